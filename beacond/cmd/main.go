@@ -50,7 +50,7 @@ func run() error {
 			nodebuilder.DefaultDepInjectConfig()),
 		// Set the Runtime Components to the Default.
 		nodebuilder.WithComponents[types.NodeI](
-			components.DefaultComponentsWithStandardTypes(),
+			components.DefaultComponentsWithStandardTypes()...,
 		),
 	)
 
@@ -197,6 +197,10 @@ test-unit-cover: ## run golang unit tests with coverage
 	@go list -f '{{.Dir}}/...' -m | xargs \
 		go test -race -coverprofile=test-unit-cover.txt 
 
+test-unit-node: ## run golang unit tests that start a node
+	@echo "Running node unit tests..."
+	@go test -tags bls12381 ./mod/node-core/pkg/builder/...
+
 
 # On MacOS, if there is a linking issue on the fuzz tests, 
 # use the old linker with flags -ldflags=-extldflags=-Wl,-ld_classic
@@ -0,0 +1,43 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain
+
+import "runtime"
+
+// Config is the configuration of the Service that is set by the node.
+type Config struct {
+	// ImportConcurrency is the number of workers the blob sidecars of the
+	// blocks imported by ImportBlocks are verified across.
+	ImportConcurrency int
+	// ImportRateLimit is the number of incoming blocks admitted to
+	// verification per second, with a small burst allowance. Blocks are
+	// admitted without limit if it is not positive.
+	ImportRateLimit int
+}
+
+// DefaultConfig returns the default configuration of the Service, which
+// verifies blob sidecars across GOMAXPROCS workers and admits incoming blocks
+// without limit.
+func DefaultConfig() Config {
+	return Config{
+		ImportConcurrency: runtime.GOMAXPROCS(0),
+	}
+}
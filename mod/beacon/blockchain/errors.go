@@ -35,4 +35,10 @@ var (
 	ErrNilBlk = errors.New("nil beacon block")
 	// ErrDataNotAvailable.
 	ErrDataNotAvailable = errors.New("data not available")
+	// ErrSidecarsCountMismatch is an error for when the number of blob
+	// sidecars given to import does not match the number of blocks.
+	ErrSidecarsCountMismatch = errors.New("sidecars count mismatch")
+	// ErrImportRateLimited is returned when the verification of an incoming
+	// block is deferred because of the import rate limit.
+	ErrImportRateLimited = errors.New("import rate limited")
 )
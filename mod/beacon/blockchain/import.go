@@ -0,0 +1,147 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain
+
+import (
+	"context"
+	"sync"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+)
+
+// ImportBlocks imports the given blocks along with their blob sidecars, which
+// are given in the same order. The blob sidecars are verified across the
+// import workers of the service, whereas the blocks are processed serially in
+// the order given, such that the resulting state does not depend on the order
+// the verifications complete in. The import stops at the first block that
+// fails to verify or process, after the blocks before it were processed, and
+// the validator updates of the processed blocks are returned in order. The
+// blocks finalized by the consensus engine are imported through it, one at a
+// time.
+func (s *Service[
+	AvailabilityStoreT,
+	BeaconBlockT,
+	BeaconBlockBodyT,
+	BeaconStateT,
+	BlobSidecarsT,
+	DepositT,
+	DepositStoreT,
+]) ImportBlocks(
+	ctx context.Context,
+	blks []BeaconBlockT,
+	sidecars []BlobSidecarsT,
+) ([]*transition.ValidatorUpdate, error) {
+	if len(blks) != len(sidecars) {
+		return nil, errors.Wrapf(
+			ErrSidecarsCountMismatch,
+			"%d blocks, %d sidecars", len(blks), len(sidecars),
+		)
+	}
+
+	indexes := make([]int, len(blks))
+	for i := range indexes {
+		indexes[i] = i
+	}
+
+	var valUpdates []*transition.ValidatorUpdate
+	err := importOrdered(
+		ctx,
+		s.importConcurrency,
+		indexes,
+		func(verifyCtx context.Context, i int) error {
+			return s.VerifyIncomingBlobs(verifyCtx, blks[i], sidecars[i])
+		},
+		func(i int) error {
+			updates, err := s.ProcessBlockAndBlobs(ctx, blks[i], sidecars[i])
+			if err != nil {
+				return err
+			}
+			valUpdates = append(valUpdates, updates...)
+			return nil
+		},
+	)
+	return valUpdates, err
+}
+
+// importOrdered verifies the given items across up to n workers, and applies
+// them serially in the order given once their verification succeeded. The
+// items are applied in order regardless of the order their verifications
+// complete in. It stops at the first item that fails to verify or apply and
+// returns its error, cancelling the context the pending verifications are
+// run with.
+func importOrdered[T any](
+	ctx context.Context,
+	n int,
+	items []T,
+	verify func(context.Context, T) error,
+	apply func(T) error,
+) error {
+	ctx, cancel := context.WithCancel(ctx)
+	var wg sync.WaitGroup
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+
+	// The result of the verification of each item is buffered, such that
+	// the workers never block on the items not applied yet.
+	results := make([]chan error, len(items))
+	for i := range results {
+		results[i] = make(chan error, 1)
+	}
+
+	workers := make(chan struct{}, max(n, 1))
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i, item := range items {
+			select {
+			case workers <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			wg.Add(1)
+			go func() {
+				defer func() {
+					<-workers
+					wg.Done()
+				}()
+				results[i] <- verify(ctx, item)
+			}()
+		}
+	}()
+
+	for i, item := range items {
+		select {
+		case err := <-results[i]:
+			if err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		if err := apply(item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,245 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain //nolint:testpackage // drives the unexported import.
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"runtime"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+// orderRecorder records the order items are passed to it in.
+type orderRecorder[T any] struct {
+	mu    sync.Mutex
+	items []T
+}
+
+func (r *orderRecorder[T]) record(item T) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.items = append(r.items, item)
+}
+
+func (r *orderRecorder[T]) recorded() []T {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.Clone(r.items)
+}
+
+// reverseDelay delays the verification of the items such that they complete
+// in the reverse order.
+func reverseDelay(i, n int) time.Duration {
+	return time.Duration(n-i) * 5 * time.Millisecond
+}
+
+func TestImportOrderedAppliesInOrder(t *testing.T) {
+	const n = 8
+	items := make([]int, n)
+	for i := range items {
+		items[i] = i
+	}
+
+	var verified, applied orderRecorder[int]
+	require.NoError(t, importOrdered(
+		context.Background(),
+		n,
+		items,
+		func(_ context.Context, i int) error {
+			time.Sleep(reverseDelay(i, n))
+			verified.record(i)
+			return nil
+		},
+		func(i int) error {
+			applied.record(i)
+			return nil
+		},
+	))
+
+	require.False(t, slices.IsSorted(verified.recorded()))
+	require.Equal(t, items, applied.recorded())
+}
+
+func TestImportOrderedStopsAtFailure(t *testing.T) {
+	errVerify := errors.New("verification failed")
+	errApply := errors.New("application failed")
+	items := []int{0, 1, 2, 3, 4, 5}
+
+	var applied orderRecorder[int]
+	err := importOrdered(
+		context.Background(),
+		len(items),
+		items,
+		func(_ context.Context, i int) error {
+			time.Sleep(reverseDelay(i, len(items)))
+			if i == 3 {
+				return errVerify
+			}
+			return nil
+		},
+		func(i int) error {
+			applied.record(i)
+			return nil
+		},
+	)
+	require.ErrorIs(t, err, errVerify)
+	require.Equal(t, []int{0, 1, 2}, applied.recorded())
+
+	applied = orderRecorder[int]{}
+	err = importOrdered(
+		context.Background(),
+		1,
+		items,
+		func(context.Context, int) error { return nil },
+		func(i int) error {
+			applied.record(i)
+			if i == 1 {
+				return errApply
+			}
+			return nil
+		},
+	)
+	require.ErrorIs(t, err, errApply)
+	require.Equal(t, []int{0, 1}, applied.recorded())
+}
+
+func TestImportOrderedCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := importOrdered(
+		ctx,
+		1,
+		[]int{0, 1},
+		func(ctx context.Context, _ int) error { return ctx.Err() },
+		func(int) error { return nil },
+	)
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestImportBlocks(t *testing.T) {
+	const n = 5
+	var applied orderRecorder[math.Slot]
+	s := newTestService(
+		nil,
+		&fakeBlobProcessor{verify: func(slot math.Slot) error {
+			time.Sleep(reverseDelay(int(slot), n))
+			return nil
+		}},
+		&fakeStateProcessor{transition: func(blk testBlock) error {
+			applied.record(blk.GetSlot())
+			return nil
+		}},
+		Config{ImportConcurrency: n},
+	)
+
+	blks := make([]testBlock, n)
+	sidecars := make([]*fakeSidecars, n)
+	for i := range blks {
+		blks[i] = newTestBlock(t, math.Slot(i))
+		sidecars[i] = &fakeSidecars{}
+	}
+
+	_, err := s.ImportBlocks(context.Background(), blks, sidecars)
+	require.NoError(t, err)
+	require.Equal(t, []math.Slot{0, 1, 2, 3, 4}, applied.recorded())
+	require.Equal(t, math.Slot(n-1), s.HeadSlot())
+
+	_, err = s.ImportBlocks(context.Background(), blks, sidecars[1:])
+	require.ErrorIs(t, err, ErrSidecarsCountMismatch)
+}
+
+func TestImportBlocksConcurrency(t *testing.T) {
+	const (
+		workers = 3
+		n       = 12
+	)
+	var (
+		mu                sync.Mutex
+		inFlight, maxSeen int
+	)
+	s := newTestService(
+		nil,
+		&fakeBlobProcessor{verify: func(math.Slot) error {
+			mu.Lock()
+			inFlight++
+			maxSeen = max(maxSeen, inFlight)
+			mu.Unlock()
+
+			time.Sleep(10 * time.Millisecond)
+
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+			return nil
+		}},
+		&fakeStateProcessor{},
+		Config{ImportConcurrency: workers},
+	)
+
+	blks := make([]testBlock, n)
+	sidecars := make([]*fakeSidecars, n)
+	for i := range blks {
+		blks[i] = newTestBlock(t, math.Slot(i+1))
+		sidecars[i] = &fakeSidecars{}
+	}
+
+	_, err := s.ImportBlocks(context.Background(), blks, sidecars)
+	require.NoError(t, err)
+
+	// The sidecars are verified across exactly the configured workers.
+	mu.Lock()
+	defer mu.Unlock()
+	require.Equal(t, workers, maxSeen)
+}
+
+func BenchmarkImportOrdered(b *testing.B) {
+	items := make([]int, 64)
+	verify := func(context.Context, int) error {
+		// Simulate the verification of the proofs of a block.
+		sum := sha256.Sum256(nil)
+		for range 10_000 {
+			sum = sha256.Sum256(sum[:])
+		}
+		return nil
+	}
+	apply := func(int) error { return nil }
+
+	for _, workers := range []int{1, runtime.GOMAXPROCS(0)} {
+		b.Run(fmt.Sprintf("workers=%d", workers), func(b *testing.B) {
+			for range b.N {
+				if err := importOrdered(
+					context.Background(), workers, items, verify, apply,
+				); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
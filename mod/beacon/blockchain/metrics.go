@@ -26,6 +26,20 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
+const (
+	// slotPhaseStateTransition is the phase of the processing of a slot that
+	// verifies the block and transitions the state with it.
+	slotPhaseStateTransition = "state_transition"
+	// slotPhaseBlobPersistence is the phase of the processing of a slot that
+	// persists the blob sidecars of the block.
+	slotPhaseBlobPersistence = "blob_persistence"
+	// slotPhaseDataAvailability is the phase of the processing of a slot that
+	// verifies the data of the block is available.
+	slotPhaseDataAvailability = "data_availability"
+	// slotPhaseTotal covers the whole processing of a slot.
+	slotPhaseTotal = "total"
+)
+
 // chainMetrics is a struct that contains metrics for the chain.
 type chainMetrics struct {
 	// sink is the sink for the metrics.
@@ -52,6 +66,18 @@ func (cm *chainMetrics) measureStateTransitionDuration(
 	)
 }
 
+// measureSlotProcessingDuration measures the time to run the given phase of
+// the processing of a slot.
+func (cm *chainMetrics) measureSlotProcessingDuration(
+	start time.Time,
+	phase string,
+) {
+	cm.sink.MeasureSince(
+		"beacon_kit.beacon.blockchain.slot_processing_duration", start,
+		"phase", phase,
+	)
+}
+
 // measureBlobProcessingDuration measures the time to process
 // the blobs for a block.
 func (cm *chainMetrics) measureBlobProcessingDuration(start time.Time) {
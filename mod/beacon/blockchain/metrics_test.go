@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain //nolint:testpackage // drives the unexported metrics.
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// fakeClock is a clock that only advances when told to.
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+// recordingTelemetrySink records the durations measured through it by the
+// phase they are labelled with, according to the clock.
+type recordingTelemetrySink struct {
+	fakeTelemetrySink
+	clock *fakeClock
+
+	mu        sync.Mutex
+	durations map[string][]time.Duration
+}
+
+func (s *recordingTelemetrySink) MeasureSince(
+	key string, start time.Time, args ...string,
+) {
+	if key != "beacon_kit.beacon.blockchain.slot_processing_duration" {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// The phase is the value of the only label of the duration.
+	s.durations[args[1]] = append(
+		s.durations[args[1]], s.clock.Now().Sub(start),
+	)
+}
+
+func TestServiceMeasuresSlotProcessing(t *testing.T) {
+	const transitionTime = 50 * time.Millisecond
+
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	sink := &recordingTelemetrySink{
+		clock:     clock,
+		durations: make(map[string][]time.Duration),
+	}
+	s := newTestService(
+		noop.NewTracerProvider(),
+		&fakeBlobProcessor{},
+		&fakeStateProcessor{transition: func(testBlock) error {
+			clock.Advance(transitionTime)
+			return nil
+		}},
+		DefaultConfig(),
+	)
+	s.metrics = newChainMetrics(sink)
+	s.now = clock.Now
+
+	const slots = 3
+	for slot := range math.Slot(slots) {
+		_, err := s.ProcessBlockAndBlobs(
+			context.Background(), newTestBlock(t, slot+1), &fakeSidecars{},
+		)
+		require.NoError(t, err)
+		// Waiting for the next slot is not measured.
+		clock.Advance(time.Second)
+	}
+
+	for _, phase := range []string{
+		slotPhaseStateTransition,
+		slotPhaseBlobPersistence,
+		slotPhaseDataAvailability,
+		slotPhaseTotal,
+	} {
+		require.Len(t, sink.durations[phase], slots, phase)
+	}
+	for _, phase := range []string{slotPhaseStateTransition, slotPhaseTotal} {
+		for _, d := range sink.durations[phase] {
+			require.Equal(t, transitionTime, d, phase)
+		}
+	}
+	for _, d := range sink.durations[slotPhaseDataAvailability] {
+		require.Zero(t, d)
+	}
+}
@@ -60,7 +60,10 @@ func (s *Service[
 }
 
 // ProcessBlockAndBlobs receives an incoming beacon block, it first validates
-// and then processes the block.
+// and then processes the block, once admitted under the import rate limit.
+// The time spent processing the slot of the block is measured per phase, from
+// the moment the block is admitted until it is processed, such that the time
+// spent waiting for the next slot is not included.
 func (s *Service[
 	AvailabilityStoreT,
 	BeaconBlockT,
@@ -85,8 +88,15 @@ func (s *Service[
 		return nil, ErrNilBlk
 	}
 
+	startTime := s.now()
+	defer s.metrics.measureSlotProcessingDuration(startTime, slotPhaseTotal)
+
 	// Launch a goroutine to process the incoming beacon block.
 	g.Go(func() error {
+		transitionStart := s.now()
+		defer s.metrics.measureSlotProcessingDuration(
+			transitionStart, slotPhaseStateTransition,
+		)
 		var err error
 		// We set `OptimisticEngine` to true since this is called during
 		// FinalizeBlock. We want to assume the payload is valid. If it
@@ -99,6 +109,10 @@ func (s *Service[
 
 	// Launch a goroutine to process the blob sidecars.
 	g.Go(func() error {
+		persistStart := s.now()
+		defer s.metrics.measureSlotProcessingDuration(
+			persistStart, slotPhaseBlobPersistence,
+		)
 		return s.processBlobSidecars(gCtx, blk.GetSlot(), sidecars)
 	})
 
@@ -110,12 +124,19 @@ func (s *Service[
 	// If the blobs needed to process the block are not available, we
 	// return an error. It is safe to use the slot off of the beacon block
 	// since it has been verified as correct already.
-	if !s.sb.AvailabilityStore(ctx).IsDataAvailable(
+	availabilityStart := s.now()
+	available := s.sb.AvailabilityStore(ctx).IsDataAvailable(
 		ctx, blk.GetSlot(), blk.GetBody(),
-	) {
+	)
+	s.metrics.measureSlotProcessingDuration(
+		availabilityStart, slotPhaseDataAvailability,
+	)
+	if !available {
 		return nil, ErrDataNotAvailable
 	}
 
+	s.headSlot.Store(blk.GetSlot().Unwrap())
+
 	// If required, we want to forkchoice at the end of post
 	// block processing.
 	// TODO: this is hood as fuck.
@@ -145,6 +166,8 @@ func (s *Service[
 ) ([]*transition.ValidatorUpdate, error) {
 	startTime := time.Now()
 	defer s.metrics.measureStateTransitionDuration(startTime)
+	ctx, span := s.tracer.Start(ctx, SpanStateTransition)
+	defer span.End()
 	valUpdates, err := s.sp.Transition(
 		&transition.Context{
 			Context:          ctx,
@@ -167,6 +190,7 @@ func (s *Service[
 		st,
 		blk,
 	)
+	recordError(span, err)
 	return valUpdates, err
 }
 
@@ -186,9 +210,13 @@ func (s *Service[
 ) error {
 	startTime := time.Now()
 	defer s.metrics.measureBlobProcessingDuration(startTime)
-	return s.bp.ProcessBlobs(
+	_, span := s.tracer.Start(ctx, SpanDAStoreWrite)
+	defer span.End()
+	err := s.bp.ProcessBlobs(
 		slot,
 		s.sb.AvailabilityStore(ctx),
 		sidecars,
 	)
+	recordError(span, err)
+	return err
 }
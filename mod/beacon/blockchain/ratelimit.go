@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"golang.org/x/time/rate"
+)
+
+// importBurst is the number of incoming blocks admitted at once, above the
+// import rate limit, after a period without blocks.
+const importBurst = 2
+
+// newImportLimiter returns a limiter admitting blocksPerSec blocks per second,
+// or nil if blocksPerSec is not positive, in which case blocks are admitted
+// without limit.
+func newImportLimiter(blocksPerSec int) *rate.Limiter {
+	if blocksPerSec <= 0 {
+		return nil
+	}
+	return rate.NewLimiter(rate.Limit(blocksPerSec), importBurst)
+}
+
+// admitBlock reports whether the incoming block of the given slot is admitted
+// to verification under the import rate limit. A block that is not admitted
+// is not verified as a proposal, and is only checked once it is finalized.
+func (s *Service[
+	AvailabilityStoreT,
+	BeaconBlockT,
+	BeaconBlockBodyT,
+	BeaconStateT,
+	BlobSidecarsT,
+	DepositStoreT,
+	DepositT,
+]) admitBlock(slot math.Slot) bool {
+	if s.importLimiter == nil || s.importLimiter.AllowN(s.now(), 1) {
+		return true
+	}
+
+	s.logger.Debug(
+		"deferring verification of incoming beacon block - "+
+			"import rate limit exceeded",
+		"slot", slot,
+	)
+	return false
+}
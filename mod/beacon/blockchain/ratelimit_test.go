@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain //nolint:testpackage // drives the unexported limiter.
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReceiveBlockRateLimit(t *testing.T) {
+	const (
+		limit    = 10
+		interval = 10 * time.Millisecond
+		n        = 200
+	)
+	var verified atomic.Int64
+	s := newTestService(
+		nil, &fakeBlobProcessor{},
+		&fakeStateProcessor{transition: func(testBlock) error {
+			verified.Add(1)
+			return nil
+		}},
+		Config{ImportRateLimit: limit},
+	)
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	s.now = clock.Now
+
+	// Feed blocks ten times faster than the limit.
+	for slot := range math.Slot(n) {
+		// Blocks above the limit are deferred rather than rejected.
+		require.False(t, errors.IsFatal(s.ReceiveBlockAndBlobs(
+			context.Background(), newTestBlock(t, slot+1), &fakeSidecars{},
+		)))
+		clock.Advance(interval)
+	}
+
+	// Beyond the burst, the blocks are admitted at the limit.
+	elapsed := time.Duration(n) * interval
+	rate := float64(verified.Load()-importBurst) / elapsed.Seconds()
+	require.InDelta(t, limit, rate, 1)
+}
+
+func TestProcessBlockNotRateLimited(t *testing.T) {
+	s := newTestService(
+		nil, &fakeBlobProcessor{}, &fakeStateProcessor{},
+		Config{ImportRateLimit: 1},
+	)
+
+	// Finalized blocks are processed without delay, whatever the limit.
+	start := time.Now()
+	for slot := range math.Slot(4 * importBurst) {
+		_, err := s.ProcessBlockAndBlobs(
+			context.Background(), newTestBlock(t, slot+1), &fakeSidecars{},
+		)
+		require.NoError(t, err)
+	}
+	require.Less(t, time.Since(start), time.Second)
+}
+
+func TestReceiveBlockNotRateLimitedByDefault(t *testing.T) {
+	var verified atomic.Int64
+	s := newTestService(
+		nil, &fakeBlobProcessor{},
+		&fakeStateProcessor{transition: func(testBlock) error {
+			verified.Add(1)
+			return nil
+		}},
+		DefaultConfig(),
+	)
+
+	const n = 4 * importBurst
+	for slot := range math.Slot(n) {
+		require.False(t, errors.IsFatal(s.ReceiveBlockAndBlobs(
+			context.Background(), newTestBlock(t, slot+1), &fakeSidecars{},
+		)))
+	}
+	require.Equal(t, int64(n), verified.Load())
+}
+
+func TestNewImportLimiter(t *testing.T) {
+	require.Nil(t, newImportLimiter(0))
+	require.Nil(t, newImportLimiter(-1))
+
+	l := newImportLimiter(10)
+	require.InDelta(t, 10, float64(l.Limit()), 0)
+	require.Equal(t, importBurst, l.Burst())
+}
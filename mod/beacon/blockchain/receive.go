@@ -45,6 +45,13 @@ func (s *Service[
 	blk BeaconBlockT,
 	blobs BlobSidecarsT,
 ) error {
+	// The verification of a block above the import rate limit is deferred
+	// to its finalization rather than rejecting it, such that this node does
+	// not vote against a valid proposal.
+	if !blk.IsNil() && !s.admitBlock(blk.GetSlot()) {
+		return errors.WrapNonFatal(ErrImportRateLimited)
+	}
+
 	var (
 		blockErr, blobsErr error
 		wg                 sync.WaitGroup
@@ -83,6 +90,9 @@ func (s *Service[
 	// Grab a copy of the state to verify the incoming block.
 	preState := s.sb.StateFromContext(ctx)
 
+	ctx, span := s.tracer.Start(ctx, SpanBlockVerify)
+	defer span.End()
+
 	// Force a sync of the startup head if we haven't done so already.
 	//
 	// TODO: This is a super hacky. It should be handled better elsewhere,
@@ -94,6 +104,7 @@ func (s *Service[
 		s.logger.Warn(
 			"aborting block verification - beacon block not found in proposal 🚫 ",
 		)
+		recordError(span, ErrNilBlk)
 		return errors.WrapNonFatal(ErrNilBlk)
 	}
 
@@ -119,6 +130,7 @@ func (s *Service[
 			"reason",
 			err,
 		)
+		recordError(span, err)
 
 		if s.shouldBuildOptimisticPayloads() {
 			go s.handleRebuildPayloadForRejectedBlock(ctx, preState)
@@ -156,6 +168,8 @@ func (s *Service[
 ) error {
 	startTime := time.Now()
 	defer s.metrics.measureStateRootVerificationTime(startTime)
+	ctx, span := s.tracer.Start(ctx, SpanStateTransition)
+	defer span.End()
 	if _, err := s.sp.Transition(
 		// We run with a non-optimistic engine here to ensure
 		// that the proposer does not try to push through a bad block.
@@ -175,6 +189,7 @@ func (s *Service[
 		// TODO: this is only true because we are assuming SSF.
 		return nil
 	} else if err != nil {
+		recordError(span, err)
 		return err
 	}
 
@@ -23,12 +23,16 @@ package blockchain
 import (
 	"context"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/feed"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/time/rate"
 )
 
 // Service is the blockchain service.
@@ -73,6 +77,17 @@ type Service[
 	]
 	// metrics is the metrics for the service.
 	metrics *chainMetrics
+	// tracer traces the processing of blocks by the service.
+	tracer trace.Tracer
+	// now returns the current time, which the processing of slots is
+	// measured by.
+	now func() time.Time
+	// importConcurrency is the number of workers the blob sidecars of the
+	// imported blocks are verified across.
+	importConcurrency int
+	// importLimiter limits the rate at which incoming blocks are verified,
+	// or is nil if they are verified without limit.
+	importLimiter *rate.Limiter
 	// blockFeed is the event feed for new blocks.
 	blockFeed EventFeed[*feed.Event[BeaconBlockT]]
 	// optimisticPayloadBuilds is a flag used when the optimistic payload
@@ -80,9 +95,17 @@ type Service[
 	optimisticPayloadBuilds bool
 	// forceStartupSyncOnce is used to force a sync of the startup head.
 	forceStartupSyncOnce *sync.Once
+	// headSlot is the slot of the last block processed by the service.
+	headSlot atomic.Uint64
+	// syncTargetSlot is the slot of the chain head the node is syncing to.
+	syncTargetSlot atomic.Uint64
 }
 
-// NewService creates a new validator service.
+// NewService creates a new validator service. The processing of blocks is
+// traced with the given tracer provider, or not at all if it is nil.
+// Imported blocks are verified across cfg.ImportConcurrency workers, and
+// incoming blocks are verified at up to cfg.ImportRateLimit blocks per
+// second.
 func NewService[
 	AvailabilityStoreT AvailabilityStore[
 		BeaconBlockBodyT, BlobSidecarsT,
@@ -116,7 +139,9 @@ func NewService[
 		BlobSidecarsT, *transition.Context, DepositT,
 	],
 	ts TelemetrySink,
+	tp trace.TracerProvider,
 	blockFeed EventFeed[*feed.Event[BeaconBlockT]],
+	cfg Config,
 	optimisticPayloadBuilds bool,
 ) *Service[
 	AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BeaconStateT,
@@ -134,6 +159,10 @@ func NewService[
 		bp:                      bp,
 		sp:                      sp,
 		metrics:                 newChainMetrics(ts),
+		tracer:                  newTracer(tp),
+		now:                     time.Now,
+		importConcurrency:       cfg.ImportConcurrency,
+		importLimiter:           newImportLimiter(cfg.ImportRateLimit),
 		blockFeed:               blockFeed,
 		optimisticPayloadBuilds: optimisticPayloadBuilds,
 		forceStartupSyncOnce:    new(sync.Once),
@@ -0,0 +1,282 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain //nolint:testpackage // drives the unexported service.
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/feed"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type (
+	testBlock   = *types.BeaconBlock
+	testBody    = *types.BeaconBlockBody
+	testState   = *fakeState
+	testService = Service[
+		*fakeAvailabilityStore, testBlock, testBody, testState,
+		*fakeSidecars, *types.Deposit, *fakeDepositStore,
+	]
+)
+
+// fakeState is a beacon state without a latest execution payload header,
+// such that no forkchoice update is sent after a block is processed.
+type fakeState struct{}
+
+func (*fakeState) GetSlot() (math.Slot, error) { return 0, nil }
+
+func (*fakeState) GetLatestExecutionPayloadHeader() (
+	*types.ExecutionPayloadHeader, error,
+) {
+	return nil, errors.New("no execution payload header")
+}
+
+func (*fakeState) GetEth1DepositIndex() (uint64, error) { return 0, nil }
+
+func (*fakeState) GetLatestBlockHeader() (*types.BeaconBlockHeader, error) {
+	return &types.BeaconBlockHeader{}, nil
+}
+
+func (*fakeState) HashTreeRoot() ([32]byte, error) { return [32]byte{}, nil }
+
+func (s *fakeState) Copy() *fakeState { return s }
+
+func (*fakeState) ValidatorIndexByPubkey(
+	crypto.BLSPubkey,
+) (math.ValidatorIndex, error) {
+	return 0, nil
+}
+
+// fakeSidecars are blob sidecars holding a single blob, such that they are
+// verified by the blob processor.
+type fakeSidecars struct{}
+
+func (*fakeSidecars) MarshalSSZTo(b []byte) ([]byte, error) { return b, nil }
+
+func (*fakeSidecars) MarshalSSZ() ([]byte, error) { return nil, nil }
+
+func (*fakeSidecars) UnmarshalSSZ([]byte) error { return nil }
+
+func (*fakeSidecars) SizeSSZ() int { return 0 }
+
+func (*fakeSidecars) HashTreeRoot() ([32]byte, error) {
+	return [32]byte{}, nil
+}
+
+func (*fakeSidecars) IsNil() bool { return false }
+
+func (*fakeSidecars) Len() int { return 1 }
+
+type fakeAvailabilityStore struct{}
+
+func (*fakeAvailabilityStore) IsDataAvailable(
+	context.Context, math.Slot, testBody,
+) bool {
+	return true
+}
+
+func (*fakeAvailabilityStore) Persist(math.Slot, *fakeSidecars) error {
+	return nil
+}
+
+type fakeDepositStore struct{}
+
+func (*fakeDepositStore) Prune(uint64, uint64) error { return nil }
+
+func (*fakeDepositStore) EnqueueDeposits([]*types.Deposit) error {
+	return nil
+}
+
+type fakeStorageBackend struct{}
+
+func (*fakeStorageBackend) AvailabilityStore(
+	context.Context,
+) *fakeAvailabilityStore {
+	return &fakeAvailabilityStore{}
+}
+
+func (*fakeStorageBackend) StateFromContext(context.Context) testState {
+	return &fakeState{}
+}
+
+func (*fakeStorageBackend) StateAtSlot(
+	context.Context, math.Slot,
+) (testState, error) {
+	return &fakeState{}, nil
+}
+
+func (*fakeStorageBackend) StateView(
+	context.Context,
+) (StateReader, error) {
+	return nil, errors.New("no state view")
+}
+
+func (*fakeStorageBackend) DepositStore(context.Context) *fakeDepositStore {
+	return &fakeDepositStore{}
+}
+
+type fakeExecutionEngine struct{}
+
+func (*fakeExecutionEngine) GetPayload(
+	context.Context, *engineprimitives.GetPayloadRequest,
+) (engineprimitives.BuiltExecutionPayloadEnv[*types.ExecutionPayload], error) {
+	return nil, errors.New("no payload")
+}
+
+func (*fakeExecutionEngine) NotifyForkchoiceUpdate(
+	context.Context, *engineprimitives.ForkchoiceUpdateRequest,
+) (*engineprimitives.PayloadID, *common.ExecutionHash, error) {
+	return nil, nil, nil
+}
+
+func (*fakeExecutionEngine) VerifyAndNotifyNewPayload(
+	context.Context,
+	*engineprimitives.NewPayloadRequest[
+		*types.ExecutionPayload, *engineprimitives.Withdrawal,
+	],
+) error {
+	return nil
+}
+
+type fakeLocalBuilder struct{}
+
+func (*fakeLocalBuilder) Enabled() bool { return false }
+
+func (*fakeLocalBuilder) RequestPayloadAsync(
+	context.Context, testState, math.Slot, uint64,
+	primitives.Root, common.ExecutionHash, common.ExecutionHash,
+) (*engineprimitives.PayloadID, error) {
+	return &engineprimitives.PayloadID{}, nil
+}
+
+func (*fakeLocalBuilder) SendForceHeadFCU(
+	context.Context, testState, math.Slot,
+) error {
+	return nil
+}
+
+// fakeBlobProcessor verifies blob sidecars with the verify function, if set.
+type fakeBlobProcessor struct {
+	verify func(math.Slot) error
+}
+
+func (*fakeBlobProcessor) ProcessBlobs(
+	math.Slot, *fakeAvailabilityStore, *fakeSidecars,
+) error {
+	return nil
+}
+
+func (bp *fakeBlobProcessor) VerifyBlobs(
+	slot math.Slot, _ *fakeSidecars,
+) error {
+	if bp.verify == nil {
+		return nil
+	}
+	return bp.verify(slot)
+}
+
+// fakeStateProcessor transitions the state with the transition function, if
+// set.
+type fakeStateProcessor struct {
+	transition func(testBlock) error
+}
+
+func (*fakeStateProcessor) InitializePreminedBeaconStateFromEth1(
+	testState, []*types.Deposit,
+	*types.ExecutionPayloadHeader, primitives.Version,
+) ([]*transition.ValidatorUpdate, error) {
+	return nil, nil
+}
+
+func (*fakeStateProcessor) ProcessSlots(
+	testState, math.Slot,
+) ([]*transition.ValidatorUpdate, error) {
+	return nil, nil
+}
+
+func (sp *fakeStateProcessor) Transition(
+	_ *transition.Context, _ testState, blk testBlock,
+) ([]*transition.ValidatorUpdate, error) {
+	if sp.transition == nil {
+		return nil, nil
+	}
+	return nil, sp.transition(blk)
+}
+
+type fakeFeed struct{}
+
+func (*fakeFeed) Send(*feed.Event[testBlock]) int { return 0 }
+
+type fakeTelemetrySink struct{}
+
+func (*fakeTelemetrySink) IncrementCounter(string, ...string) {}
+
+func (*fakeTelemetrySink) MeasureSince(string, time.Time, ...string) {}
+
+// newTestService returns a service verifying and processing blocks with the
+// given processors, and tracing them with the given tracer provider.
+func newTestService(
+	tp trace.TracerProvider,
+	bp *fakeBlobProcessor,
+	sp *fakeStateProcessor,
+	cfg Config,
+) *testService {
+	return NewService[
+		*fakeAvailabilityStore, testBlock, testBody, testState,
+		*fakeSidecars, *fakeDepositStore, *types.Deposit,
+	](
+		&fakeStorageBackend{},
+		noop.NewLogger(),
+		nil,
+		&fakeExecutionEngine{},
+		&fakeLocalBuilder{},
+		bp,
+		sp,
+		&fakeTelemetrySink{},
+		tp,
+		&fakeFeed{},
+		cfg,
+		false,
+	)
+}
+
+// newTestBlock returns an empty block for the given slot.
+func newTestBlock(t *testing.T, slot math.Slot) testBlock {
+	t.Helper()
+	blk, err := (&types.BeaconBlock{}).NewWithVersion(
+		slot, 0, common.Root{}, version.Deneb,
+	)
+	require.NoError(t, err)
+	return blk
+}
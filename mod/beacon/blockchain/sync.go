@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain
+
+import "github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+
+// SetSyncTarget sets the slot of the chain head the node is syncing to, as
+// reported by the consensus engine.
+func (s *Service[
+	AvailabilityStoreT,
+	BeaconBlockT,
+	BeaconBlockBodyT,
+	BeaconStateT,
+	BlobSidecarsT,
+	DepositT,
+	DepositStoreT,
+]) SetSyncTarget(slot math.Slot) {
+	s.syncTargetSlot.Store(slot.Unwrap())
+}
+
+// HeadSlot returns the slot of the last block processed by the service.
+func (s *Service[
+	AvailabilityStoreT,
+	BeaconBlockT,
+	BeaconBlockBodyT,
+	BeaconStateT,
+	BlobSidecarsT,
+	DepositT,
+	DepositStoreT,
+]) HeadSlot() math.Slot {
+	return math.Slot(s.headSlot.Load())
+}
+
+// IsSynced reports whether the service has processed a block and is at most
+// maxLag slots behind the chain head it is syncing to.
+func (s *Service[
+	AvailabilityStoreT,
+	BeaconBlockT,
+	BeaconBlockBodyT,
+	BeaconStateT,
+	BlobSidecarsT,
+	DepositT,
+	DepositStoreT,
+]) IsSynced(maxLag math.Slot) bool {
+	head := s.headSlot.Load()
+	if head == 0 {
+		return false
+	}
+	target := s.syncTargetSlot.Load()
+	return target <= head || target-head <= maxLag.Unwrap()
+}
@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain //nolint:testpackage // drives the unexported tracer.
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestServiceTracesBlockProcessing(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	t.Cleanup(func() { require.NoError(t, tp.Shutdown(context.Background())) })
+
+	s := newTestService(
+		tp, &fakeBlobProcessor{}, &fakeStateProcessor{}, DefaultConfig(),
+	)
+	blk := newTestBlock(t, 1)
+
+	ctx := context.Background()
+	require.NoError(t, s.VerifyIncomingBlock(ctx, blk))
+	_, err := s.ProcessBlockAndBlobs(ctx, blk, &fakeSidecars{})
+	require.NoError(t, err)
+
+	spans := make(map[string][]sdktrace.ReadOnlySpan)
+	for _, span := range recorder.Ended() {
+		spans[span.Name()] = append(spans[span.Name()], span)
+	}
+	require.Len(t, spans[SpanBlockVerify], 1)
+	require.Len(t, spans[SpanStateTransition], 2)
+	require.Len(t, spans[SpanDAStoreWrite], 1)
+
+	// The state transition run to verify the block is a child of the span
+	// of the verification.
+	verify := spans[SpanBlockVerify][0].SpanContext().SpanID()
+	var children int
+	for _, span := range spans[SpanStateTransition] {
+		if span.Parent().SpanID() == verify {
+			children++
+		}
+	}
+	require.Equal(t, 1, children)
+}
+
+func TestServiceWithoutTracerProvider(t *testing.T) {
+	_, span := newTracer(nil).Start(context.Background(), SpanBlockVerify)
+	defer span.End()
+	require.False(t, span.IsRecording())
+}
@@ -206,10 +206,55 @@ type StorageBackend[
 	AvailabilityStore(context.Context) AvailabilityStoreT
 	// StateFromContext retrieves the beacon state from the given context.
 	StateFromContext(context.Context) BeaconStateT
+	// StateAtSlot returns a read-only view of the beacon state as of the
+	// given slot, or an error if the slot is outside the retained range.
+	StateAtSlot(context.Context, math.Slot) (BeaconStateT, error)
+	// StateView returns a consistent read-only view of the latest committed
+	// beacon state.
+	StateView(context.Context) (StateReader, error)
 	// DepositStore returns the deposit store for the given context.
 	DepositStore(context.Context) DepositStoreT
 }
 
+// StateReader is a read-only view of the beacon state, exposing the accessors
+// commonly used by query services. All of the reads through a StateReader are
+// served from the same version of the state.
+type StateReader interface {
+	// GetSlot returns the slot of the state.
+	GetSlot() (math.Slot, error)
+	// GetGenesisValidatorsRoot returns the genesis validators root.
+	GetGenesisValidatorsRoot() (primitives.Root, error)
+	// GetLatestBlockHeader returns the header of the latest block, which is
+	// final as soon as it is committed.
+	GetLatestBlockHeader() (*types.BeaconBlockHeader, error)
+	// GetLatestExecutionPayloadHeader returns the header of the latest
+	// execution payload.
+	GetLatestExecutionPayloadHeader() (*types.ExecutionPayloadHeader, error)
+	// GetEth1Data returns the eth1 data of the state.
+	GetEth1Data() (*types.Eth1Data, error)
+	// GetEth1DepositIndex returns the index of the latest eth1 deposit.
+	GetEth1DepositIndex() (uint64, error)
+	// GetBlockRootAtIndex returns the block root at the given index.
+	GetBlockRootAtIndex(uint64) (primitives.Root, error)
+	// StateRootAtIndex returns the state root at the given index.
+	StateRootAtIndex(uint64) (primitives.Root, error)
+	// GetValidators returns the validator set.
+	GetValidators() ([]*types.Validator, error)
+	// GetTotalValidators returns the size of the validator set.
+	GetTotalValidators() (uint64, error)
+	// ValidatorByIndex returns the validator at the given index.
+	ValidatorByIndex(math.ValidatorIndex) (*types.Validator, error)
+	// ValidatorIndexByPubkey returns the index of the validator with the
+	// given public key.
+	ValidatorIndexByPubkey(crypto.BLSPubkey) (math.ValidatorIndex, error)
+	// GetBalances returns the balances of the validator set.
+	GetBalances() ([]uint64, error)
+	// GetBalance returns the balance of the validator at the given index.
+	GetBalance(math.ValidatorIndex) (math.Gwei, error)
+	// HashTreeRoot returns the hash tree root of the state.
+	HashTreeRoot() ([32]byte, error)
+}
+
 // TelemetrySink is an interface for sending metrics to a telemetry backend.
 type TelemetrySink interface {
 	// IncrementCounter increments the counter identified by
@@ -20,6 +20,8 @@
 
 package validator
 
+import "time"
+
 const (
 	// defaultGraffiti is the default graffiti string.
 	defaultGraffiti = ""
@@ -27,6 +29,10 @@ const (
 	// defaultEnableOptimisticPayloadBuilds is the default
 	// for enabling the optimistic payload builder.
 	defaultEnableOptimisticPayloadBuilds = true
+
+	// defaultProposerTimeout is the default time the proposer waits for the
+	// execution payload, where zero means it waits for as long as it takes.
+	defaultProposerTimeout = 0
 )
 
 // Config is the validator configuration.
@@ -39,6 +45,12 @@ type Config struct {
 
 	// EnableOptimisticPayloadBuilds is the optimistic block builder.
 	EnableOptimisticPayloadBuilds bool `mapstructure:"enable-optimistic-payload-builds"`
+
+	// ProposerTimeout is the time the proposer waits for the execution
+	// payload of the block it builds. Once it expires, no block is proposed
+	// for the slot and an empty proposal is made instead. It is not bounded
+	// if zero.
+	ProposerTimeout time.Duration `mapstructure:"proposer-timeout"`
 }
 
 // DefaultConfig returns the default fork configuration.
@@ -46,5 +58,6 @@ func DefaultConfig() Config {
 	return Config{
 		Graffiti:                      defaultGraffiti,
 		EnableOptimisticPayloadBuilds: defaultEnableOptimisticPayloadBuilds,
+		ProposerTimeout:               defaultProposerTimeout,
 	}
 }
@@ -49,4 +49,8 @@ var (
 	// ErrNilDepositIndexStart is an error for when the deposit index start is
 	// nil.
 	ErrNilDepositIndexStart = errors.New("nil deposit index start")
+
+	// ErrProposerTimeout is an error for when the execution payload of a
+	// proposed block is not retrieved before the proposer timeout expires.
+	ErrProposerTimeout = errors.New("timed out waiting for execution payload")
 )
@@ -95,7 +95,7 @@ func (s *Service[
 	body.SetRandaoReveal(reveal)
 
 	// Get the payload for the block.
-	envelope, err := s.retrieveExecutionPayload(ctx, st, blk)
+	envelope, err := s.retrieveExecutionPayloadWithTimeout(ctx, st, blk)
 	if err != nil {
 		return blk, sidecars, err
 	} else if envelope == nil {
@@ -163,6 +163,12 @@ func (s *Service[
 		return blk, sidecars, err
 	}
 
+	// Reject the block if it cannot be included by consensus, rather than
+	// wasting the proposal slot on an oversized block.
+	if err = blk.CheckSize(s.chainSpec); err != nil {
+		return blk, sidecars, err
+	}
+
 	s.logger.Info(
 		"beacon block successfully built 🛠️ ",
 		"slot", requestedSlot.Base10(),
@@ -242,12 +248,73 @@ func (s *Service[
 	return s.signer.Sign(signingRoot[:])
 }
 
-// retrieveExecutionPayload retrieves the execution payload for the block.
+// retrieveExecutionPayloadWithTimeout retrieves the execution payload for the
+// block, giving up with ErrProposerTimeout once the proposer timeout expires
+// such that a slow execution client does not cause the slot to be missed.
+// Without a block, an empty proposal is made for the slot instead.
 func (s *Service[
 	BeaconBlockT, BeaconBlockBodyT, BeaconStateT,
 	BlobSidecarsT, DepositStoreT, ForkDataT,
-]) retrieveExecutionPayload(
+]) retrieveExecutionPayloadWithTimeout(
 	ctx context.Context, st BeaconStateT, blk BeaconBlockT,
+) (engineprimitives.BuiltExecutionPayloadEnv[*types.ExecutionPayload], error) {
+	var (
+		timeout         = s.cfg.ProposerTimeout
+		slot            = blk.GetSlot()
+		parentBlockRoot = blk.GetParentBlockRoot()
+	)
+	if timeout <= 0 {
+		return s.retrieveExecutionPayload(ctx, st, slot, parentBlockRoot)
+	}
+
+	// The retrieval is abandoned on timeout rather than waited for, as the
+	// execution client may not honour the cancellation of its context. It
+	// therefore works on a copy of the state, such that it does not race
+	// with the proposal once abandoned. The channel is buffered such that
+	// the retrieval does not block once it completes.
+	timeoutCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	type result struct {
+		envelope engineprimitives.BuiltExecutionPayloadEnv[*types.ExecutionPayload]
+		err      error
+	}
+	resCh := make(chan result, 1)
+	stCopy := st.Copy()
+	go func() {
+		envelope, err := s.retrieveExecutionPayload(
+			timeoutCtx, stCopy, slot, parentBlockRoot,
+		)
+		resCh <- result{envelope: envelope, err: err}
+	}()
+
+	select {
+	case res := <-resCh:
+		return res.envelope, res.err
+	case <-timeoutCtx.Done():
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		s.logger.Warn(
+			"timed out waiting for execution payload, proposing empty block",
+			"slot", slot.Base10(),
+			"timeout", timeout.String(),
+		)
+		return nil, errors.Wrapf(
+			ErrProposerTimeout, "slot %d after %s", slot, timeout,
+		)
+	}
+}
+
+// retrieveExecutionPayload retrieves the execution payload for the block of
+// the given slot and parent block root.
+func (s *Service[
+	BeaconBlockT, BeaconBlockBodyT, BeaconStateT,
+	BlobSidecarsT, DepositStoreT, ForkDataT,
+]) retrieveExecutionPayload(
+	ctx context.Context,
+	st BeaconStateT,
+	slot math.Slot,
+	parentBlockRoot primitives.Root,
 ) (engineprimitives.BuiltExecutionPayloadEnv[*types.ExecutionPayload], error) {
 	//
 	// TODO: Add external block builders to this flow.
@@ -256,12 +323,12 @@ func (s *Service[
 	envelope, err := s.localPayloadBuilder.
 		RetrievePayload(
 			ctx,
-			blk.GetSlot(),
-			blk.GetParentBlockRoot(),
+			slot,
+			parentBlockRoot,
 		)
 	if err != nil {
 		s.metrics.failedToRetrievePayload(
-			blk.GetSlot(),
+			slot,
 			err,
 		)
 
@@ -284,14 +351,14 @@ func (s *Service[
 		return s.localPayloadBuilder.RequestPayloadSync(
 			ctx,
 			st,
-			blk.GetSlot(),
+			slot,
 			// TODO: this is hood.
 			max(
 				//#nosec:G701
 				uint64(time.Now().Unix()+1),
 				uint64((lph.GetTimestamp()+1)),
 			),
-			blk.GetParentBlockRoot(),
+			parentBlockRoot,
 			lph.GetBlockHash(),
 			lph.GetParentHash(),
 		)
@@ -0,0 +1,283 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator //nolint:testpackage // drives the unexported service.
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/stretchr/testify/require"
+)
+
+type testService = Service[
+	*types.BeaconBlock, *types.BeaconBlockBody, *fakeState,
+	*fakeSidecars, *fakeDepositStore, *types.ForkData,
+]
+
+func TestRequestBlockForProposalTimeout(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	timeout := 100 * time.Millisecond
+	s := newTestService(timeout, &fakePayloadBuilder{release: release})
+
+	start := time.Now()
+	_, _, err := s.RequestBlockForProposal(context.Background(), 1)
+	require.ErrorIs(t, err, ErrProposerTimeout)
+	require.Less(t, time.Since(start), timeout+time.Second)
+}
+
+func TestRequestBlockForProposalTimeoutCopiesState(t *testing.T) {
+	release := make(chan struct{})
+	synced := make(chan *fakeState, 1)
+	s := newTestService(
+		10*time.Millisecond,
+		&fakePayloadBuilder{release: release, synced: synced},
+	)
+
+	_, _, err := s.RequestBlockForProposal(context.Background(), 1)
+	require.ErrorIs(t, err, ErrProposerTimeout)
+
+	// The abandoned retrieval completes with a copy of the state rather
+	// than the state of the proposal.
+	close(release)
+	require.True(t, (<-synced).copied)
+}
+
+func TestRequestBlockForProposalWithinTimeout(t *testing.T) {
+	s := newTestService(time.Second, &fakePayloadBuilder{})
+
+	// The response of the execution client before the timeout is used.
+	_, _, err := s.RequestBlockForProposal(context.Background(), 1)
+	require.ErrorIs(t, err, errNoPayload)
+	require.NotErrorIs(t, err, ErrProposerTimeout)
+}
+
+func TestRequestBlockForProposalCanceled(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	s := newTestService(time.Minute, &fakePayloadBuilder{release: release})
+
+	ctx, cancel := context.WithTimeout(
+		context.Background(), 100*time.Millisecond,
+	)
+	defer cancel()
+	_, _, err := s.RequestBlockForProposal(ctx, 1)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.NotErrorIs(t, err, ErrProposerTimeout)
+}
+
+// newTestService returns a service building blocks with the given payload
+// builder, waiting for the payloads for up to the given timeout.
+func newTestService(
+	timeout time.Duration,
+	pb *fakePayloadBuilder,
+) *testService {
+	cfg := DefaultConfig()
+	cfg.ProposerTimeout = timeout
+	return NewService[
+		*types.BeaconBlock, *types.BeaconBlockBody, *fakeState,
+		*fakeSidecars, *fakeDepositStore, *types.ForkData,
+	](
+		&cfg,
+		noop.NewLogger(),
+		chain.NewChainSpec(chain.SpecData[
+			primitives.DomainType, math.Epoch, common.ExecutionAddress,
+			math.Slot, any,
+		]{
+			SlotsPerEpoch:          32,
+			SlotsPerHistoricalRoot: 8,
+			ElectraForkEpoch:       math.Epoch(^uint64(0)),
+		}),
+		&fakeStorageBackend{},
+		nil,
+		&fakeStateProcessor{},
+		&fakeSigner{},
+		nil,
+		pb,
+		nil,
+		&fakeTelemetrySink{},
+	)
+}
+
+// errNoPayload is returned by the execution client of a fakePayloadBuilder.
+var errNoPayload = errors.New("no payload")
+
+// fakePayloadBuilder is a payload builder whose execution client, if release
+// is set, does not respond until release is closed. The states payloads are
+// requested synchronously for are sent on synced, if set.
+type fakePayloadBuilder struct {
+	release chan struct{}
+	synced  chan *fakeState
+}
+
+func (*fakePayloadBuilder) Enabled() bool { return true }
+
+func (pb *fakePayloadBuilder) RetrievePayload(
+	context.Context, math.Slot, primitives.Root,
+) (engineprimitives.BuiltExecutionPayloadEnv[*types.ExecutionPayload], error) {
+	if pb.release != nil {
+		<-pb.release
+	}
+	return nil, errNoPayload
+}
+
+func (*fakePayloadBuilder) RequestPayloadAsync(
+	context.Context, *fakeState, math.Slot, uint64,
+	primitives.Root, common.ExecutionHash, common.ExecutionHash,
+) (*engineprimitives.PayloadID, error) {
+	return &engineprimitives.PayloadID{}, nil
+}
+
+func (pb *fakePayloadBuilder) RequestPayloadSync(
+	_ context.Context, st *fakeState, _ math.Slot, _ uint64,
+	_ primitives.Root, _ common.ExecutionHash, _ common.ExecutionHash,
+) (engineprimitives.BuiltExecutionPayloadEnv[*types.ExecutionPayload], error) {
+	if pb.synced != nil {
+		pb.synced <- st
+	}
+	return nil, errNoPayload
+}
+
+func (*fakePayloadBuilder) SendForceHeadFCU(
+	context.Context, *fakeState, math.Slot,
+) error {
+	return nil
+}
+
+// fakeState is a beacon state that records whether it is a copy.
+type fakeState struct {
+	copied bool
+}
+
+func (*fakeState) Copy() *fakeState { return &fakeState{copied: true} }
+
+func (*fakeState) GetBlockRootAtIndex(uint64) (primitives.Root, error) {
+	return primitives.Root{}, nil
+}
+
+func (*fakeState) GetLatestExecutionPayloadHeader() (
+	*types.ExecutionPayloadHeader, error,
+) {
+	return &types.ExecutionPayloadHeader{
+		InnerExecutionPayloadHeader: &types.ExecutionPayloadHeaderDeneb{},
+	}, nil
+}
+
+func (*fakeState) GetLatestBlockHeader() (*types.BeaconBlockHeader, error) {
+	return &types.BeaconBlockHeader{}, nil
+}
+
+func (*fakeState) GetSlot() (math.Slot, error) { return 0, nil }
+
+func (*fakeState) HashTreeRoot() ([32]byte, error) { return [32]byte{}, nil }
+
+func (*fakeState) ValidatorIndexByPubkey(
+	crypto.BLSPubkey,
+) (math.ValidatorIndex, error) {
+	return 0, nil
+}
+
+func (*fakeState) GetEth1DepositIndex() (uint64, error) { return 0, nil }
+
+func (*fakeState) GetGenesisValidatorsRoot() (primitives.Root, error) {
+	return primitives.Root{}, nil
+}
+
+type fakeSidecars struct{}
+
+func (*fakeSidecars) MarshalSSZTo(b []byte) ([]byte, error) { return b, nil }
+
+func (*fakeSidecars) MarshalSSZ() ([]byte, error) { return nil, nil }
+
+func (*fakeSidecars) UnmarshalSSZ([]byte) error { return nil }
+
+func (*fakeSidecars) SizeSSZ() int { return 0 }
+
+func (*fakeSidecars) HashTreeRoot() ([32]byte, error) {
+	return [32]byte{}, nil
+}
+
+func (*fakeSidecars) IsNil() bool { return false }
+
+func (*fakeSidecars) Len() int { return 0 }
+
+type fakeDepositStore struct{}
+
+func (*fakeDepositStore) GetDepositsByIndex(
+	uint64, uint64,
+) ([]*types.Deposit, error) {
+	return nil, nil
+}
+
+type fakeStorageBackend struct{}
+
+func (*fakeStorageBackend) DepositStore(context.Context) *fakeDepositStore {
+	return &fakeDepositStore{}
+}
+
+func (*fakeStorageBackend) StateFromContext(context.Context) *fakeState {
+	return &fakeState{}
+}
+
+type fakeStateProcessor struct{}
+
+func (*fakeStateProcessor) ProcessSlots(
+	*fakeState, math.Slot,
+) ([]*transition.ValidatorUpdate, error) {
+	return nil, nil
+}
+
+func (*fakeStateProcessor) Transition(
+	*transition.Context, *fakeState, *types.BeaconBlock,
+) ([]*transition.ValidatorUpdate, error) {
+	return nil, nil
+}
+
+type fakeSigner struct{}
+
+func (*fakeSigner) PublicKey() crypto.BLSPubkey { return crypto.BLSPubkey{} }
+
+func (*fakeSigner) Sign([]byte) (crypto.BLSSignature, error) {
+	return crypto.BLSSignature{}, nil
+}
+
+func (*fakeSigner) VerifySignature(
+	crypto.BLSPubkey, []byte, crypto.BLSSignature,
+) error {
+	return nil
+}
+
+type fakeTelemetrySink struct{}
+
+func (*fakeTelemetrySink) IncrementCounter(string, ...string) {}
+
+func (*fakeTelemetrySink) MeasureSince(string, time.Time, ...string) {}
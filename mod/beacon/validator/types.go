@@ -62,6 +62,9 @@ type BeaconBlock[BeaconBlockT any, BeaconBlockBodyT BeaconBlockBody[
 	SetStateRoot(common.Root)
 	// GetStateRoot returns the state root of the beacon block.
 	GetStateRoot() common.Root
+	// CheckSize returns an error if the beacon block exceeds the maximum
+	// block size.
+	CheckSize(cs primitives.ChainSpec) error
 
 	// GetBody returns the body of the beacon block.
 	GetBody() BeaconBlockBodyT
@@ -0,0 +1,156 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// socketFlag is the flag for the path of the admin socket.
+	socketFlag = "socket"
+	// socketFlagMsg is the usage description for the socketFlag flag.
+	socketFlagMsg = "path of the unix socket the admin server of the node " +
+		"listens on"
+
+	// requestTimeout is the timeout of the requests to the admin server.
+	requestTimeout = 10 * time.Second
+)
+
+// ErrUnexpectedResponse is returned when the admin server does not respond
+// with the pause status of the node.
+var ErrUnexpectedResponse = errors.New("unexpected response")
+
+// Command creates a new command for controlling the block processing of a
+// running node through its admin socket.
+func Command() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "admin",
+		Short: "Controls the block processing of the running node",
+		Long: `Controls the block processing of the running node through the
+unix socket its admin server listens on. While paused, the node does not
+propose blocks, and the blocks delivered to it are buffered, up to a cap, and
+applied in order once it is resumed.`,
+	}
+
+	cmd.PersistentFlags().String(socketFlag, "", socketFlagMsg)
+	if err := cmd.MarkPersistentFlagRequired(socketFlag); err != nil {
+		panic(err)
+	}
+
+	cmd.AddCommand(
+		&cobra.Command{
+			Use:   "pause",
+			Short: "Pauses block processing",
+			Args:  cobra.NoArgs,
+			RunE:  run(http.MethodPost, "/pause"),
+		},
+		&cobra.Command{
+			Use:   "resume",
+			Short: "Resumes block processing",
+			Args:  cobra.NoArgs,
+			RunE:  run(http.MethodPost, "/resume"),
+		},
+		&cobra.Command{
+			Use:   "status",
+			Short: "Prints whether block processing is paused",
+			Args:  cobra.NoArgs,
+			RunE:  run(http.MethodGet, "/status"),
+		},
+	)
+	return cmd
+}
+
+// run returns a function that performs a request with the given method
+// against the given path of the admin server and prints the pause status it
+// responds with.
+func run(
+	method, path string,
+) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, _ []string) error {
+		socketPath, err := cmd.Flags().GetString(socketFlag)
+		if err != nil {
+			return err
+		}
+		cmd.SilenceUsage = true
+
+		status, err := request(cmd.Context(), socketPath, method, path)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(
+			cmd.OutOrStdout(),
+			"paused:  %t\nwaiting: %t\n",
+			status.Paused,
+			status.Waiting,
+		)
+		return err
+	}
+}
+
+// request performs a request with the given method against the given path
+// of the admin server listening on the given socket.
+func request(
+	ctx context.Context, socketPath, method, path string,
+) (types.PauseStatus, error) {
+	var status types.PauseStatus
+	req, err := http.NewRequestWithContext(
+		ctx, method, "http://admin"+path, http.NoBody,
+	)
+	if err != nil {
+		return status, err
+	}
+	client := &http.Client{
+		Timeout: requestTimeout,
+		Transport: &http.Transport{
+			DialContext: func(
+				ctx context.Context, _, _ string,
+			) (net.Conn, error) {
+				var d net.Dialer
+				return d.DialContext(ctx, "unix", socketPath)
+			},
+		},
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return status, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return status, errors.Wrapf(
+			ErrUnexpectedResponse, "status %s", resp.Status,
+		)
+	}
+	if err = json.NewDecoder(resp.Body).Decode(&status); err != nil {
+		return status, errors.Wrap(ErrUnexpectedResponse, err.Error())
+	}
+	return status, nil
+}
@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package admin_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/admin"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+// serve serves the given handler on a unix socket and returns the path of
+// the socket.
+func serve(t *testing.T, handler http.Handler) string {
+	t.Helper()
+	socketPath := filepath.Join(t.TempDir(), "admin.sock")
+	var lc net.ListenConfig
+	ln, err := lc.Listen(context.Background(), "unix", socketPath)
+	require.NoError(t, err)
+
+	srv := &http.Server{
+		Handler:           handler,
+		ReadHeaderTimeout: time.Second,
+	}
+	go func() { _ = srv.Serve(ln) }()
+	t.Cleanup(func() { require.NoError(t, srv.Close()) })
+	return socketPath
+}
+
+// runAdmin executes the admin command with the given arguments and returns
+// its output.
+func runAdmin(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+	cmd := admin.Command()
+	out := new(bytes.Buffer)
+	cmd.SetOut(out)
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs(args)
+	err := cmd.ExecuteContext(context.Background())
+	return out.String(), err
+}
+
+func TestAdmin(t *testing.T) {
+	var status types.PauseStatus
+	write := func(w http.ResponseWriter) {
+		if err := json.NewEncoder(w).Encode(status); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /pause", func(w http.ResponseWriter, _ *http.Request) {
+		status = types.PauseStatus{Paused: true, Waiting: true}
+		write(w)
+	})
+	mux.HandleFunc(
+		"POST /resume", func(w http.ResponseWriter, _ *http.Request) {
+			status = types.PauseStatus{}
+			write(w)
+		},
+	)
+	mux.HandleFunc("GET /status", func(w http.ResponseWriter, _ *http.Request) {
+		write(w)
+	})
+	socketPath := serve(t, mux)
+
+	out, err := runAdmin(t, "status", "--socket", socketPath)
+	require.NoError(t, err)
+	require.Equal(t, "paused:  false\nwaiting: false\n", out)
+
+	out, err = runAdmin(t, "pause", "--socket", socketPath)
+	require.NoError(t, err)
+	require.Equal(t, "paused:  true\nwaiting: true\n", out)
+
+	out, err = runAdmin(t, "resume", "--socket", socketPath)
+	require.NoError(t, err)
+	require.Equal(t, "paused:  false\nwaiting: false\n", out)
+}
+
+func TestAdminUnexpectedResponse(t *testing.T) {
+	socketPath := serve(t, http.NotFoundHandler())
+
+	out, err := runAdmin(t, "pause", "--socket", socketPath)
+	require.ErrorIs(t, err, admin.ErrUnexpectedResponse)
+	require.Empty(t, out)
+}
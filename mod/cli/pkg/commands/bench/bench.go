@@ -0,0 +1,197 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package bench
+
+import (
+	"fmt"
+	"os"
+	"runtime/pprof"
+	"slices"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/debug"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// blocksFlag is the flag for the number of blocks to apply.
+	blocksFlag = "blocks"
+	// blocksFlagMsg is the usage description for the blocksFlag flag.
+	blocksFlagMsg = "number of synthetic blocks to apply"
+	// defaultBlocks is the default value for the blocksFlag flag.
+	defaultBlocks = 1000
+
+	// profileFlag is the flag for the file the CPU profile is written to.
+	profileFlag = "profile"
+	// profileFlagMsg is the usage description for the profileFlag flag.
+	profileFlagMsg = "file to write a pprof CPU profile of the benchmark to"
+)
+
+// ErrInvalidBlocks is returned when the number of blocks to apply is not
+// positive.
+var ErrInvalidBlocks = errors.New("number of blocks must be positive")
+
+// syntheticKey is the key of the single validator of the synthetic chain.
+//
+//nolint:gochecknoglobals // fixed key of a throwaway chain.
+var syntheticKey = signer.LegacyKey{31: 1}
+
+// Commands creates a new command for benchmarking the node.
+func Commands(cs primitives.ChainSpec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "bench",
+		Short:                      "benchmarking subcommands",
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2, //nolint:mnd // from sdk.
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewStateTransitionCommand(cs),
+	)
+
+	return cmd
+}
+
+// NewStateTransitionCommand creates a new command for benchmarking the state
+// transition.
+func NewStateTransitionCommand(cs primitives.ChainSpec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "state-transition",
+		Short: "Benchmarks the throughput of the state transition",
+		Long: `Applies synthetic beacon blocks to a beacon state kept in memory,
+which is initialized from a genesis with a single validator, and prints the
+throughput and latency of the state transition. The blocks go through the
+same state transition as blocks received from the network, including the
+computation of the resulting state root, but their payloads are not verified
+against an execution client. Only applying the blocks is timed, building
+them is not.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			blocks, err := cmd.Flags().GetInt(blocksFlag)
+			if err != nil {
+				return err
+			}
+			if blocks < 1 {
+				return errors.Wrapf(ErrInvalidBlocks, "got %d", blocks)
+			}
+			profile, err := cmd.Flags().GetString(profileFlag)
+			if err != nil {
+				return err
+			}
+
+			cmd.SilenceUsage = true
+			latencies, err := benchStateTransition(cmd, cs, blocks, profile)
+			if err != nil {
+				return err
+			}
+			return printSummary(cmd, latencies)
+		},
+	}
+
+	cmd.Flags().Int(blocksFlag, defaultBlocks, blocksFlagMsg)
+	cmd.Flags().String(profileFlag, "", profileFlagMsg)
+	return cmd
+}
+
+// benchStateTransition applies the given number of synthetic blocks and
+// returns the time each of them took to be applied. The CPU is profiled
+// into the given file while the blocks are applied, if it is set.
+func benchStateTransition(
+	cmd *cobra.Command,
+	cs primitives.ChainSpec,
+	blocks int,
+	profile string,
+) ([]time.Duration, error) {
+	blsSigner, err := signer.NewLegacySigner(syntheticKey)
+	if err != nil {
+		return nil, err
+	}
+	genesisData, err := debug.NewSyntheticGenesis(cs, blsSigner)
+	if err != nil {
+		return nil, err
+	}
+	r, err := debug.NewReplayer(cs, genesisData)
+	if err != nil {
+		return nil, err
+	}
+
+	if profile != "" {
+		var f *os.File
+		if f, err = os.Create(profile); err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		if err = pprof.StartCPUProfile(f); err != nil {
+			return nil, err
+		}
+		defer pprof.StopCPUProfile()
+	}
+
+	latencies := make([]time.Duration, 0, blocks)
+	for range blocks {
+		blk, blkErr := r.NextBlock(blsSigner)
+		if blkErr != nil {
+			return nil, blkErr
+		}
+		start := time.Now()
+		if _, err = r.ApplyUnverified(cmd.Context(), blk); err != nil {
+			return nil, errors.Wrapf(
+				err, "failed to apply block at slot %d", blk.GetSlot(),
+			)
+		}
+		latencies = append(latencies, time.Since(start))
+	}
+	return latencies, nil
+}
+
+// printSummary prints the throughput and latency percentiles of the given
+// latencies of applied blocks.
+func printSummary(cmd *cobra.Command, latencies []time.Duration) error {
+	var total time.Duration
+	for _, d := range latencies {
+		total += d
+	}
+	slices.Sort(latencies)
+
+	_, err := fmt.Fprintf(
+		cmd.OutOrStdout(),
+		"blocks:      %d\ntotal:       %s\nblocks/sec:  %.2f\n"+
+			"p50 latency: %s\np99 latency: %s\n",
+		len(latencies),
+		total,
+		float64(len(latencies))/total.Seconds(),
+		percentile(latencies, 50), //nolint:mnd // median.
+		percentile(latencies, 99), //nolint:mnd // tail latency.
+	)
+	return err
+}
+
+// percentile returns the p-th percentile of the given sorted durations,
+// using the nearest-rank method.
+func percentile(sorted []time.Duration, p int) time.Duration {
+	rank := (p*len(sorted) + 99) / 100 //nolint:mnd // ceil of p% of n.
+	return sorted[max(rank, 1)-1]
+}
@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package bench //nolint:testpackage // tests the unexported percentiles.
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/config/spec"
+	"github.com/stretchr/testify/require"
+)
+
+// runBench executes the bench command with the given arguments and returns
+// its output.
+func runBench(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+	cmd := Commands(spec.TestnetChainSpec())
+	out := new(bytes.Buffer)
+	cmd.SetOut(out)
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs(args)
+	err := cmd.Execute()
+	return out.String(), err
+}
+
+func TestStateTransition(t *testing.T) {
+	profile := filepath.Join(t.TempDir(), "cpu.out")
+	out, err := runBench(
+		t, "state-transition", "--blocks", "3", "--profile", profile,
+	)
+	require.NoError(t, err)
+	require.Contains(t, out, "blocks:      3\n")
+	require.Contains(t, out, "blocks/sec:")
+	require.Contains(t, out, "p99 latency:")
+
+	info, err := os.Stat(profile)
+	require.NoError(t, err)
+	require.Positive(t, info.Size())
+}
+
+func TestStateTransitionInvalidBlocks(t *testing.T) {
+	_, err := runBench(t, "state-transition", "--blocks", "0")
+	require.ErrorIs(t, err, ErrInvalidBlocks)
+}
+
+func TestPercentile(t *testing.T) {
+	sorted := make([]time.Duration, 100)
+	for i := range sorted {
+		sorted[i] = time.Duration(i + 1)
+	}
+	require.Equal(t, time.Duration(50), percentile(sorted, 50))
+	require.Equal(t, time.Duration(99), percentile(sorted, 99))
+	require.Equal(t, time.Duration(1), percentile(sorted, 0))
+	require.Equal(t, time.Duration(7), percentile(sorted[:7], 99))
+}
@@ -0,0 +1,129 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+
+	confixcmd "cosmossdk.io/tools/confix/cmd"
+	"github.com/berachain/beacon-kit/mod/errors"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// outFlag is the flag for the directory the configs are written to.
+	outFlag = "out"
+	// outFlagMsg is the usage description for the outFlag flag.
+	outFlagMsg = "directory to write app.toml and config.toml to"
+	// defaultOut is the default value for the outFlag flag.
+	defaultOut = "./config"
+
+	// AppConfigFileName is the name of the file the app config is written to.
+	AppConfigFileName = "app.toml"
+	// CometConfigFileName is the name of the file the CometBFT config is
+	// written to.
+	CometConfigFileName = "config.toml"
+)
+
+// Defaults are the configs the node is started with if no config files are
+// present in its home directory.
+type Defaults struct {
+	// AppConfig is the app config, which is rendered into app.toml.
+	AppConfig any
+	// AppConfigTemplate is the template app.toml is rendered with.
+	AppConfigTemplate string
+	// CometConfig is the CometBFT config, which is rendered into config.toml.
+	CometConfig *cmtcfg.Config
+}
+
+// Commands creates the config command of confix, extended by a command to
+// export the default configs of the node.
+func Commands(defaults Defaults) *cobra.Command {
+	cmd := confixcmd.ConfigCommand()
+	cmd.AddCommand(NewExportCommand(defaults))
+	return cmd
+}
+
+// NewExportCommand creates a new command for writing the default configs of
+// the node to disk.
+func NewExportCommand(defaults Defaults) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Writes the default app.toml and config.toml to disk",
+		Long: `Writes the app.toml and config.toml the node is started with if
+none are present in its home directory, such that they can be edited before
+the node is started for the first time. Existing files are not overwritten.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			out, err := cmd.Flags().GetString(outFlag)
+			if err != nil {
+				return err
+			}
+			cmd.SilenceUsage = true
+
+			if err = Export(defaults, out); err != nil {
+				return err
+			}
+			cmd.Printf("exported %s and %s to %s\n",
+				AppConfigFileName, CometConfigFileName, out)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(outFlag, defaultOut, outFlagMsg)
+	return cmd
+}
+
+// Export renders the given default configs into app.toml and config.toml in
+// the given directory, which is created if it does not exist. It fails with
+// ErrConfigFileExists if either of the files is already present.
+func Export(defaults Defaults, dir string) error {
+	appPath := filepath.Join(dir, AppConfigFileName)
+	cometPath := filepath.Join(dir, CometConfigFileName)
+	for _, path := range []string{appPath, cometPath} {
+		if _, err := os.Stat(path); err == nil {
+			return errors.Wrapf(ErrConfigFileExists, "%s", path)
+		} else if !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	if err := os.MkdirAll(dir, cmtcfg.DefaultDirPerm); err != nil {
+		return err
+	}
+
+	if err := serverconfig.SetConfigTemplate(
+		defaults.AppConfigTemplate,
+	); err != nil {
+		return err
+	}
+	if err := serverconfig.WriteConfigFile(
+		appPath, defaults.AppConfig,
+	); err != nil {
+		return err
+	}
+
+	cmtcfg.WriteConfigFile(cometPath, defaults.CometConfig)
+	return nil
+}
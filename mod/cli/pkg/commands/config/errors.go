@@ -18,11 +18,10 @@
 // MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
 // TITLE.
 
-package components
+package config
 
-import "github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
+import "github.com/berachain/beacon-kit/mod/errors"
 
-// ProvideTelemetrySink is a function that provides a TelemetrySink.
-func ProvideTelemetrySink() *metrics.TelemetrySink {
-	return &metrics.TelemetrySink{}
-}
+// ErrConfigFileExists is returned when a config file that is to be exported
+// is already present.
+var ErrConfigFileExists = errors.New("config file already exists")
@@ -0,0 +1,259 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package da
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// slotFlag is the flag for the slot to inspect.
+	slotFlag = "slot"
+	// slotFlagMsg is the usage description for the slotFlag flag.
+	slotFlagMsg = "slot to inspect the blob sidecars of"
+
+	// outFlag is the flag for the file the sidecars are exported to.
+	outFlag = "out"
+	// outFlagMsg is the usage description for the outFlag flag.
+	outFlagMsg = "file to export the blob sidecars to"
+
+	// inFlag is the flag for the file the sidecars are imported from.
+	inFlag = "in"
+	// inFlagMsg is the usage description for the inFlag flag.
+	inFlagMsg = "file to import the blob sidecars from"
+
+	// archiveFileMode is the mode of the file the sidecars are exported to.
+	archiveFileMode = 0o600
+)
+
+// Commands creates a new command for inspecting the availability store of
+// the node, and for verifying blob sidecars against the given chain spec.
+func Commands(
+	cs primitives.ChainSpec,
+	newStore StoreCreator,
+) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "da",
+		Short:                      "data availability subcommands",
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2, //nolint:mnd // from sdk.
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewExportCommand(newStore),
+		NewImportCommand(newStore),
+		NewInspectCommand(newStore),
+		NewVerifyCommand(cs),
+	)
+
+	return cmd
+}
+
+// NewExportCommand creates a new command for exporting the blob sidecars of
+// the availability store to an archive.
+func NewExportCommand(newStore StoreCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Exports the blob sidecars to an archive",
+		Long: `Exports all the blob sidecars stored in the availability store of
+the node to a gzip compressed tar archive, keyed by the slots they are stored
+for and ending with a checksum of its content. The archive can be imported
+into the availability store of another node with the import command.`,
+		Args: cobra.NoArgs,
+		RunE: exportSidecars(newStore),
+	}
+
+	cmd.Flags().String(outFlag, "", outFlagMsg)
+	if err := cmd.MarkFlagRequired(outFlag); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
+// NewImportCommand creates a new command for importing the blob sidecars of
+// an archive into the availability store.
+func NewImportCommand(newStore StoreCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Imports the blob sidecars of an archive",
+		Long: `Imports the blob sidecars of an archive written by the export
+command into the availability store of the node, under the slots they were
+exported from. The availability store must be empty, and the checksum of the
+archive is verified, with nothing imported if it does not match.`,
+		Args: cobra.NoArgs,
+		RunE: importSidecars(newStore),
+	}
+
+	cmd.Flags().String(inFlag, "", inFlagMsg)
+	if err := cmd.MarkFlagRequired(inFlag); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
+// exportSidecars exports the blob sidecars of the availability store to the
+// file given by the out flag. The file is removed if the export fails.
+func exportSidecars(
+	newStore StoreCreator,
+) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, _ []string) error {
+		out, err := cmd.Flags().GetString(outFlag)
+		if err != nil {
+			return err
+		}
+		cmd.SilenceUsage = true
+
+		serverCtx := server.GetServerContextFromCmd(cmd)
+		store, err := newStore(serverCtx.Logger, serverCtx.Viper)
+		if err != nil {
+			return err
+		}
+
+		f, err := os.OpenFile(
+			out, os.O_WRONLY|os.O_CREATE|os.O_EXCL, archiveFileMode,
+		)
+		if err != nil {
+			return err
+		}
+		w := bufio.NewWriter(f)
+		if err = store.Export(cmd.Context(), w); err == nil {
+			err = w.Flush()
+		}
+		if err = errors.Join(err, f.Close()); err != nil {
+			return errors.Join(err, os.Remove(out))
+		}
+		return nil
+	}
+}
+
+// importSidecars imports the blob sidecars of the archive given by the in
+// flag into the availability store.
+func importSidecars(
+	newStore StoreCreator,
+) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, _ []string) error {
+		in, err := cmd.Flags().GetString(inFlag)
+		if err != nil {
+			return err
+		}
+		cmd.SilenceUsage = true
+
+		serverCtx := server.GetServerContextFromCmd(cmd)
+		store, err := newStore(serverCtx.Logger, serverCtx.Viper)
+		if err != nil {
+			return err
+		}
+
+		f, err := os.Open(in)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		return store.Import(cmd.Context(), f)
+	}
+}
+
+// NewInspectCommand creates a new command for inspecting the blob sidecars
+// stored for a slot.
+func NewInspectCommand(newStore StoreCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "inspect",
+		Short: "Prints the blob sidecars stored for the given slot",
+		Long: `Prints whether blob sidecars are stored for the given slot in the
+availability store of the node, along with the index, KZG commitment and
+versioned hash of each of them.`,
+		Args: cobra.NoArgs,
+		RunE: inspectSlot(newStore),
+	}
+
+	cmd.Flags().Uint64(slotFlag, 0, slotFlagMsg)
+	if err := cmd.MarkFlagRequired(slotFlag); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
+// inspectSlot reads the blob sidecars stored for the slot given by the slot
+// flag from the availability store and prints them.
+func inspectSlot(
+	newStore StoreCreator,
+) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, _ []string) error {
+		s, err := cmd.Flags().GetUint64(slotFlag)
+		if err != nil {
+			return err
+		}
+		slot := math.Slot(s)
+		cmd.SilenceUsage = true
+
+		serverCtx := server.GetServerContextFromCmd(cmd)
+		store, err := newStore(serverCtx.Logger, serverCtx.Viper)
+		if err != nil {
+			return err
+		}
+		sidecars, err := store.GetSidecarsRange(cmd.Context(), slot, slot)
+		if err != nil {
+			return err
+		}
+
+		out := cmd.OutOrStdout()
+		stored, found := sidecars[slot]
+		if !found || stored.IsNil() || stored.Len() == 0 {
+			_, err = fmt.Fprintf(out, "no sidecars for slot %d\n", slot)
+			return err
+		}
+
+		if _, err = fmt.Fprintf(
+			out,
+			"slot:     %d\n"+
+				"sidecars: %d\n",
+			slot,
+			stored.Len(),
+		); err != nil {
+			return err
+		}
+		for _, sc := range stored.Sidecars {
+			if _, err = fmt.Fprintf(
+				out,
+				"index: %d\n"+
+					"  commitment:     %s\n"+
+					"  versioned_hash: %s\n",
+				sc.Index,
+				bytes.B48(sc.KzgCommitment),
+				bytes.B32(sc.KzgCommitment.ToVersionedHash()),
+			); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
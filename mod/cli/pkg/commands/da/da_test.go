@@ -0,0 +1,206 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package da_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/da"
+	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	dastore "github.com/berachain/beacon-kit/mod/da/pkg/store"
+	datypes "github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/config/spec"
+	bytespkg "github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/filedb"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestSidecars returns sidecars included at the given slot with the given
+// KZG commitments, indexed in order. The inclusion proof of each sidecar is
+// valid for the body root of its block header.
+func newTestSidecars(
+	slot math.Slot,
+	commitments ...eip4844.KZGCommitment,
+) *datypes.BlobSidecars {
+	kzgOffset := ctypes.BlockBodyKZGOffset(slot, spec.DevnetChainSpec())
+	sidecars := make([]*datypes.BlobSidecar, len(commitments))
+	for i, commitment := range commitments {
+		sidecars[i] = &datypes.BlobSidecar{
+			Index:         uint64(i),
+			KzgCommitment: commitment,
+			BeaconBlockHeader: &ctypes.BeaconBlockHeader{
+				BeaconBlockHeaderBase: ctypes.BeaconBlockHeaderBase{
+					Slot: slot.Unwrap(),
+				},
+			},
+			InclusionProof: make([][32]byte, 8),
+		}
+		leaf, err := commitment.HashTreeRoot()
+		if err != nil {
+			panic(err)
+		}
+		sidecars[i].BeaconBlockHeader.BodyRoot = merkle.RootFromBranch(
+			leaf, sidecars[i].InclusionProof, 8, kzgOffset+uint64(i),
+		)
+	}
+	return &datypes.BlobSidecars{Sidecars: sidecars}
+}
+
+// newTestStore returns an in-memory availability store seeded with the given
+// sidecars.
+func newTestStore(
+	t *testing.T,
+	seed map[math.Slot]*datypes.BlobSidecars,
+) *dastore.Store[*ctypes.BeaconBlockBody] {
+	t.Helper()
+	store := dastore.New[*ctypes.BeaconBlockBody](
+		filedb.NewRangeDB(
+			filedb.NewInMemoryDB(filedb.WithLogger(log.NewNopLogger())),
+		),
+		log.NewNopLogger(),
+		spec.DevnetChainSpec(),
+	)
+	for slot, sidecars := range seed {
+		require.NoError(t, store.Persist(slot, sidecars))
+	}
+	return store
+}
+
+// runCommand executes the command created by newCmd against the given
+// availability store and returns its output.
+func runCommand(
+	newCmd func(da.StoreCreator) *cobra.Command,
+	store da.AvailabilityStore,
+	args ...string,
+) ([]byte, error) {
+	cmd := newCmd(func(
+		log.Logger, servertypes.AppOptions,
+	) (da.AvailabilityStore, error) {
+		return store, nil
+	})
+	out := new(bytes.Buffer)
+	cmd.SetOut(out)
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs(args)
+	err := cmd.ExecuteContext(context.WithValue(
+		context.Background(),
+		server.ServerContextKey,
+		server.NewDefaultContext(),
+	))
+	return out.Bytes(), err
+}
+
+// runInspect executes the inspect command against an in-memory availability
+// store seeded with the given sidecars and returns its output.
+func runInspect(
+	t *testing.T,
+	seed map[math.Slot]*datypes.BlobSidecars,
+	args ...string,
+) ([]byte, error) {
+	t.Helper()
+	return runCommand(da.NewInspectCommand, newTestStore(t, seed), args...)
+}
+
+func TestInspect(t *testing.T) {
+	commitments := []eip4844.KZGCommitment{{1}, {2}}
+	seed := map[math.Slot]*datypes.BlobSidecars{
+		3: newTestSidecars(3, commitments...),
+		4: newTestSidecars(4, eip4844.KZGCommitment{3}),
+	}
+
+	out, err := runInspect(t, seed, "--slot", "3")
+	require.NoError(t, err)
+	require.Contains(t, string(out), "slot:     3\n")
+	require.Contains(t, string(out), "sidecars: 2\n")
+	for i, commitment := range commitments {
+		versionedHash := commitment.ToVersionedHash()
+		require.Contains(t, string(out), fmt.Sprintf(
+			"index: %d\n"+
+				"  commitment:     %s\n"+
+				"  versioned_hash: %s\n",
+			i,
+			bytespkg.B48(commitment),
+			bytespkg.B32(versionedHash),
+		))
+	}
+	require.NotContains(t, string(out), bytespkg.B48{3}.String())
+}
+
+func TestInspectNoSidecars(t *testing.T) {
+	seed := map[math.Slot]*datypes.BlobSidecars{
+		3: newTestSidecars(3, eip4844.KZGCommitment{1}),
+	}
+
+	out, err := runInspect(t, seed, "--slot", "5")
+	require.NoError(t, err)
+	require.Equal(t, "no sidecars for slot 5\n", string(out))
+}
+
+func TestInspectRequiresSlot(t *testing.T) {
+	_, err := runInspect(t, nil)
+	require.Error(t, err)
+}
+
+func TestExportImport(t *testing.T) {
+	ctx := context.Background()
+	src := newTestStore(t, map[math.Slot]*datypes.BlobSidecars{
+		3: newTestSidecars(3, eip4844.KZGCommitment{1}, eip4844.KZGCommitment{2}),
+		4: newTestSidecars(4, eip4844.KZGCommitment{3}),
+	})
+	archive := filepath.Join(t.TempDir(), "da.tar")
+	_, err := runCommand(da.NewExportCommand, src, "--out", archive)
+	require.NoError(t, err)
+
+	// Existing files are not overwritten.
+	_, err = runCommand(da.NewExportCommand, src, "--out", archive)
+	require.ErrorIs(t, err, os.ErrExist)
+
+	dst := newTestStore(t, nil)
+	_, err = runCommand(da.NewImportCommand, dst, "--in", archive)
+	require.NoError(t, err)
+
+	want, err := src.GetSidecarsRange(ctx, 0, 10)
+	require.NoError(t, err)
+	got, err := dst.GetSidecarsRange(ctx, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	require.Equal(t, want, got)
+
+	// The sidecars are imported under the slots they were exported from.
+	out, err := runCommand(da.NewInspectCommand, dst, "--slot", "4")
+	require.NoError(t, err)
+	require.Contains(t, string(out), "sidecars: 1\n")
+
+	_, err = runCommand(da.NewImportCommand, dst, "--in", archive)
+	require.ErrorIs(t, err, dastore.ErrStoreNotEmpty)
+}
@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package da
+
+import (
+	"context"
+	"io"
+
+	"cosmossdk.io/log"
+	datypes "github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+// AvailabilityStore is the store the blob sidecars are read from.
+type AvailabilityStore interface {
+	// GetSidecarsRange returns the sidecars stored for the slots in
+	// [startSlot, endSlot], keyed by slot.
+	GetSidecarsRange(
+		ctx context.Context,
+		startSlot, endSlot math.Slot,
+	) (map[math.Slot]*datypes.BlobSidecars, error)
+	// Export writes all the sidecars of the store to w as a compressed
+	// archive ending with a checksum.
+	Export(ctx context.Context, w io.Writer) error
+	// Import reads the sidecars of an archive written by Export from r into
+	// the store, which must be empty.
+	Import(ctx context.Context, r io.Reader) error
+}
+
+// StoreCreator creates the availability store of the node.
+type StoreCreator func(
+	logger log.Logger,
+	appOpts servertypes.AppOptions,
+) (AvailabilityStore, error)
@@ -0,0 +1,240 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package da
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/berachain/beacon-kit/mod/cli/pkg/utils/parser"
+	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg"
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg/gokzg"
+	datypes "github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	gokzg4844 "github.com/crate-crypto/go-kzg-4844"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// sidecarsFlag is the flag for the file the sidecars are verified from.
+	sidecarsFlag = "sidecars"
+	// sidecarsFlagMsg is the usage description for the sidecarsFlag flag.
+	sidecarsFlagMsg = "file holding the SSZ encoded blob sidecars to verify"
+
+	// blockBodyRootFlag is the flag for the root of the block body the
+	// sidecars are verified against.
+	blockBodyRootFlag = "block-body-root"
+	// blockBodyRootFlagMsg is the usage description for the
+	// blockBodyRootFlag flag.
+	blockBodyRootFlagMsg = "hex encoded root of the block body the blobs " +
+		"must be included in"
+
+	// trustedSetupFlag is the flag for the trusted setup the KZG proofs are
+	// verified with.
+	trustedSetupFlag = "trusted-setup"
+	// trustedSetupFlagMsg is the usage description for the trustedSetupFlag
+	// flag.
+	trustedSetupFlagMsg = "path to the KZG trusted setup, if unset the " +
+		"trusted setup of the Ethereum KZG ceremony is used"
+)
+
+// ErrInvalidSidecars is returned by the verify command when at least one of
+// the verified sidecars is invalid.
+var ErrInvalidSidecars = errors.New("invalid blob sidecars")
+
+// NewVerifyCommand creates a new command for verifying the blob sidecars of a
+// file against the root of a block body, without a running node.
+func NewVerifyCommand(cs primitives.ChainSpec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify",
+		Short: "Verifies the blob sidecars of a file",
+		Long: `Verifies the SSZ encoded blob sidecars of a file, as received
+out-of-band, against the root of the block body they are included in. The
+sidecars must be within the limits of the chain spec, and the KZG proof and
+the inclusion proof of each of them is verified, printing whether each blob
+passed. The command fails if any of the blobs did not pass.`,
+		Args: cobra.NoArgs,
+		RunE: verifySidecars(cs),
+	}
+
+	cmd.Flags().String(sidecarsFlag, "", sidecarsFlagMsg)
+	cmd.Flags().String(blockBodyRootFlag, "", blockBodyRootFlagMsg)
+	cmd.Flags().String(trustedSetupFlag, "", trustedSetupFlagMsg)
+	for _, flag := range []string{sidecarsFlag, blockBodyRootFlag} {
+		if err := cmd.MarkFlagRequired(flag); err != nil {
+			panic(err)
+		}
+	}
+	return cmd
+}
+
+// verifySidecars verifies the blob sidecars of the file given by the sidecars
+// flag against the block body root given by the block-body-root flag.
+func verifySidecars(
+	cs primitives.ChainSpec,
+) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, _ []string) error {
+		path, err := cmd.Flags().GetString(sidecarsFlag)
+		if err != nil {
+			return err
+		}
+		root, err := cmd.Flags().GetString(blockBodyRootFlag)
+		if err != nil {
+			return err
+		}
+		bodyRoot, err := parser.ConvertRoot(root)
+		if err != nil {
+			return errors.Wrapf(err, "invalid %s", blockBodyRootFlag)
+		}
+		setupPath, err := cmd.Flags().GetString(trustedSetupFlag)
+		if err != nil {
+			return err
+		}
+		cmd.SilenceUsage = true
+
+		sidecars, err := readSidecars(path, cs)
+		if err != nil {
+			return err
+		}
+		proofVerifier, err := newProofVerifier(setupPath)
+		if err != nil {
+			return err
+		}
+
+		invalid, err := reportSidecars(
+			cmd.OutOrStdout(), cs, proofVerifier, sidecars, bodyRoot,
+		)
+		if err != nil {
+			return err
+		}
+		if invalid > 0 {
+			return errors.Wrapf(
+				ErrInvalidSidecars, "%d of %d blobs did not pass",
+				invalid, sidecars.Len(),
+			)
+		}
+		return nil
+	}
+}
+
+// reportSidecars verifies each of the given sidecars against the block body
+// with the given root, writing whether it passed to w, and returns the number
+// of sidecars that did not pass.
+func reportSidecars(
+	w io.Writer,
+	cs primitives.ChainSpec,
+	proofVerifier kzg.BlobProofVerifier,
+	sidecars *datypes.BlobSidecars,
+	bodyRoot common.Root,
+) (int, error) {
+	var invalid int
+	for _, sc := range sidecars.Sidecars {
+		result := "ok"
+		if err := verifySidecar(cs, proofVerifier, sc, bodyRoot); err != nil {
+			result = "invalid: " + err.Error()
+			invalid++
+		}
+		if _, err := fmt.Fprintf(
+			w, "blob %d: %s\n", sc.Index, result,
+		); err != nil {
+			return 0, err
+		}
+	}
+	return invalid, nil
+}
+
+// readSidecars decodes the blob sidecars of the file at the given path and
+// validates that they are within the limits of the given chain spec.
+func readSidecars(
+	path string,
+	cs primitives.ChainSpec,
+) (*datypes.BlobSidecars, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	sidecars := new(datypes.BlobSidecars)
+	if err = sidecars.UnmarshalSSZ(bz); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode sidecars of %s", path)
+	}
+	if err = sidecars.Validate(cs); err != nil {
+		return nil, err
+	}
+	return sidecars, nil
+}
+
+// newProofVerifier returns a verifier of KZG proofs using the trusted setup
+// at the given path, or the trusted setup embedded in go-kzg-4844 if the path
+// is empty.
+func newProofVerifier(setupPath string) (kzg.BlobProofVerifier, error) {
+	if setupPath == "" {
+		ctx, err := gokzg4844.NewContext4096Secure()
+		if err != nil {
+			return nil, err
+		}
+		return &gokzg.Verifier{Context: ctx}, nil
+	}
+	ts, err := components.ReadTrustedSetup(setupPath)
+	if err != nil {
+		return nil, err
+	}
+	return kzg.NewBlobProofVerifier(gokzg.Implementation, ts)
+}
+
+// verifySidecar verifies the KZG proof of the given sidecar, and that its
+// KZG commitment is included in the block body with the given root.
+func verifySidecar(
+	cs primitives.ChainSpec,
+	proofVerifier kzg.BlobProofVerifier,
+	sc *datypes.BlobSidecar,
+	bodyRoot common.Root,
+) error {
+	if err := proofVerifier.VerifyBlobProof(
+		&sc.Blob, sc.KzgProof, sc.KzgCommitment,
+	); err != nil {
+		return errors.Wrap(err, "kzg proof")
+	}
+
+	if sc.BeaconBlockHeader == nil {
+		return errors.New("inclusion proof: missing block header")
+	}
+	// The position of the KZG commitments in the block body depends on the
+	// fork of the block.
+	slot := sc.BeaconBlockHeader.GetSlot()
+	if fork := cs.ActiveForkVersionForSlot(slot); fork != version.Deneb {
+		return errors.Newf(
+			"inclusion proof: unsupported fork version %d of slot %d",
+			fork, slot,
+		)
+	}
+	if err := sc.VerifyInclusionProof(
+		bodyRoot, ctypes.BlockBodyKZGOffset(slot, cs),
+	); err != nil {
+		return errors.Wrap(err, "inclusion proof")
+	}
+	return nil
+}
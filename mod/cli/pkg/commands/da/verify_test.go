@@ -0,0 +1,209 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package da_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/da"
+	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	dablob "github.com/berachain/beacon-kit/mod/da/pkg/blob"
+	datypes "github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/config/spec"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	gokzg4844 "github.com/crate-crypto/go-kzg-4844"
+	"github.com/stretchr/testify/require"
+)
+
+// trustedSetupPath is the path of the trusted setup used by the tests of the
+// repository.
+const trustedSetupPath = "../../../../../testing/files/kzg-trusted-setup.json"
+
+// noopSink is a telemetry sink that discards all metrics.
+type noopSink struct{}
+
+func (noopSink) MeasureSince(string, time.Time, ...string) {}
+
+// newVerifiableSidecars returns n sidecars of distinct blobs with valid KZG
+// proofs, which are included in the returned block body root.
+func newVerifiableSidecars(
+	t *testing.T,
+	n int,
+) (*datypes.BlobSidecars, common.Root) {
+	t.Helper()
+	ctx, err := gokzg4844.NewContext4096Secure()
+	require.NoError(t, err)
+
+	var (
+		blobs       = make([]eip4844.Blob, n)
+		commitments = make([]eip4844.KZGCommitment, n)
+		proofs      = make([]eip4844.KZGProof, n)
+	)
+	for i := range n {
+		// The first field element of each blob is set to its index.
+		blobs[i][31] = byte(i + 1)
+		blob := (*gokzg4844.Blob)(&blobs[i])
+		commitment, cErr := ctx.BlobToKZGCommitment(blob, 0)
+		require.NoError(t, cErr)
+		proof, pErr := ctx.ComputeBlobKZGProof(blob, commitment, 0)
+		require.NoError(t, pErr)
+		commitments[i] = eip4844.KZGCommitment(commitment)
+		proofs[i] = eip4844.KZGProof(proof)
+	}
+
+	body := &ctypes.BeaconBlockBody{
+		RawBeaconBlockBody: &ctypes.BeaconBlockBodyDeneb{
+			BeaconBlockBodyBase: ctypes.BeaconBlockBodyBase{
+				Eth1Data: &ctypes.Eth1Data{},
+				Deposits: []*ctypes.Deposit{},
+			},
+			ExecutionPayload: &ctypes.ExecutableDataDeneb{
+				LogsBloom: make([]byte, ctypes.LogsBloomSize),
+			},
+			BlobKzgCommitments: commitments,
+		},
+	}
+	bodyRoot, err := body.HashTreeRoot()
+	require.NoError(t, err)
+
+	factory := dablob.NewSidecarFactory[
+		*ctypes.BeaconBlock, *ctypes.BeaconBlockBody,
+	](spec.DevnetChainSpec(), ctypes.KZGPositionDeneb, noopSink{})
+	header := &ctypes.BeaconBlockHeader{
+		BeaconBlockHeaderBase: ctypes.BeaconBlockHeaderBase{Slot: 3},
+		BodyRoot:              bodyRoot,
+	}
+	sidecars := &datypes.BlobSidecars{
+		Sidecars: make([]*datypes.BlobSidecar, n),
+	}
+	for i := range n {
+		inclusionProof, pErr := factory.BuildKZGInclusionProof(
+			body, math.U64(i),
+		)
+		require.NoError(t, pErr)
+		sidecars.Sidecars[i] = datypes.BuildBlobSidecar(
+			math.U64(i), header, &blobs[i], commitments[i], proofs[i],
+			inclusionProof,
+		)
+	}
+	return sidecars, bodyRoot
+}
+
+// runVerify writes the given sidecars to a file and executes the verify
+// command against it, returning its output.
+func runVerify(
+	t *testing.T,
+	sidecars *datypes.BlobSidecars,
+	args ...string,
+) ([]byte, error) {
+	t.Helper()
+	bz, err := sidecars.MarshalSSZ()
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "sidecars.ssz")
+	require.NoError(t, os.WriteFile(path, bz, 0o600))
+
+	cmd := da.NewVerifyCommand(spec.DevnetChainSpec())
+	out := new(bytes.Buffer)
+	cmd.SetOut(out)
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs(append([]string{"--sidecars", path}, args...))
+	err = cmd.Execute()
+	return out.Bytes(), err
+}
+
+func TestVerify(t *testing.T) {
+	sidecars, bodyRoot := newVerifiableSidecars(t, 3)
+
+	out, err := runVerify(
+		t, sidecars, "--block-body-root", bodyRoot.String(),
+	)
+	require.NoError(t, err)
+	require.Equal(t, "blob 0: ok\nblob 1: ok\nblob 2: ok\n", string(out))
+
+	// The trusted setup can be read from a file.
+	out, err = runVerify(
+		t, sidecars,
+		"--block-body-root", bodyRoot.String(),
+		"--trusted-setup", trustedSetupPath,
+	)
+	require.NoError(t, err)
+	require.Equal(t, "blob 0: ok\nblob 1: ok\nblob 2: ok\n", string(out))
+}
+
+func TestVerifyInvalidSidecars(t *testing.T) {
+	sidecars, bodyRoot := newVerifiableSidecars(t, 3)
+	// The KZG proof of the second blob is the proof of the first one.
+	sidecars.Sidecars[1].KzgProof = sidecars.Sidecars[0].KzgProof
+	// The inclusion proof of the third blob is corrupted.
+	sidecars.Sidecars[2].InclusionProof[0][0] ^= 0xff
+
+	out, err := runVerify(
+		t, sidecars, "--block-body-root", bodyRoot.String(),
+	)
+	require.ErrorIs(t, err, da.ErrInvalidSidecars)
+	require.ErrorContains(t, err, "2 of 3 blobs")
+
+	lines := bytes.Split(bytes.TrimSpace(out), []byte("\n"))
+	require.Len(t, lines, 3)
+	require.Equal(t, "blob 0: ok", string(lines[0]))
+	require.Contains(t, string(lines[1]), "blob 1: invalid: kzg proof")
+	require.Contains(
+		t, string(lines[2]), "blob 2: invalid: inclusion proof",
+	)
+	require.Contains(
+		t, string(lines[2]), datypes.ErrInvalidInclusionProof.Error(),
+	)
+}
+
+func TestVerifyWrongBodyRoot(t *testing.T) {
+	sidecars, _ := newVerifiableSidecars(t, 1)
+
+	out, err := runVerify(
+		t, sidecars, "--block-body-root", common.Root{1}.String(),
+	)
+	require.ErrorIs(t, err, da.ErrInvalidSidecars)
+	require.Contains(t, string(out), "blob 0: invalid: inclusion proof")
+}
+
+func TestVerifyExceedsChainSpecLimits(t *testing.T) {
+	sidecars, bodyRoot := newVerifiableSidecars(t, 2)
+	// Every sidecar must have a distinct index.
+	sidecars.Sidecars[1].Index = 0
+
+	out, err := runVerify(
+		t, sidecars, "--block-body-root", bodyRoot.String(),
+	)
+	require.ErrorIs(t, err, datypes.ErrInconsistentCommitments)
+	require.Empty(t, out)
+}
+
+func TestVerifyInvalidBodyRoot(t *testing.T) {
+	sidecars, _ := newVerifiableSidecars(t, 1)
+
+	_, err := runVerify(t, sidecars, "--block-body-root", "0x1234")
+	require.ErrorContains(t, err, "block-body-root")
+}
@@ -0,0 +1,174 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package debug
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/genesis"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/spf13/cobra"
+)
+
+// offsetSize is the size of an offset in an SSZ encoding.
+const offsetSize = 4
+
+// Commands creates a new command for debugging the node.
+func Commands(cs primitives.ChainSpec, graphDOT GraphCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "debug",
+		Short:                      "debugging subcommands",
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2, //nolint:mnd // from sdk.
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewReplayCommand(cs),
+		NewGraphCommand(graphDOT),
+	)
+
+	return cmd
+}
+
+// NewReplayCommand creates a new command for replaying beacon blocks.
+func NewReplayCommand(cs primitives.ChainSpec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replay",
+		Short: "Replays beacon blocks from a file",
+		Long: `Replays the beacon blocks of a file against a fresh beacon state
+kept in memory, which is initialized from the genesis file of the node. The
+file holds the SSZ encoding of a list of beacon blocks. The state root is
+printed after each block, and the replay stops at the first block that fails
+the state transition. The payloads of the blocks are not verified against an
+execution client.`,
+		Args: cobra.NoArgs,
+		RunE: replayBlocks(cs),
+	}
+
+	cmd.Flags().String(fromFlag, defaultFrom, fromFlagMsg)
+	if err := cmd.MarkFlagRequired(fromFlag); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
+// replayBlocks replays the beacon blocks of the file given by the from flag
+// of the command.
+func replayBlocks(
+	cs primitives.ChainSpec,
+) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, _ []string) error {
+		from, err := cmd.Flags().GetString(fromFlag)
+		if err != nil {
+			return err
+		}
+		bz, err := os.ReadFile(from)
+		if err != nil {
+			return err
+		}
+		blocks, err := splitSSZList(bz)
+		if err != nil {
+			return err
+		}
+
+		genesisData, err := genesis.ReadBeaconGenesis(
+			server.GetServerContextFromCmd(cmd).Config.GenesisFile(),
+		)
+		if err != nil {
+			return err
+		}
+		r, err := NewReplayer(cs, genesisData)
+		if err != nil {
+			return err
+		}
+
+		cmd.SilenceUsage = true
+		return replay(cmd, r, blocks)
+	}
+}
+
+// replay applies the SSZ-encoded beacon blocks in order and prints the state
+// root after each of them.
+func replay(cmd *cobra.Command, r *Replayer, blocks [][]byte) error {
+	for i, bz := range blocks {
+		blk, err := r.DecodeBlock(bz)
+		if err != nil {
+			return errors.Wrapf(err, "failed to decode block %d", i)
+		}
+		root, err := r.Apply(cmd.Context(), blk)
+		if err != nil {
+			return fmt.Errorf(
+				"%w: block %d at slot %d: %w",
+				ErrTransitionFailed, i, blk.GetSlot(), err,
+			)
+		}
+		if _, err = fmt.Fprintf(
+			cmd.OutOrStdout(), "slot %d: state root %s\n",
+			blk.GetSlot(), root,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// splitSSZList splits the SSZ encoding of a list of variable-size elements
+// into the encodings of its elements.
+func splitSSZList(bz []byte) ([][]byte, error) {
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	if len(bz) < offsetSize {
+		return nil, errors.Wrap(ErrInvalidBlockList, "truncated offset")
+	}
+
+	first := binary.LittleEndian.Uint32(bz)
+	if first%offsetSize != 0 || first == 0 || uint64(first) > uint64(len(bz)) {
+		return nil, errors.Wrapf(
+			ErrInvalidBlockList, "invalid first offset %d", first,
+		)
+	}
+
+	count := int(first / offsetSize)
+	offsets := make([]uint32, count+1)
+	for i := range count {
+		offsets[i] = binary.LittleEndian.Uint32(bz[i*offsetSize:])
+	}
+	//#nosec:G115 // the length is bounded by the first offset.
+	offsets[count] = uint32(len(bz))
+
+	elems := make([][]byte, count)
+	for i := range count {
+		if offsets[i] > offsets[i+1] {
+			return nil, errors.Wrapf(
+				ErrInvalidBlockList, "offset %d is out of order", i,
+			)
+		}
+		elems[i] = bz[offsets[i]:offsets[i+1]]
+	}
+	return elems, nil
+}
@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package debug
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+)
+
+// StateFieldDiff is a top-level field of the beacon state whose value differs
+// between two states, given as the JSON encodings of the values.
+type StateFieldDiff struct {
+	// Field is the JSON name of the field, e.g. "slot" or "balances".
+	Field string
+	// A is the value of the field in the first state.
+	A json.RawMessage
+	// B is the value of the field in the second state.
+	B json.RawMessage
+}
+
+// DiffStates returns the top-level fields of the beacon state that differ
+// between the two states, in the order of the fields of the state. A field
+// is reported as a whole, e.g. a change to the balance of a single validator
+// reports the balances field.
+func DiffStates(a, b components.BeaconState) ([]StateFieldDiff, error) {
+	aFields, aValues, err := stateFields(a)
+	if err != nil {
+		return nil, err
+	}
+	bFields, bValues, err := stateFields(b)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fields missing from the first state are reported after its fields.
+	for _, field := range bFields {
+		if _, ok := aValues[field]; !ok {
+			aFields = append(aFields, field)
+		}
+	}
+
+	var diffs []StateFieldDiff
+	for _, field := range aFields {
+		if !bytes.Equal(aValues[field], bValues[field]) {
+			diffs = append(diffs, StateFieldDiff{
+				Field: field,
+				A:     aValues[field],
+				B:     bValues[field],
+			})
+		}
+	}
+	return diffs, nil
+}
+
+// stateFields returns the names of the top-level fields of the beacon state
+// in order, together with the JSON encodings of their values.
+func stateFields(
+	st components.BeaconState,
+) ([]string, map[string]json.RawMessage, error) {
+	bz, err := st.MarshalJSON()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(bz))
+	if tok, tokErr := dec.Token(); tokErr != nil {
+		return nil, nil, tokErr
+	} else if tok != json.Delim('{') {
+		return nil, nil, errors.New("beacon state is not a JSON object")
+	}
+
+	var (
+		fields []string
+		values = make(map[string]json.RawMessage)
+	)
+	for dec.More() {
+		tok, tokErr := dec.Token()
+		if tokErr != nil {
+			return nil, nil, tokErr
+		}
+		field, _ := tok.(string)
+		var value json.RawMessage
+		if err = dec.Decode(&value); err != nil {
+			return nil, nil, err
+		}
+		fields = append(fields, field)
+		values[field] = value
+	}
+	return fields, values, nil
+}
@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package debug //nolint:testpackage // mutates the state of the replayer.
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/config/spec"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffStates(t *testing.T) {
+	cs := spec.TestnetChainSpec()
+	genesisData, _ := newTestGenesis(t, cs)
+	r, err := NewReplayer(cs, genesisData)
+	require.NoError(t, err)
+
+	st := r.st.Copy()
+	diffs, err := DiffStates(r.st, st)
+	require.NoError(t, err)
+	require.Empty(t, diffs)
+
+	balance, err := r.st.GetBalance(0)
+	require.NoError(t, err)
+	require.NoError(t, st.IncreaseBalance(0, 1))
+	diffs, err = DiffStates(r.st, st)
+	require.NoError(t, err)
+	require.Len(t, diffs, 1)
+	require.Equal(t, "balances", diffs[0].Field)
+	require.JSONEq(t, fmt.Sprintf("[%d]", balance), string(diffs[0].A))
+	require.JSONEq(t, fmt.Sprintf("[%d]", balance+1), string(diffs[0].B))
+
+	require.NoError(t, st.SetSlot(math.Slot(1)))
+	diffs, err = DiffStates(r.st, st)
+	require.NoError(t, err)
+	require.Len(t, diffs, 2)
+	require.Equal(t, "slot", diffs[0].Field)
+	require.Equal(t, "balances", diffs[1].Field)
+}
@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package debug
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+// GraphCreator returns the providers of the node and their dependencies as a
+// Graphviz DOT graph.
+type GraphCreator func() (string, error)
+
+// NewGraphCommand creates a new command printing the dependency graph of the
+// providers of the node.
+func NewGraphCommand(graphDOT GraphCreator) *cobra.Command {
+	return &cobra.Command{
+		Use:   "graph",
+		Short: "Prints the dependency graph of the node in DOT format",
+		Long: `Prints the providers the node is built from, along with the types
+they depend on and provide, as a Graphviz DOT graph. None of the providers is
+called, so the graph is printed without a home directory. Render it with, for
+example, "beacond debug graph | dot -Tsvg > graph.svg".`,
+		Args:   cobra.NoArgs,
+		Hidden: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			dot, err := graphDOT()
+			if err != nil {
+				return err
+			}
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), dot)
+			return err
+		},
+	}
+}
@@ -0,0 +1,179 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package debug //nolint:testpackage // builds blocks against the replayer.
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/config/spec"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestGenesis returns a genesis with a single validator whose key is
+// held by the returned signer.
+func newTestGenesis(
+	t *testing.T,
+	cs primitives.ChainSpec,
+) (*Genesis, *signer.LegacySigner) {
+	t.Helper()
+	blsSigner, err := signer.NewLegacySigner(signer.LegacyKey{31: 1})
+	require.NoError(t, err)
+	genesisData, err := NewSyntheticGenesis(cs, blsSigner)
+	require.NoError(t, err)
+	return genesisData, blsSigner
+}
+
+// newTestChain builds a chain of valid blocks for the slots 1 to n on top
+// of the given genesis, and returns the blocks together with the state root
+// after each of them.
+func newTestChain(
+	t *testing.T,
+	cs primitives.ChainSpec,
+	genesisData *Genesis,
+	blsSigner *signer.LegacySigner,
+	n int,
+) ([]*types.BeaconBlock, []common.Root) {
+	t.Helper()
+	r, err := NewReplayer(cs, genesisData)
+	require.NoError(t, err)
+
+	blocks := make([]*types.BeaconBlock, 0, n)
+	roots := make([]common.Root, 0, n)
+	for range n {
+		blk, blkErr := r.NextBlock(blsSigner)
+		require.NoError(t, blkErr)
+
+		// The state root of the block is the root of the state after it.
+		root, applyErr := r.ApplyUnverified(context.Background(), blk)
+		require.NoError(t, applyErr)
+		blk.SetStateRoot(root)
+
+		blocks = append(blocks, blk)
+		roots = append(roots, root)
+	}
+	return blocks, roots
+}
+
+// encodeBlocks returns the SSZ encoding of the list of the given blocks.
+func encodeBlocks(t *testing.T, blocks []*types.BeaconBlock) []byte {
+	t.Helper()
+	var offsets, data []byte
+	for _, blk := range blocks {
+		offsets = binary.LittleEndian.AppendUint32(
+			offsets, uint32(len(blocks)*offsetSize+len(data)),
+		)
+		bz, err := blk.MarshalSSZ()
+		require.NoError(t, err)
+		data = append(data, bz...)
+	}
+	return append(offsets, data...)
+}
+
+// runReplay writes the genesis and the blocks to a temporary home directory
+// and replays the blocks.
+func runReplay(
+	t *testing.T,
+	cs primitives.ChainSpec,
+	genesisData *Genesis,
+	blocksBz []byte,
+) (string, error) {
+	t.Helper()
+	serverCtx := server.NewDefaultContext()
+	serverCtx.Config.SetRoot(t.TempDir())
+
+	beaconGenesis, err := json.Marshal(genesisData)
+	require.NoError(t, err)
+	appState, err := json.Marshal(map[string]json.RawMessage{
+		beaconStoreKey: beaconGenesis,
+	})
+	require.NoError(t, err)
+	appGenesis := genutiltypes.NewAppGenesisWithVersion("test", appState)
+	genesisFile := serverCtx.Config.GenesisFile()
+	require.NoError(t, os.MkdirAll(filepath.Dir(genesisFile), 0o700))
+	require.NoError(t, genutil.ExportGenesisFile(appGenesis, genesisFile))
+
+	blocksFile := filepath.Join(serverCtx.Config.RootDir, "blocks.ssz")
+	require.NoError(t, os.WriteFile(blocksFile, blocksBz, 0o600))
+
+	cmd := NewReplayCommand(cs)
+	out := &bytes.Buffer{}
+	cmd.SetOut(out)
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{"--" + fromFlag, blocksFile})
+	err = cmd.ExecuteContext(context.WithValue(
+		context.Background(), server.ServerContextKey, serverCtx,
+	))
+	return out.String(), err
+}
+
+func TestReplay(t *testing.T) {
+	cs := spec.TestnetChainSpec()
+	genesisData, blsSigner := newTestGenesis(t, cs)
+	blocks, roots := newTestChain(t, cs, genesisData, blsSigner, 3)
+
+	out, err := runReplay(t, cs, genesisData, encodeBlocks(t, blocks))
+	require.NoError(t, err)
+	require.Equal(t, "slot 1: state root "+roots[0].String()+"\n"+
+		"slot 2: state root "+roots[1].String()+"\n"+
+		"slot 3: state root "+roots[2].String()+"\n", out)
+}
+
+func TestReplayStopsAtFailedTransition(t *testing.T) {
+	cs := spec.TestnetChainSpec()
+	genesisData, blsSigner := newTestGenesis(t, cs)
+	blocks, roots := newTestChain(t, cs, genesisData, blsSigner, 3)
+	blocks[1].SetStateRoot(common.Root{1})
+
+	out, err := runReplay(t, cs, genesisData, encodeBlocks(t, blocks))
+	require.ErrorIs(t, err, ErrTransitionFailed)
+	require.ErrorContains(t, err, "block 1 at slot 2")
+	require.Equal(t, "slot 1: state root "+roots[0].String()+"\n", out)
+}
+
+func TestSplitSSZList(t *testing.T) {
+	elems, err := splitSSZList(nil)
+	require.NoError(t, err)
+	require.Empty(t, elems)
+
+	elems, err = splitSSZList([]byte{8, 0, 0, 0, 9, 0, 0, 0, 1, 2, 3})
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{{1}, {2, 3}}, elems)
+
+	_, err = splitSSZList([]byte{8, 0})
+	require.ErrorIs(t, err, ErrInvalidBlockList)
+	_, err = splitSSZList([]byte{3, 0, 0, 0})
+	require.ErrorIs(t, err, ErrInvalidBlockList)
+	_, err = splitSSZList([]byte{8, 0, 0, 0, 7, 0, 0, 0})
+	require.ErrorIs(t, err, ErrInvalidBlockList)
+}
@@ -0,0 +1,138 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package debug
+
+import (
+	"context"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/genesis"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb/encoding"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// beaconStoreKey is the key of the store the beacon state is kept in.
+const beaconStoreKey = "beacon"
+
+// Genesis is the genesis of the beacon module the state is initialized from.
+type Genesis = genesis.Genesis[
+	*types.Deposit, *types.ExecutionPayloadHeaderDeneb,
+]
+
+// Replayer applies beacon blocks to a beacon state kept in memory. The
+// payloads of the blocks are not verified against an execution client, but
+// their RANDAO reveals and resulting state roots are.
+type Replayer struct {
+	cs primitives.ChainSpec
+	sp components.StateProcessor
+	st components.BeaconState
+}
+
+// NewReplayer returns a new Replayer whose state is initialized from the
+// given genesis.
+func NewReplayer(
+	cs primitives.ChainSpec,
+	genesisData *Genesis,
+) (*Replayer, error) {
+	key := storetypes.NewKVStoreKey(beaconStoreKey)
+	ms := rootmulti.NewStore(
+		dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics(),
+	)
+	ms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	if err := ms.LoadLatestVersion(); err != nil {
+		return nil, err
+	}
+
+	kv := beacondb.New[
+		*types.Fork,
+		*types.BeaconBlockHeader,
+		*types.ExecutionPayloadHeader,
+		*types.Eth1Data,
+		*types.Validator,
+	](
+		runtime.NewKVStoreService(key),
+		&encoding.SSZInterfaceCodec[*types.ExecutionPayloadHeader]{},
+	)
+	backend := components.ProvideStorageBackend(
+		components.StorageBackendInput{ChainSpec: cs, KVStore: kv},
+	)
+
+	r := &Replayer{
+		cs: cs,
+		sp: components.ProvideStateProcessor(components.StateProcessorInput{
+			ChainSpec: cs,
+			Signer:    signer.NewVerifyingSigner(),
+		}),
+		st: backend.StateFromContext(
+			sdk.NewContext(ms.CacheMultiStore(), false, log.NewNopLogger()),
+		),
+	}
+	if _, err := r.sp.InitializePreminedBeaconStateFromEth1(
+		r.st,
+		genesisData.Deposits,
+		&types.ExecutionPayloadHeader{
+			InnerExecutionPayloadHeader: genesisData.ExecutionPayloadHeader,
+		},
+		genesisData.ForkVersion,
+	); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// DecodeBlock decodes the SSZ-encoded beacon block following the current
+// state, using the fork version active at the slot after the state.
+func (r *Replayer) DecodeBlock(bz []byte) (*types.BeaconBlock, error) {
+	slot, err := r.st.GetSlot()
+	if err != nil {
+		return nil, err
+	}
+	return (&types.BeaconBlock{}).NewFromSSZ(
+		bz, r.cs.ActiveForkVersionForSlot(slot+1),
+	)
+}
+
+// Apply applies the beacon block to the state and returns the resulting
+// state root.
+func (r *Replayer) Apply(
+	ctx context.Context,
+	blk *types.BeaconBlock,
+) (common.Root, error) {
+	if _, err := r.sp.Transition(&transition.Context{
+		Context:                 ctx,
+		SkipPayloadVerification: true,
+	}, r.st, blk); err != nil {
+		return common.Root{}, err
+	}
+	return r.st.HashTreeRoot()
+}
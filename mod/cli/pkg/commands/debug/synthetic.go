@@ -0,0 +1,157 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package debug
+
+import (
+	"context"
+
+	consensusgenesis "github.com/berachain/beacon-kit/mod/consensus-types/pkg/genesis"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// NewSyntheticGenesis returns the default Deneb genesis with a single
+// validator, whose key is held by the given signer, such that blocks can be
+// built on top of it with NextBlock.
+func NewSyntheticGenesis(
+	cs primitives.ChainSpec,
+	signer crypto.BLSSigner,
+) (*Genesis, error) {
+	genesisData := consensusgenesis.DefaultGenesisDeneb()
+	msg, sig, err := types.CreateAndSignDepositMessage(
+		types.NewForkData(genesisData.ForkVersion, common.Root{}),
+		cs.DomainTypeDeposit(),
+		signer,
+		types.NewCredentialsFromExecutionAddress(common.ExecutionAddress{}),
+		math.Gwei(cs.MaxEffectiveBalance()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	genesisData.Deposits = append(genesisData.Deposits, &types.Deposit{
+		Pubkey:      msg.Pubkey,
+		Credentials: msg.Credentials,
+		Amount:      msg.Amount,
+		Signature:   sig,
+	})
+	return genesisData, nil
+}
+
+// NextBlock builds an empty beacon block for the slot after the state, with
+// its RANDAO reveal signed by the given signer. The state root of the block
+// is left unset, as it is only known once the block is applied.
+func (r *Replayer) NextBlock(
+	signer crypto.BLSSigner,
+) (*types.BeaconBlock, error) {
+	slot, err := r.st.GetSlot()
+	if err != nil {
+		return nil, err
+	}
+	slot++
+	epoch := r.cs.SlotToEpoch(slot)
+
+	// The header of the parent block has no state root until the next slot
+	// is processed, which sets it to the current state root.
+	header, err := r.st.GetLatestBlockHeader()
+	if err != nil {
+		return nil, err
+	}
+	if (header.GetStateRoot() == common.Root{}) {
+		var stateRoot common.Root
+		if stateRoot, err = r.st.HashTreeRoot(); err != nil {
+			return nil, err
+		}
+		header.SetStateRoot(stateRoot)
+	}
+	parentRoot, err := header.HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+
+	genesisValidatorsRoot, err := r.st.GetGenesisValidatorsRoot()
+	if err != nil {
+		return nil, err
+	}
+	signingRoot, err := types.NewForkData(
+		version.FromUint32[primitives.Version](
+			r.cs.ActiveForkVersionForEpoch(epoch),
+		),
+		genesisValidatorsRoot,
+	).ComputeRandaoSigningRoot(r.cs.DomainTypeRandao(), epoch)
+	if err != nil {
+		return nil, err
+	}
+	reveal, err := signer.Sign(signingRoot[:])
+	if err != nil {
+		return nil, err
+	}
+
+	// The payload must hold the withdrawals expected by the state.
+	withdrawals, err := r.st.ExpectedWithdrawals()
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.BeaconBlock{RawBeaconBlock: &types.BeaconBlockDeneb{
+		BeaconBlockHeaderBase: types.BeaconBlockHeaderBase{
+			Slot:            slot.Unwrap(),
+			ParentBlockRoot: parentRoot,
+		},
+		Body: &types.BeaconBlockBodyDeneb{
+			BeaconBlockBodyBase: types.BeaconBlockBodyBase{
+				RandaoReveal: reveal,
+				Eth1Data:     &types.Eth1Data{},
+				Deposits:     []*types.Deposit{},
+			},
+			ExecutionPayload: &types.ExecutableDataDeneb{
+				Number:       slot,
+				LogsBloom:    make([]byte, types.LogsBloomSize),
+				ExtraData:    []byte{},
+				Transactions: [][]byte{},
+				Withdrawals:  withdrawals,
+			},
+			BlobKzgCommitments: []eip4844.KZGCommitment{},
+		},
+	}}, nil
+}
+
+// ApplyUnverified applies the beacon block to the state like Apply, but
+// without verifying the state root of the block against the resulting state
+// root, which it returns.
+func (r *Replayer) ApplyUnverified(
+	ctx context.Context,
+	blk *types.BeaconBlock,
+) (common.Root, error) {
+	if _, err := r.sp.Transition(&transition.Context{
+		Context:                 ctx,
+		SkipPayloadVerification: true,
+		SkipValidateResult:      true,
+	}, r.st, blk); err != nil {
+		return common.Root{}, err
+	}
+	return r.st.HashTreeRoot()
+}
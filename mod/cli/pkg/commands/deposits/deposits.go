@@ -0,0 +1,150 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposits
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/state"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// outputFlag is the flag for the output format of the deposit.
+	outputFlag = "output"
+	// outputFlagMsg is the usage description for the outputFlag flag.
+	outputFlagMsg = "output format of the deposit (text|json)"
+
+	// outputText is the text output format.
+	outputText = "text"
+	// outputJSON is the JSON output format.
+	outputJSON = "json"
+)
+
+// Commands creates a new command for inspecting the deposits of the node.
+func Commands[BeaconStateT BeaconState](
+	newStore StoreCreator,
+	newBackend state.BackendCreator[BeaconStateT],
+) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "deposits",
+		Short:                      "deposits subcommands",
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2, //nolint:mnd // from sdk.
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewGetCommand(newStore),
+		NewPruneCommand(newStore, newBackend),
+	)
+
+	return cmd
+}
+
+// NewGetCommand creates a new command for reading a deposit by its index.
+func NewGetCommand(newStore StoreCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get [index]",
+		Short: "Prints the deposit with the given index",
+		Long: `Prints the deposit with the given index from the deposit store of
+the node, which must not be running.`,
+		Args: cobra.ExactArgs(1),
+		RunE: getDeposit(newStore),
+	}
+
+	cmd.Flags().String(outputFlag, outputText, outputFlagMsg)
+	return cmd
+}
+
+// getDeposit reads the deposit with the index given as argument from the
+// deposit store and prints it.
+func getDeposit(
+	newStore StoreCreator,
+) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		index, err := strconv.ParseUint(args[0], 10, 64)
+		if err != nil {
+			return err
+		}
+		output, err := cmd.Flags().GetString(outputFlag)
+		if err != nil {
+			return err
+		}
+		if output != outputText && output != outputJSON {
+			return errors.Wrapf(ErrUnsupportedOutput, "%q", output)
+		}
+		cmd.SilenceUsage = true
+
+		serverCtx := server.GetServerContextFromCmd(cmd)
+		store, err := newStore(serverCtx.Logger, serverCtx.Viper)
+		if err != nil {
+			return err
+		}
+		deposits, err := store.GetDepositsByIndex(index, 1)
+		if err != nil {
+			return err
+		}
+		if len(deposits) == 0 {
+			return fmt.Errorf("%w at index %d", ErrDepositNotFound, index)
+		}
+
+		if output == outputJSON {
+			return printJSON(cmd, deposits[0])
+		}
+		return printText(cmd, deposits[0])
+	}
+}
+
+// printJSON prints the deposit as indented JSON.
+func printJSON(cmd *cobra.Command, deposit *types.Deposit) error {
+	bz, err := json.MarshalIndent(deposit, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+	return err
+}
+
+// printText prints the fields of the deposit, one per line.
+func printText(cmd *cobra.Command, deposit *types.Deposit) error {
+	_, err := fmt.Fprintf(
+		cmd.OutOrStdout(),
+		"index:       %d\n"+
+			"pubkey:      %s\n"+
+			"credentials: %s\n"+
+			"amount:      %d\n"+
+			"signature:   %s\n",
+		deposit.GetIndex(),
+		deposit.GetPubkey(),
+		bytes.B32(deposit.GetWithdrawalCredentials()),
+		deposit.GetAmount().Unwrap(),
+		deposit.GetSignature(),
+	)
+	return err
+}
@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposits_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"cosmossdk.io/log"
+	storev2 "cosmossdk.io/store/v2/db"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/deposits"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	depositdb "github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/stretchr/testify/require"
+)
+
+// runGet executes the get command against a deposit store seeded with the
+// given deposits and returns its output.
+func runGet(
+	t *testing.T,
+	seed []*types.Deposit,
+	args ...string,
+) ([]byte, error) {
+	t.Helper()
+	store := depositdb.NewStore[*types.Deposit](
+		&depositdb.KVStoreProvider{KVStoreWithBatch: storev2.NewMemDB()},
+		nil,
+	)
+	require.NoError(t, store.EnqueueDeposits(seed))
+
+	cmd := deposits.NewGetCommand(func(
+		log.Logger, servertypes.AppOptions,
+	) (deposits.DepositStore, error) {
+		return store, nil
+	})
+	out := &bytes.Buffer{}
+	cmd.SetOut(out)
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs(args)
+	err := cmd.ExecuteContext(context.WithValue(
+		context.Background(),
+		server.ServerContextKey,
+		server.NewDefaultContext(),
+	))
+	return out.Bytes(), err
+}
+
+func TestGet(t *testing.T) {
+	seed := []*types.Deposit{
+		{
+			Pubkey: crypto.BLSPubkey{1},
+			Amount: math.Gwei(32e9),
+			Index:  0,
+		},
+		{
+			Pubkey: crypto.BLSPubkey{2},
+			Amount: math.Gwei(64e9),
+			Index:  1,
+		},
+	}
+
+	out, err := runGet(t, seed, "1")
+	require.NoError(t, err)
+	require.Contains(t, string(out), "index:       1\n")
+	require.Contains(t, string(out), "amount:      64000000000\n")
+	require.Contains(t, string(out), "pubkey:      "+seed[1].Pubkey.String())
+
+	out, err = runGet(t, seed, "0", "--output", "json")
+	require.NoError(t, err)
+	deposit := new(types.Deposit)
+	require.NoError(t, json.Unmarshal(out, deposit))
+	require.Equal(t, seed[0], deposit)
+}
+
+func TestGetErrors(t *testing.T) {
+	seed := []*types.Deposit{{Index: 0}}
+
+	_, err := runGet(t, seed, "1")
+	require.ErrorIs(t, err, deposits.ErrDepositNotFound)
+	require.EqualError(t, err, "deposit not found at index 1")
+
+	_, err = runGet(t, seed, "0", "--output", "yaml")
+	require.ErrorIs(t, err, deposits.ErrUnsupportedOutput)
+}
@@ -0,0 +1,145 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposits
+
+import (
+	"fmt"
+
+	"cosmossdk.io/store/rootmulti"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/state"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// belowFlag is the flag for the index the deposits are pruned below.
+	belowFlag = "below"
+	// belowFlagMsg is the usage description for the belowFlag flag.
+	belowFlagMsg = "prune the deposits with an index strictly below this one"
+
+	// forceFlag is the flag for pruning deposits that are not finalized.
+	forceFlag = "force"
+	// forceFlagMsg is the usage description for the forceFlag flag.
+	forceFlagMsg = "prune deposits above the finalized deposit index"
+)
+
+// NewPruneCommand creates a new command for pruning the deposits below an
+// index from the deposit store.
+func NewPruneCommand[BeaconStateT BeaconState](
+	newStore StoreCreator,
+	newBackend state.BackendCreator[BeaconStateT],
+) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prune",
+		Short: "Prunes the deposits below the given index",
+		Long: `Removes the deposits with an index strictly below the given one from
+the deposit store of the node, which must not be running, and compacts the
+store. Only the deposits processed by the latest committed beacon state are
+finalized, pruning above the finalized deposit index requires --force.`,
+		Args: cobra.NoArgs,
+		RunE: pruneDeposits(newStore, newBackend),
+	}
+
+	cmd.Flags().Uint64(belowFlag, 0, belowFlagMsg)
+	cmd.Flags().Bool(forceFlag, false, forceFlagMsg)
+	if err := cmd.MarkFlagRequired(belowFlag); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
+// pruneDeposits removes the deposits below the index given by the below flag
+// from the deposit store and prints the number of deposits removed.
+func pruneDeposits[BeaconStateT BeaconState](
+	newStore StoreCreator,
+	newBackend state.BackendCreator[BeaconStateT],
+) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, _ []string) error {
+		below, err := cmd.Flags().GetUint64(belowFlag)
+		if err != nil {
+			return err
+		}
+		force, err := cmd.Flags().GetBool(forceFlag)
+		if err != nil {
+			return err
+		}
+		cmd.SilenceUsage = true
+
+		if !force {
+			var finalized uint64
+			finalized, err = finalizedIndex(cmd, newBackend)
+			if err != nil {
+				return err
+			}
+			if below > finalized {
+				return fmt.Errorf(
+					"%w: %d is above the finalized deposit index %d",
+					ErrNotFinalized, below, finalized,
+				)
+			}
+		}
+
+		serverCtx := server.GetServerContextFromCmd(cmd)
+		store, err := newStore(serverCtx.Logger, serverCtx.Viper)
+		if err != nil {
+			return err
+		}
+		removed, err := store.PruneBelow(below)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(cmd.OutOrStdout(), "pruned %d deposits\n", removed)
+		return err
+	}
+}
+
+// finalizedIndex returns the finalized deposit index, which is the index of
+// the next deposit to be processed by the latest beacon state committed to the
+// application database. Every deposit below it is finalized.
+func finalizedIndex[BeaconStateT BeaconState](
+	cmd *cobra.Command,
+	newBackend state.BackendCreator[BeaconStateT],
+) (uint64, error) {
+	serverCtx := server.GetServerContextFromCmd(cmd)
+	db, err := server.OpenDB(
+		serverCtx.Config.RootDir,
+		server.GetAppDBBackend(serverCtx.Viper),
+	)
+	if err != nil {
+		return 0, err
+	}
+	defer db.Close()
+
+	backend, err := newBackend(serverCtx.Logger, db, serverCtx.Viper)
+	if err != nil {
+		return 0, err
+	}
+	//#nosec:G701 // the latest version is never negative.
+	slot := math.Slot(rootmulti.GetLatestVersion(db))
+	st, err := backend.StateAtSlot(cmd.Context(), slot)
+	if err != nil {
+		return 0, fmt.Errorf(
+			"%w at slot %d: %w", state.ErrStateUnavailable, slot, err,
+		)
+	}
+	return st.GetEth1DepositIndex()
+}
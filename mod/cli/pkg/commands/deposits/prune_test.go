@@ -0,0 +1,136 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposits_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"cosmossdk.io/log"
+	storev2 "cosmossdk.io/store/v2/db"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/deposits"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/state"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	depositdb "github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/stretchr/testify/require"
+)
+
+// testState is a beacon state that only holds its deposit index.
+type testState struct {
+	depositIndex uint64
+}
+
+func (*testState) MarshalSSZ() ([]byte, error) {
+	return nil, nil
+}
+
+func (*testState) MarshalJSON() ([]byte, error) {
+	return []byte("{}"), nil
+}
+
+func (s *testState) GetEth1DepositIndex() (uint64, error) {
+	return s.depositIndex, nil
+}
+
+// testBackend serves a state with the same deposit index at every slot.
+type testBackend struct {
+	depositIndex uint64
+}
+
+func (b testBackend) StateAtSlot(
+	context.Context, math.Slot,
+) (*testState, error) {
+	return &testState{depositIndex: b.depositIndex}, nil
+}
+
+// runPrune executes the prune command against the given deposit store, with
+// the deposits below the given index finalized, and returns its output.
+func runPrune(
+	t *testing.T,
+	store deposits.DepositStore,
+	finalized uint64,
+	args ...string,
+) ([]byte, error) {
+	t.Helper()
+	serverCtx := server.NewDefaultContext()
+	serverCtx.Config.SetRoot(t.TempDir())
+
+	cmd := deposits.NewPruneCommand(
+		func(log.Logger, servertypes.AppOptions) (deposits.DepositStore, error) {
+			return store, nil
+		},
+		func(
+			log.Logger, dbm.DB, servertypes.AppOptions,
+		) (state.StorageBackend[*testState], error) {
+			return testBackend{depositIndex: finalized}, nil
+		},
+	)
+	out := &bytes.Buffer{}
+	cmd.SetOut(out)
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs(args)
+	err := cmd.ExecuteContext(context.WithValue(
+		context.Background(), server.ServerContextKey, serverCtx,
+	))
+	return out.Bytes(), err
+}
+
+func TestPrune(t *testing.T) {
+	store := depositdb.NewStore[*types.Deposit](
+		&depositdb.KVStoreProvider{KVStoreWithBatch: storev2.NewMemDB()},
+		nil,
+	)
+	require.NoError(t, store.EnqueueDeposits([]*types.Deposit{
+		{Index: 0}, {Index: 1}, {Index: 2}, {Index: 3}, {Index: 4},
+	}))
+
+	out, err := runPrune(t, store, 4, "--below", "3")
+	require.NoError(t, err)
+	require.Equal(t, "pruned 3 deposits\n", string(out))
+
+	remaining, err := store.GetDepositsByIndex(0, 5)
+	require.NoError(t, err)
+	require.Empty(t, remaining)
+	remaining, err = store.GetDepositsByIndex(3, 5)
+	require.NoError(t, err)
+	require.Len(t, remaining, 2)
+	require.Equal(t, uint64(3), remaining[0].GetIndex())
+	require.Equal(t, uint64(4), remaining[1].GetIndex())
+
+	// Deposits above the finalized deposit index are only pruned if forced.
+	_, err = runPrune(t, store, 4, "--below", "5")
+	require.ErrorIs(t, err, deposits.ErrNotFinalized)
+	count, err := store.Count()
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), count)
+
+	out, err = runPrune(t, store, 4, "--below", "5", "--force")
+	require.NoError(t, err)
+	require.Equal(t, "pruned 2 deposits\n", string(out))
+	count, err = store.Count()
+	require.NoError(t, err)
+	require.Zero(t, count)
+}
@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposits
+
+import (
+	"cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/state"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+// BeaconState is the beacon state the finalized deposit index is read from.
+type BeaconState interface {
+	state.BeaconState
+	// GetEth1DepositIndex returns the index of the next deposit to be
+	// processed.
+	GetEth1DepositIndex() (uint64, error)
+}
+
+// DepositStore is the store the deposits are read from.
+type DepositStore interface {
+	// GetDepositsByIndex returns up to numView deposits starting from the
+	// given index.
+	GetDepositsByIndex(
+		startIndex uint64,
+		numView uint64,
+	) ([]*types.Deposit, error)
+	// PruneBelow removes the deposits with an index strictly below the given
+	// one and returns the number of deposits removed.
+	PruneBelow(index uint64) (uint64, error)
+}
+
+// StoreCreator creates the deposit store of the node.
+type StoreCreator func(
+	logger log.Logger,
+	appOpts servertypes.AppOptions,
+) (DepositStore, error)
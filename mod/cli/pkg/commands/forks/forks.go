@@ -0,0 +1,135 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package forks
+
+import (
+	"fmt"
+
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// slotFlag is the flag for the slot to print the active fork at.
+	slotFlag = "slot"
+	// slotFlagMsg is the usage description for the slotFlag flag.
+	slotFlagMsg = "slot to print the active fork at"
+)
+
+// fork is a fork of the fork schedule of a chain spec.
+type fork struct {
+	// version is the version of the fork.
+	version uint32
+	// epoch is the epoch the fork is activated at.
+	epoch math.Epoch
+}
+
+// schedule returns the fork schedule of the given chain spec, ordered by
+// activation epoch. Deneb is omitted if Electra is active from genesis.
+func schedule(cs primitives.ChainSpec) []fork {
+	electra := fork{version: version.Electra, epoch: cs.ElectraForkEpoch()}
+	if electra.epoch == 0 {
+		return []fork{electra}
+	}
+	return []fork{{version: version.Deneb, epoch: 0}, electra}
+}
+
+// Commands creates a new command for inspecting the fork schedule of the
+// chain spec of the node.
+func Commands(cs primitives.ChainSpec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "forks",
+		Short:                      "fork schedule subcommands",
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2, //nolint:mnd // from sdk.
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewCurrentCommand(cs),
+		NewListCommand(cs),
+	)
+
+	return cmd
+}
+
+// NewCurrentCommand creates a new command for printing the fork that is
+// active at a slot.
+func NewCurrentCommand(cs primitives.ChainSpec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "current",
+		Short: "Prints the fork that is active at a slot",
+		Long: `Prints the name and version of the fork that is active at the
+given slot under the fork schedule of the chain spec of the node.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			slot, err := cmd.Flags().GetUint64(slotFlag)
+			if err != nil {
+				return err
+			}
+			epoch := cs.SlotToEpoch(math.Slot(slot))
+			v := cs.ActiveForkVersionForEpoch(epoch)
+			_, err = fmt.Fprintf(
+				cmd.OutOrStdout(),
+				"fork:    %s\nversion: %s\nepoch:   %d\n",
+				version.Name(v),
+				version.FromUint32[common.Version](v),
+				epoch,
+			)
+			return err
+		},
+	}
+
+	cmd.Flags().Uint64(slotFlag, 0, slotFlagMsg)
+	if err := cmd.MarkFlagRequired(slotFlag); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
+// NewListCommand creates a new command for printing the fork schedule.
+func NewListCommand(cs primitives.ChainSpec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "Prints the fork schedule",
+		Long: `Prints the name, version and activation epoch of each fork of the
+fork schedule of the chain spec of the node, in order of activation.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			for _, f := range schedule(cs) {
+				if _, err := fmt.Fprintf(
+					cmd.OutOrStdout(),
+					"%-9s %s epoch %d\n",
+					version.Name(f.version),
+					version.FromUint32[common.Version](f.version),
+					f.epoch,
+				); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package forks_test
+
+import (
+	"bytes"
+	"strconv"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/forks"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+// runForks executes the forks command with the given arguments against a
+// chain spec that activates Electra at epoch 2, and returns its output.
+func runForks(t *testing.T, args ...string) string {
+	t.Helper()
+	cmd := forks.Commands(chain.NewChainSpec(chain.SpecData[
+		common.DomainType, math.Epoch,
+		common.ExecutionAddress, math.Slot, any,
+	]{
+		SlotsPerEpoch:    32,
+		ElectraForkEpoch: 2,
+	}))
+	out := new(bytes.Buffer)
+	cmd.SetOut(out)
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs(args)
+	require.NoError(t, cmd.Execute())
+	return out.String()
+}
+
+func TestCurrent(t *testing.T) {
+	for slot, expected := range map[uint64]string{
+		0:  "fork:    deneb\nversion: 0x04000000\nepoch:   0\n",
+		63: "fork:    deneb\nversion: 0x04000000\nepoch:   1\n",
+		64: "fork:    electra\nversion: 0x05000000\nepoch:   2\n",
+		65: "fork:    electra\nversion: 0x05000000\nepoch:   2\n",
+	} {
+		out := runForks(
+			t, "current", "--slot", strconv.FormatUint(slot, 10),
+		)
+		require.Equal(t, expected, out, "slot %d", slot)
+	}
+}
+
+func TestList(t *testing.T) {
+	require.Equal(t,
+		"deneb     0x04000000 epoch 0\n"+
+			"electra   0x05000000 epoch 2\n",
+		runForks(t, "list"),
+	)
+}
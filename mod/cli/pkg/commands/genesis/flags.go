@@ -25,3 +25,8 @@ const (
 	defaultDepositAmount = "32000000000" // 32e9
 	depositAmountFlagMsg = "The amount of deposit to be made"
 )
+
+const (
+	chainSpecFlag    = "chain-spec"
+	chainSpecFlagMsg = "The name of the chain spec to verify against"
+)
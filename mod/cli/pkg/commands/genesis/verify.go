@@ -0,0 +1,191 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package genesis
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/genesis"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/config/spec"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/cosmos/cosmos-sdk/server"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/spf13/cobra"
+)
+
+// VerifyGenesisCmd returns a command that verifies that the genesis file and
+// the chain spec of the node match the chain spec of a known network.
+func VerifyGenesisCmd(cs primitives.ChainSpec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify",
+		Short: "verifies the genesis file against a known chain spec",
+		Long: `Verifies that the genesis file and the chain spec of the node match
+the chain spec of the given network. The deposit contract, the fork epochs,
+the slots per epoch and the genesis fork version are compared, and every
+mismatch is printed.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			name, err := cmd.Flags().GetString(chainSpecFlag)
+			if err != nil {
+				return err
+			}
+			expected, ok := spec.ChainSpecs()[name]
+			if !ok {
+				return errors.Wrapf(
+					ErrUnknownChainSpec, "%q, expected one of %s",
+					name, strings.Join(spec.Names(), ", "),
+				)
+			}
+
+			genesisInfo, err := readBeaconGenesis(cmd)
+			if err != nil {
+				return err
+			}
+
+			cmd.SilenceUsage = true
+			mismatches := diffChainSpecs(
+				cs, genesisInfo.ForkVersion, expected,
+			)
+			for _, m := range mismatches {
+				if _, err = fmt.Fprintf(
+					cmd.OutOrStdout(), "%s: got %s, expected %s\n",
+					m.field, m.got, m.expected,
+				); err != nil {
+					return err
+				}
+			}
+			if len(mismatches) > 0 {
+				return fmt.Errorf(
+					"%w %s: %d mismatches", ErrGenesisMismatch, name,
+					len(mismatches),
+				)
+			}
+
+			_, err = fmt.Fprintf(
+				cmd.OutOrStdout(), "genesis matches chain spec %s\n", name,
+			)
+			return err
+		},
+	}
+
+	cmd.Flags().String(chainSpecFlag, "", chainSpecFlagMsg)
+	if err := cmd.MarkFlagRequired(chainSpecFlag); err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
+
+// readBeaconGenesis reads the genesis of the beacon module from the genesis
+// file of the node.
+func readBeaconGenesis(cmd *cobra.Command) (*genesis.Genesis[
+	*types.Deposit,
+	*types.ExecutionPayloadHeaderDeneb,
+], error) {
+	return ReadBeaconGenesis(
+		server.GetServerContextFromCmd(cmd).Config.GenesisFile(),
+	)
+}
+
+// ReadBeaconGenesis reads the genesis of the beacon module from the genesis
+// file at the given path.
+func ReadBeaconGenesis(path string) (*genesis.Genesis[
+	*types.Deposit,
+	*types.ExecutionPayloadHeaderDeneb,
+], error) {
+	appGenesis, err := genutiltypes.AppGenesisFromFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read genesis doc from file")
+	}
+	appGenesisState, err := genutiltypes.GenesisStateFromAppGenesis(
+		appGenesis,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	genesisInfo := &genesis.Genesis[
+		*types.Deposit,
+		*types.ExecutionPayloadHeaderDeneb,
+	]{}
+	if err = json.Unmarshal(
+		appGenesisState["beacon"], genesisInfo,
+	); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal beacon state")
+	}
+	return genesisInfo, nil
+}
+
+// mismatch is a field of the chain spec that differs from its expected value.
+type mismatch struct {
+	field    string
+	got      string
+	expected string
+}
+
+// diffChainSpecs returns the critical fields of the chain spec and the fork
+// version of the genesis that differ from the expected chain spec.
+func diffChainSpecs(
+	cs primitives.ChainSpec,
+	forkVersion primitives.Version,
+	expected primitives.ChainSpec,
+) []mismatch {
+	var mismatches []mismatch
+	diff := func(field string, got, want any) {
+		if got != want {
+			mismatches = append(mismatches, mismatch{
+				field:    field,
+				got:      fmt.Sprint(got),
+				expected: fmt.Sprint(want),
+			})
+		}
+	}
+
+	diff(
+		"deposit contract address",
+		cs.DepositContractAddress(),
+		expected.DepositContractAddress(),
+	)
+	diff(
+		"deposit eth1 chain id",
+		cs.DepositEth1ChainID(),
+		expected.DepositEth1ChainID(),
+	)
+	diff("slots per epoch", cs.SlotsPerEpoch(), expected.SlotsPerEpoch())
+	diff(
+		"electra fork epoch",
+		cs.ElectraForkEpoch(),
+		expected.ElectraForkEpoch(),
+	)
+	diff(
+		"genesis fork version",
+		forkVersion,
+		version.FromUint32[primitives.Version](
+			expected.ActiveForkVersionForSlot(0),
+		),
+	)
+	return mismatches
+}
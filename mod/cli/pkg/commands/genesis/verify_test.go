@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package genesis_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/genesis"
+	consensusgenesis "github.com/berachain/beacon-kit/mod/consensus-types/pkg/genesis"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/config/spec"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/stretchr/testify/require"
+)
+
+// runVerify writes the default genesis to a temporary home directory and
+// verifies it and the given chain spec against the named chain spec.
+func runVerify(
+	t *testing.T,
+	cs primitives.ChainSpec,
+	name string,
+) (string, error) {
+	t.Helper()
+	serverCtx := server.NewDefaultContext()
+	serverCtx.Config.SetRoot(t.TempDir())
+
+	beaconGenesis, err := json.Marshal(consensusgenesis.DefaultGenesisDeneb())
+	require.NoError(t, err)
+	appState, err := json.Marshal(map[string]json.RawMessage{
+		"beacon": beaconGenesis,
+	})
+	require.NoError(t, err)
+	appGenesis := genutiltypes.NewAppGenesisWithVersion("test", appState)
+	genesisFile := serverCtx.Config.GenesisFile()
+	require.NoError(t, os.MkdirAll(filepath.Dir(genesisFile), 0o700))
+	require.NoError(t, genutil.ExportGenesisFile(appGenesis, genesisFile))
+
+	cmd := genesis.VerifyGenesisCmd(cs)
+	out := &bytes.Buffer{}
+	cmd.SetOut(out)
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{"--chain-spec", name})
+	err = cmd.ExecuteContext(context.WithValue(
+		context.Background(), server.ServerContextKey, serverCtx,
+	))
+	return out.String(), err
+}
+
+func TestVerifyGenesis(t *testing.T) {
+	out, err := runVerify(t, spec.TestnetChainSpec(), "testnet")
+	require.NoError(t, err)
+	require.Equal(t, "genesis matches chain spec testnet\n", out)
+}
+
+func TestVerifyGenesisMismatch(t *testing.T) {
+	data := spec.BaseSpec()
+	data.DepositContractAddress = common.HexToAddress(
+		"0x1111111111111111111111111111111111111111",
+	)
+	data.SlotsPerEpoch = 16
+
+	out, err := runVerify(t, chain.NewChainSpec(data), "testnet")
+	require.ErrorIs(t, err, genesis.ErrGenesisMismatch)
+	require.Contains(t, out, "deposit contract address: got "+
+		"0x1111111111111111111111111111111111111111, expected "+
+		"0x4242424242424242424242424242424242424242\n")
+	require.Contains(t, out, "slots per epoch: got 16, expected 32\n")
+
+	// The testnet chain spec differs from the devnet one.
+	out, err = runVerify(t, spec.TestnetChainSpec(), "devnet")
+	require.ErrorIs(t, err, genesis.ErrGenesisMismatch)
+	require.Equal(
+		t, "deposit eth1 chain id: got 80084, expected 80087\n", out,
+	)
+
+	_, err = runVerify(t, spec.TestnetChainSpec(), "mainnet")
+	require.ErrorIs(t, err, genesis.ErrUnknownChainSpec)
+}
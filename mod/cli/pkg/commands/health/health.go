@@ -0,0 +1,137 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package health
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// addrFlag is the flag for the address of the health server.
+	addrFlag = "addr"
+	// addrFlagMsg is the usage description for the addrFlag flag.
+	addrFlagMsg = "address the health server of the node listens on"
+
+	// requestTimeout is the timeout of the request for the health report.
+	requestTimeout = 10 * time.Second
+)
+
+var (
+	// ErrNodeUnhealthy is returned when the node reports itself as
+	// unhealthy.
+	ErrNodeUnhealthy = errors.New("node is unhealthy")
+
+	// ErrUnexpectedResponse is returned when the health server does not
+	// respond with a health report.
+	ErrUnexpectedResponse = errors.New("unexpected response")
+)
+
+// Command creates a new command for printing the health of a running node.
+func Command() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "health",
+		Short: "Prints the health of the running node",
+		Long: `Prints the health of each of the subsystems of the running node,
+along with its overall health, as reported by its health server. The command
+fails if the node is unhealthy.`,
+		Args: cobra.NoArgs,
+		RunE: printHealth,
+	}
+
+	cmd.Flags().String(addrFlag, "", addrFlagMsg)
+	if err := cmd.MarkFlagRequired(addrFlag); err != nil {
+		panic(err)
+	}
+	return cmd
+}
+
+// printHealth requests the health report of the node from the health server
+// at the address given by the addr flag and prints it.
+func printHealth(cmd *cobra.Command, _ []string) error {
+	addr, err := cmd.Flags().GetString(addrFlag)
+	if err != nil {
+		return err
+	}
+	cmd.SilenceUsage = true
+
+	report, err := getReport(cmd, addr)
+	if err != nil {
+		return err
+	}
+	if _, err = fmt.Fprintf(
+		cmd.OutOrStdout(),
+		"status:             %s\n"+
+			"sync:               %s\n"+
+			"availability_store: %s\n"+
+			"deposit_store:      %s\n"+
+			"peers:              %s (%d peers)\n",
+		report.Status,
+		report.Sync,
+		report.AvailabilityStore,
+		report.DepositStore,
+		report.Peers,
+		report.PeerCount,
+	); err != nil {
+		return err
+	}
+
+	if report.Status == types.HealthStatusUnhealthy {
+		return ErrNodeUnhealthy
+	}
+	return nil
+}
+
+// getReport requests the health report of the node from the health server
+// at the given address.
+func getReport(cmd *cobra.Command, addr string) (types.HealthReport, error) {
+	var report types.HealthReport
+	req, err := http.NewRequestWithContext(
+		cmd.Context(), http.MethodGet, "http://"+addr+"/health", http.NoBody,
+	)
+	if err != nil {
+		return report, err
+	}
+	client := &http.Client{Timeout: requestTimeout}
+	resp, err := client.Do(req)
+	if err != nil {
+		return report, err
+	}
+	defer resp.Body.Close()
+
+	// The report of an unhealthy node is served as unavailable.
+	if resp.StatusCode != http.StatusOK &&
+		resp.StatusCode != http.StatusServiceUnavailable {
+		return report, errors.Wrapf(
+			ErrUnexpectedResponse, "status %s", resp.Status,
+		)
+	}
+	if err = json.NewDecoder(resp.Body).Decode(&report); err != nil {
+		return report, errors.Wrap(ErrUnexpectedResponse, err.Error())
+	}
+	return report, nil
+}
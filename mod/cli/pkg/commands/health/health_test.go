@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package health_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/health"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+// runHealth executes the health command against a health server serving the
+// given report and returns its output.
+func runHealth(
+	t *testing.T,
+	report types.HealthReport,
+) (string, error) {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/health" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			if report.Status == types.HealthStatusUnhealthy {
+				w.WriteHeader(http.StatusServiceUnavailable)
+			}
+			if err := json.NewEncoder(w).Encode(report); err != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+			}
+		},
+	))
+	defer srv.Close()
+
+	cmd := health.Command()
+	out := new(bytes.Buffer)
+	cmd.SetOut(out)
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{
+		"--addr", strings.TrimPrefix(srv.URL, "http://"),
+	})
+	err := cmd.ExecuteContext(context.Background())
+	return out.String(), err
+}
+
+func TestHealth(t *testing.T) {
+	out, err := runHealth(t, types.HealthReport{
+		Status:    types.HealthStatusDegraded,
+		Peers:     types.HealthStatusDegraded,
+		PeerCount: 0,
+	})
+	require.NoError(t, err)
+	require.Equal(t,
+		"status:             degraded\n"+
+			"sync:               healthy\n"+
+			"availability_store: healthy\n"+
+			"deposit_store:      healthy\n"+
+			"peers:              degraded (0 peers)\n",
+		out,
+	)
+}
+
+func TestHealthUnhealthy(t *testing.T) {
+	out, err := runHealth(t, types.HealthReport{
+		Status:    types.HealthStatusUnhealthy,
+		Sync:      types.HealthStatusUnhealthy,
+		PeerCount: 5,
+	})
+	require.ErrorIs(t, err, health.ErrNodeUnhealthy)
+	require.Contains(t, out, "status:             unhealthy\n")
+	require.Contains(t, out, "sync:               unhealthy\n")
+	require.Contains(t, out, "peers:              healthy (5 peers)\n")
+}
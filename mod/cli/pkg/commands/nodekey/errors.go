@@ -0,0 +1,42 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package nodekey
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrNodeKeyMissing is returned when the p2p key file of the node does
+	// not exist.
+	ErrNodeKeyMissing = errors.New("node_key.json missing")
+	// ErrNodeKeyMalformed is returned when the p2p key file of the node
+	// cannot be decoded.
+	ErrNodeKeyMalformed = errors.New("node_key.json malformed")
+	// ErrValidatorKeyMissing is returned when the validator key file of the
+	// node does not exist.
+	ErrValidatorKeyMissing = errors.New("priv_validator_key.json missing")
+	// ErrValidatorKeyMalformed is returned when the validator key file of the
+	// node cannot be decoded or its public key does not match its private
+	// key.
+	ErrValidatorKeyMalformed = errors.New("priv_validator_key.json malformed")
+	// ErrKeyringUnavailable is returned when the keyring of the node cannot
+	// be opened with the configured backend.
+	ErrKeyringUnavailable = errors.New("keyring unavailable")
+)
@@ -0,0 +1,199 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package nodekey
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/privval"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/spf13/cobra"
+)
+
+// Commands creates the command managing the p2p and validator keys of the
+// node, which is added to the keys command.
+func Commands(newKeyring KeyringCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "node",
+		Short:                      "Inspect the p2p and validator keys of the node",
+		SuggestionsMinimumDistance: 2, //nolint:mnd // from sdk.
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewVerifyCommand(newKeyring),
+		NewShowCommand(),
+	)
+
+	return cmd
+}
+
+// NewVerifyCommand creates the command verifying that the key files of the
+// node exist and are well-formed, and that the keyring of the node can be
+// opened with the configured backend.
+func NewVerifyCommand(newKeyring KeyringCreator) *cobra.Command {
+	return &cobra.Command{
+		Use:   "verify",
+		Short: "Verifies the p2p and validator keys of the node",
+		Long: `Verifies that the node_key.json and priv_validator_key.json files
+of the node exist and are well-formed, and that the keyring of the node can be
+opened with the configured keyring backend.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cfg := server.GetServerContextFromCmd(cmd).Config
+			if _, err := loadNodeKey(cfg.NodeKeyFile()); err != nil {
+				return err
+			}
+			if _, err := loadValidatorKey(
+				cfg.PrivValidatorKeyFile(),
+			); err != nil {
+				return err
+			}
+			backend, err := verifyKeyring(cmd, newKeyring)
+			if err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			fmt.Fprintf(out, "node key:        ok (%s)\n", cfg.NodeKeyFile())
+			fmt.Fprintf(
+				out, "validator key:   ok (%s)\n", cfg.PrivValidatorKeyFile(),
+			)
+			fmt.Fprintf(out, "keyring backend: ok (%s)\n", backend)
+			return nil
+		},
+	}
+}
+
+// NewShowCommand creates the command printing the node id and the public keys
+// of the node.
+func NewShowCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "show",
+		Short: "Prints the node id and the public keys of the node",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cfg := server.GetServerContextFromCmd(cmd).Config
+			nodeKey, err := loadNodeKey(cfg.NodeKeyFile())
+			if err != nil {
+				return err
+			}
+			pvKey, err := loadValidatorKey(cfg.PrivValidatorKeyFile())
+			if err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			fmt.Fprintf(out, "node_id:          %s\n", nodeKey.ID())
+			fmt.Fprintf(out, "node_pubkey:      %#x\n", nodeKey.PubKey().Bytes())
+			fmt.Fprintf(out, "validator_pubkey: %#x\n", pvKey.PubKey.Bytes())
+			return nil
+		},
+	}
+}
+
+// loadNodeKey loads the p2p key of the node from the given file.
+func loadNodeKey(path string) (*p2p.NodeKey, error) {
+	if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
+		return nil, errors.Wrapf(
+			ErrNodeKeyMissing,
+			"expected at %s, run the init command to generate it", path,
+		)
+	}
+	nodeKey, err := p2p.LoadNodeKey(path)
+	if err != nil {
+		return nil, errors.Wrapf(ErrNodeKeyMalformed, "%s: %v", path, err)
+	}
+	if nodeKey.PrivKey == nil {
+		return nil, errors.Wrapf(
+			ErrNodeKeyMalformed, "%s: no private key", path,
+		)
+	}
+	return nodeKey, nil
+}
+
+// loadValidatorKey loads the validator key of the node from the given file,
+// checking that its public key and address are derived from its private key.
+func loadValidatorKey(path string) (privval.FilePVKey, error) {
+	var pvKey privval.FilePVKey
+	bz, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return pvKey, errors.Wrapf(
+			ErrValidatorKeyMissing,
+			"expected at %s, run the init command to generate it", path,
+		)
+	} else if err != nil {
+		return pvKey, err
+	}
+
+	if err = cmtjson.Unmarshal(bz, &pvKey); err != nil {
+		return pvKey, errors.Wrapf(
+			ErrValidatorKeyMalformed, "%s: %v", path, err,
+		)
+	}
+	switch {
+	case pvKey.PrivKey == nil:
+		return pvKey, errors.Wrapf(
+			ErrValidatorKeyMalformed, "%s: no private key", path,
+		)
+	case pvKey.PubKey == nil ||
+		!pvKey.PubKey.Equals(pvKey.PrivKey.PubKey()):
+		return pvKey, errors.Wrapf(
+			ErrValidatorKeyMalformed,
+			"%s: public key does not match private key", path,
+		)
+	case !bytes.Equal(pvKey.Address, pvKey.PubKey.Address()):
+		return pvKey, errors.Wrapf(
+			ErrValidatorKeyMalformed,
+			"%s: address does not match public key", path,
+		)
+	}
+	return pvKey, nil
+}
+
+// verifyKeyring opens the keyring of the node and returns its backend, which
+// must be one of the backends supported by the node.
+func verifyKeyring(
+	cmd *cobra.Command,
+	newKeyring KeyringCreator,
+) (string, error) {
+	kr, err := newKeyring(client.GetClientContextFromCmd(cmd))
+	if err != nil {
+		return "", errors.Wrapf(
+			ErrKeyringUnavailable,
+			"%v, check keyring-backend in client.toml", err,
+		)
+	}
+	if err = components.KeyringBackend(kr.Backend()).Validate(); err != nil {
+		return "", errors.Wrapf(
+			ErrKeyringUnavailable,
+			"%v, set keyring-backend in client.toml to os, file or test", err,
+		)
+	}
+	return kr.Backend(), nil
+}
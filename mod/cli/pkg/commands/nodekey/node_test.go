@@ -0,0 +1,164 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package nodekey_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/nodekey"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/privval"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+// testHome is a temporary home directory of a node.
+type testHome struct {
+	serverCtx *server.Context
+}
+
+func newTestHome(t *testing.T) *testHome {
+	t.Helper()
+	serverCtx := server.NewDefaultContext()
+	serverCtx.Config.SetRoot(t.TempDir())
+	for _, dir := range []string{"config", "data"} {
+		require.NoError(t, os.MkdirAll(
+			filepath.Join(serverCtx.Config.RootDir, dir), 0o700,
+		))
+	}
+	return &testHome{serverCtx: serverCtx}
+}
+
+// genNodeKey writes a new p2p key to the home directory.
+func (h *testHome) genNodeKey(t *testing.T) *p2p.NodeKey {
+	t.Helper()
+	nodeKey, err := p2p.LoadOrGenNodeKey(h.serverCtx.Config.NodeKeyFile())
+	require.NoError(t, err)
+	return nodeKey
+}
+
+// genValidatorKey writes a new validator key to the home directory.
+func (h *testHome) genValidatorKey() *privval.FilePV {
+	pv := privval.GenFilePV(
+		h.serverCtx.Config.PrivValidatorKeyFile(),
+		h.serverCtx.Config.PrivValidatorStateFile(),
+	)
+	pv.Save()
+	return pv
+}
+
+// run executes the node command with the given arguments against the home
+// directory, with the given keyring backend configured on the client
+// context.
+func (h *testHome) run(
+	t *testing.T,
+	backend string,
+	args ...string,
+) (string, error) {
+	t.Helper()
+	cmd := nodekey.Commands(
+		func(clientCtx client.Context) (keyring.Keyring, error) {
+			return components.NewKeyring(clientCtx, "")
+		},
+	)
+	out := new(bytes.Buffer)
+	cmd.SetOut(out)
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs(args)
+
+	kr, err := keyring.New(
+		sdk.KeyringServiceName(), backend, h.serverCtx.Config.RootDir,
+		nil, nil,
+	)
+	require.NoError(t, err)
+	clientCtx := client.Context{}.
+		WithHomeDir(h.serverCtx.Config.RootDir).
+		WithKeyringDir(h.serverCtx.Config.RootDir).
+		WithKeyring(kr)
+	ctx := context.WithValue(
+		context.Background(), server.ServerContextKey, h.serverCtx,
+	)
+	ctx = context.WithValue(ctx, client.ClientContextKey, &clientCtx)
+	err = cmd.ExecuteContext(ctx)
+	return out.String(), err
+}
+
+func TestVerify(t *testing.T) {
+	home := newTestHome(t)
+
+	_, err := home.run(t, keyring.BackendTest, "verify")
+	require.ErrorIs(t, err, nodekey.ErrNodeKeyMissing)
+	require.ErrorContains(t, err, "node_key.json missing")
+
+	home.genNodeKey(t)
+	_, err = home.run(t, keyring.BackendTest, "verify")
+	require.ErrorIs(t, err, nodekey.ErrValidatorKeyMissing)
+
+	home.genValidatorKey()
+	out, err := home.run(t, keyring.BackendTest, "verify")
+	require.NoError(t, err)
+	require.Contains(t, out, "keyring backend: ok (test)")
+
+	_, err = home.run(t, keyring.BackendMemory, "verify")
+	require.ErrorIs(t, err, nodekey.ErrKeyringUnavailable)
+	require.ErrorContains(t, err, `"memory": unsupported keyring backend`)
+}
+
+func TestVerifyMalformedKeys(t *testing.T) {
+	home := newTestHome(t)
+	home.genNodeKey(t)
+	home.genValidatorKey()
+
+	keyFile := home.serverCtx.Config.PrivValidatorKeyFile()
+	require.NoError(t, os.WriteFile(keyFile, []byte("{"), 0o600))
+	_, err := home.run(t, keyring.BackendTest, "verify")
+	require.ErrorIs(t, err, nodekey.ErrValidatorKeyMalformed)
+
+	nodeKeyFile := home.serverCtx.Config.NodeKeyFile()
+	require.NoError(t, os.WriteFile(nodeKeyFile, []byte("{}"), 0o600))
+	_, err = home.run(t, keyring.BackendTest, "verify")
+	require.ErrorIs(t, err, nodekey.ErrNodeKeyMalformed)
+}
+
+func TestShow(t *testing.T) {
+	home := newTestHome(t)
+
+	_, err := home.run(t, keyring.BackendTest, "show")
+	require.ErrorIs(t, err, nodekey.ErrNodeKeyMissing)
+
+	nodeKey := home.genNodeKey(t)
+	pv := home.genValidatorKey()
+	out, err := home.run(t, keyring.BackendTest, "show")
+	require.NoError(t, err)
+	require.Contains(t, out, "node_id:          "+string(nodeKey.ID()))
+	require.Contains(t, out, fmt.Sprintf(
+		"validator_pubkey: %#x", pv.Key.PubKey.Bytes(),
+	))
+}
@@ -21,12 +21,22 @@
 package commands
 
 import (
-	confixcmd "cosmossdk.io/tools/confix/cmd"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/admin"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/bench"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/client"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/cometbft"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/config"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/da"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/debug"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/deposit"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/deposits"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/forks"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/genesis"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/health"
 	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/jwt"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/nodekey"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/state"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/validators"
 	beaconconfig "github.com/berachain/beacon-kit/mod/node-core/pkg/config"
 	"github.com/berachain/beacon-kit/mod/primitives"
 	"github.com/cosmos/cosmos-sdk/client/keys"
@@ -41,11 +51,23 @@ import (
 )
 
 // DefaultRootCommandSetup sets up the default commands for the root command.
-func DefaultRootCommandSetup[T servertypes.Application](
+func DefaultRootCommandSetup[
+	T servertypes.Application,
+	BeaconStateT interface {
+		validators.BeaconState
+		deposits.BeaconState
+	},
+](
 	rootCmd *cobra.Command,
 	mm *module.Manager,
 	newApp servertypes.AppCreator[T],
 	chainSpec primitives.ChainSpec,
+	newBackend state.BackendCreator[BeaconStateT],
+	newDepositStore deposits.StoreCreator,
+	newAvailabilityStore da.StoreCreator,
+	newKeyring nodekey.KeyringCreator,
+	graphDOT debug.GraphCreator,
+	configDefaults config.Defaults,
 ) {
 	// Add the ToS Flag to the root command.
 	beaconconfig.AddToSFlag(rootCmd)
@@ -57,22 +79,36 @@ func DefaultRootCommandSetup[T servertypes.Application](
 
 	// Add all the commands to the root command.
 	rootCmd.AddCommand(
+		// `admin`
+		admin.Command(),
+		// `bench`
+		bench.Commands(chainSpec),
 		// `comet`
 		cometbft.Commands(newApp),
 		// `client`
 		client.Commands[T](),
 		// `config`
-		confixcmd.ConfigCommand(),
+		config.Commands(configDefaults),
+		// `health`
+		health.Command(),
 		// `init`
 		genutilcli.InitCmd(mm),
 		// `genesis`
 		genesis.Commands(chainSpec),
+		// `da`
+		da.Commands(chainSpec, newAvailabilityStore),
+		// `debug`
+		debug.Commands(chainSpec, graphDOT),
 		// `deposit`
 		deposit.Commands(chainSpec),
+		// `deposits`
+		deposits.Commands(newDepositStore, newBackend),
+		// `forks`
+		forks.Commands(chainSpec),
 		// `jwt`
 		jwt.Commands(),
 		// `keys`
-		keys.Commands(),
+		keysCommand(newKeyring),
 		// `prune`
 		pruning.Cmd(newApp),
 		// `rollback`
@@ -80,10 +116,22 @@ func DefaultRootCommandSetup[T servertypes.Application](
 		// `snapshots`
 		snapshot.Cmd(newApp),
 		// `start`
-		server.StartCmdWithOptions(newApp, startCmdOptions),
+		StartCmdWithOptions(newApp, startCmdOptions),
+		// `state`
+		state.Commands(newBackend),
 		// `status`
 		server.StatusCommand(),
+		// `validators`
+		validators.Commands(chainSpec, newBackend),
 		// `version`
 		version.NewVersionCommand(),
 	)
 }
+
+// keysCommand returns the keys command of the sdk, extended with the `node`
+// subcommand inspecting the p2p and validator keys of the node.
+func keysCommand(newKeyring nodekey.KeyringCreator) *cobra.Command {
+	cmd := keys.Commands()
+	cmd.AddCommand(nodekey.Commands(newKeyring))
+	return cmd
+}
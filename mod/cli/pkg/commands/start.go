@@ -0,0 +1,158 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package commands
+
+import (
+	"context"
+	"os/signal"
+	"syscall"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/config/flags"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+)
+
+// StartCmdWithOptions returns the start command of the node. In addition to
+// the default start command, it supports a dry-run mode in which the
+// application is resolved against an in-memory database and the command
+// exits without starting the node. The node is stopped once the context the
+// command is executed with is cancelled, or once the process receives SIGINT
+// or SIGTERM.
+func StartCmdWithOptions[T servertypes.Application](
+	appCreator servertypes.AppCreator[T],
+	opts server.StartCmdOptions[T],
+) *cobra.Command {
+	var cmd *cobra.Command
+	cmdCtx := func() context.Context { return cmd.Context() }
+	opts.PostSetup = bindContext(cmdCtx, opts.PostSetup)
+	opts.PostSetupStandalone = bindContext(cmdCtx, opts.PostSetupStandalone)
+
+	cmd = server.StartCmdWithOptions(appCreator, opts)
+	runE := cmd.RunE
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		if dryRun, _ := cmd.Flags().GetBool(flags.DryRun); dryRun {
+			return dryRunApp(cmd, appCreator)
+		}
+		return runWithSignals(cmd, args, runE)
+	}
+	return cmd
+}
+
+// runWithSignals runs the given start function with the context of the
+// command cancelled once the process receives SIGINT or SIGTERM. A shutdown
+// requested by a signal is not reported as an error.
+func runWithSignals(
+	cmd *cobra.Command,
+	args []string,
+	runE func(*cobra.Command, []string) error,
+) error {
+	parent := cmd.Context()
+	if parent == nil {
+		parent = context.Background()
+	}
+	ctx, stop := signal.NotifyContext(parent, syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+	cmd.SetContext(ctx)
+
+	err := runE(cmd, args)
+	if parent.Err() == nil && ctx.Err() != nil && errors.Is(err, ctx.Err()) {
+		return nil
+	}
+	return err
+}
+
+// shutdowner is implemented by applications which must be notified once the
+// server shuts down, before the consensus engine is stopped, such as to
+// release the blocks they hold.
+type shutdowner interface {
+	Shutdown()
+}
+
+// postSetupFn is the signature of the post setup hooks of the start command.
+type postSetupFn[T servertypes.Application] func(
+	T, *server.Context, client.Context, context.Context, *errgroup.Group,
+) error
+
+// bindContext wraps the given post setup hook such that the server is stopped
+// once the context returned by parent is cancelled, and such that the
+// application is shut down once the server is.
+func bindContext[T servertypes.Application](
+	parent func() context.Context,
+	postSetup postSetupFn[T],
+) postSetupFn[T] {
+	return func(
+		app T,
+		svrCtx *server.Context,
+		clientCtx client.Context,
+		ctx context.Context,
+		g *errgroup.Group,
+	) error {
+		g.Go(func() error {
+			select {
+			case <-parent().Done():
+				// Returning an error cancels the context of the server, but
+				// the server only returns once its listener of quit signals
+				// does, which is released by raising SIGTERM.
+				if ctx.Err() == nil {
+					_ = syscall.Kill(syscall.Getpid(), syscall.SIGTERM)
+				}
+				return parent().Err()
+			case <-ctx.Done():
+				return nil
+			}
+		})
+		if app, ok := any(app).(shutdowner); ok {
+			g.Go(func() error {
+				<-ctx.Done()
+				app.Shutdown()
+				return nil
+			})
+		}
+		if postSetup == nil {
+			return nil
+		}
+		return postSetup(app, svrCtx, clientCtx, ctx, g)
+	}
+}
+
+// dryRunApp creates the application without starting it, returning an error
+// if the application could not be created.
+func dryRunApp[T servertypes.Application](
+	cmd *cobra.Command,
+	appCreator servertypes.AppCreator[T],
+) (err error) {
+	serverCtx := server.GetServerContextFromCmd(cmd)
+
+	// The app creator panics if any of the components fails to resolve.
+	defer func() {
+		if r := recover(); r != nil {
+			err = errors.Newf("dry run failed: %v", r)
+		}
+	}()
+
+	appCreator(serverCtx.Logger, dbm.NewMemDB(), nil, serverCtx.Viper)
+	return nil
+}
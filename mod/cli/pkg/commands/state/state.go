@@ -0,0 +1,190 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package state
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+
+	"cosmossdk.io/store/rootmulti"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// latestSlot selects the latest beacon state.
+	latestSlot = "latest"
+
+	// outputJSON is the JSON output format.
+	outputJSON = "json"
+	// outputSSZ is the SSZ output format.
+	outputSSZ = "ssz"
+
+	// outFileMode is the mode of the file the beacon state is written to.
+	outFileMode = 0o600
+)
+
+// Commands creates a new command for inspecting the beacon state.
+func Commands[BeaconStateT BeaconState](
+	newBackend BackendCreator[BeaconStateT],
+) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "state",
+		Short:                      "beacon state subcommands",
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2, //nolint:mnd // from sdk.
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewDumpCommand(newBackend),
+	)
+
+	return cmd
+}
+
+// NewDumpCommand creates a new command for dumping the beacon state.
+func NewDumpCommand[BeaconStateT BeaconState](
+	newBackend BackendCreator[BeaconStateT],
+) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dump",
+		Short: "Dumps the beacon state at a slot",
+		Long: `Dumps the beacon state at the given slot, or the latest beacon
+state if no slot is given, as JSON or SSZ. The state is read from the
+application database of the node, which must not be running.`,
+		Args: cobra.NoArgs,
+		RunE: dumpState(newBackend),
+	}
+
+	cmd.Flags().String(slotFlag, defaultSlot, slotFlagMsg)
+	cmd.Flags().String(outputFlag, defaultOutput, outputFlagMsg)
+	cmd.Flags().String(outFlag, defaultOut, outFlagMsg)
+	return cmd
+}
+
+// dumpState loads the beacon state requested by the flags of the command
+// from the application database and writes it out.
+func dumpState[BeaconStateT BeaconState](
+	newBackend BackendCreator[BeaconStateT],
+) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, _ []string) error {
+		output, err := cmd.Flags().GetString(outputFlag)
+		if err != nil {
+			return err
+		}
+		if output != outputJSON && output != outputSSZ {
+			return errors.Wrapf(ErrUnsupportedOutput, "%q", output)
+		}
+
+		serverCtx := server.GetServerContextFromCmd(cmd)
+		db, err := server.OpenDB(
+			serverCtx.Config.RootDir,
+			server.GetAppDBBackend(serverCtx.Viper),
+		)
+		if err != nil {
+			return err
+		}
+		defer db.Close()
+
+		slotFlagValue, err := cmd.Flags().GetString(slotFlag)
+		if err != nil {
+			return err
+		}
+		slot, err := ResolveSlot(slotFlagValue, db)
+		if err != nil {
+			return err
+		}
+
+		backend, err := newBackend(serverCtx.Logger, db, serverCtx.Viper)
+		if err != nil {
+			return err
+		}
+		st, err := backend.StateAtSlot(cmd.Context(), slot)
+		if err != nil {
+			return fmt.Errorf("%w at slot %d: %w", ErrStateUnavailable, slot, err)
+		}
+
+		bz, err := marshalState(st, output)
+		if err != nil {
+			return err
+		}
+		return writeState(cmd, bz)
+	}
+}
+
+// getSlot returns the slot requested by the slot flag of the command. The
+// latest slot is the height of the last block committed to the database.
+// ResolveSlot returns the slot selected by the given slot flag value, which is
+// either a slot number or "latest" for the slot of the latest state committed
+// to the given application database.
+func ResolveSlot(slot string, db dbm.DB) (math.Slot, error) {
+	if slot == latestSlot {
+		//#nosec:G701 // the latest version is never negative.
+		return math.Slot(rootmulti.GetLatestVersion(db)), nil
+	}
+
+	n, err := strconv.ParseUint(slot, 10, 64)
+	if err != nil {
+		return 0, errors.Wrapf(ErrInvalidSlot, "%q", slot)
+	}
+	return math.Slot(n), nil
+}
+
+// marshalState encodes the beacon state in the given output format.
+func marshalState(st BeaconState, output string) ([]byte, error) {
+	if output == outputSSZ {
+		return st.MarshalSSZ()
+	}
+
+	bz, err := st.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err = json.Indent(&buf, bz, "", "  "); err != nil {
+		return nil, err
+	}
+	buf.WriteByte('\n')
+	return buf.Bytes(), nil
+}
+
+// writeState writes the encoded beacon state to the file given by the out
+// flag of the command, or to the output of the command if it is unset.
+func writeState(cmd *cobra.Command, bz []byte) error {
+	out, err := cmd.Flags().GetString(outFlag)
+	if err != nil {
+		return err
+	}
+
+	if out != "" {
+		return os.WriteFile(out, bz, outFileMode)
+	}
+	_, err = cmd.OutOrStdout().Write(bz)
+	return err
+}
@@ -0,0 +1,133 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package state_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/state"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/stretchr/testify/require"
+)
+
+var errSlotOutOfRange = errors.New("slot out of range")
+
+// testState is a beacon state that only encodes its slot.
+type testState struct {
+	Slot math.Slot
+}
+
+func (s *testState) MarshalSSZ() ([]byte, error) {
+	return s.Slot.MarshalSSZ()
+}
+
+func (s *testState) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Slot math.Slot `json:"slot"`
+	}{s.Slot})
+}
+
+// testBackend serves the states of the slots up to its latest slot.
+type testBackend struct {
+	latest math.Slot
+}
+
+func (b testBackend) StateAtSlot(
+	_ context.Context,
+	slot math.Slot,
+) (*testState, error) {
+	if slot == 0 || slot > b.latest {
+		return nil, errSlotOutOfRange
+	}
+	return &testState{Slot: slot}, nil
+}
+
+// newTestBackend returns a BackendCreator of a testBackend.
+func newTestBackend(latest math.Slot) state.BackendCreator[*testState] {
+	return func(
+		log.Logger, dbm.DB, servertypes.AppOptions,
+	) (state.StorageBackend[*testState], error) {
+		return testBackend{latest: latest}, nil
+	}
+}
+
+// runDump executes the dump command against a temporary home directory and
+// returns its output.
+func runDump(t *testing.T, args ...string) ([]byte, error) {
+	t.Helper()
+	serverCtx := server.NewDefaultContext()
+	serverCtx.Config.SetRoot(t.TempDir())
+
+	cmd := state.NewDumpCommand(newTestBackend(3))
+	out := &bytes.Buffer{}
+	cmd.SetOut(out)
+	cmd.SetArgs(args)
+	err := cmd.ExecuteContext(context.WithValue(
+		context.Background(), server.ServerContextKey, serverCtx,
+	))
+	return out.Bytes(), err
+}
+
+func TestDump(t *testing.T) {
+	out, err := runDump(t, "--slot", "2")
+	require.NoError(t, err)
+	require.JSONEq(t, `{"slot":"0x2"}`, string(out))
+
+	out, err = runDump(t, "--slot", "3", "--output", "ssz")
+	require.NoError(t, err)
+	want, err := math.Slot(3).MarshalSSZ()
+	require.NoError(t, err)
+	require.Equal(t, want, out)
+
+	file := filepath.Join(t.TempDir(), "state.json")
+	out, err = runDump(t, "--slot", "1", "--out", file)
+	require.NoError(t, err)
+	require.Empty(t, out)
+	bz, err := os.ReadFile(file)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"slot":"0x1"}`, string(bz))
+}
+
+func TestDumpErrors(t *testing.T) {
+	// Nothing has been committed to the database, so no state is available.
+	_, err := runDump(t)
+	require.ErrorIs(t, err, state.ErrStateUnavailable)
+
+	_, err = runDump(t, "--slot", "4")
+	require.ErrorIs(t, err, state.ErrStateUnavailable)
+	require.ErrorIs(t, err, errSlotOutOfRange)
+
+	_, err = runDump(t, "--slot", "two")
+	require.ErrorIs(t, err, state.ErrInvalidSlot)
+
+	_, err = runDump(t, "--slot", "2", "--output", "yaml")
+	require.ErrorIs(t, err, state.ErrUnsupportedOutput)
+}
@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package state
+
+import (
+	"context"
+
+	"cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	dbm "github.com/cosmos/cosmos-db"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+// BeaconState is the beacon state dumped by the state commands.
+type BeaconState interface {
+	// MarshalSSZ returns the SSZ encoding of the beacon state.
+	MarshalSSZ() ([]byte, error)
+	// MarshalJSON returns the JSON encoding of the beacon state.
+	MarshalJSON() ([]byte, error)
+}
+
+// StorageBackend is the storage backend the beacon states are loaded from.
+type StorageBackend[BeaconStateT BeaconState] interface {
+	// StateAtSlot returns the beacon state at the given slot.
+	StateAtSlot(ctx context.Context, slot math.Slot) (BeaconStateT, error)
+}
+
+// BackendCreator creates the storage backend of the node on top of its
+// application database.
+type BackendCreator[BeaconStateT BeaconState] func(
+	logger log.Logger,
+	db dbm.DB,
+	appOpts servertypes.AppOptions,
+) (StorageBackend[BeaconStateT], error)
@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validators
+
+const (
+	// slotFlag is the flag for the slot of the validator set.
+	slotFlag = "slot"
+
+	// formatFlag is the flag for the format of the validator set.
+	formatFlag = "format"
+
+	// outFlag is the flag for the file the validator set is written to.
+	outFlag = "out"
+)
+
+const (
+	// defaultSlot is the default value for the slotFlag flag.
+	defaultSlot = "latest"
+
+	// defaultFormat is the default value for the formatFlag flag.
+	defaultFormat = formatCSV
+
+	// defaultOut is the default value for the outFlag flag.
+	defaultOut = ""
+)
+
+const (
+	// slotFlagMsg is the usage description for the slotFlag flag.
+	slotFlagMsg = `slot of the validator set, or "latest" for the latest set`
+
+	// formatFlagMsg is the usage description for the formatFlag flag.
+	formatFlagMsg = "format of the validator set (csv|json)"
+
+	// outFlagMsg is the usage description for the outFlag flag.
+	outFlagMsg = "file to write the validator set to instead of stdout"
+)
@@ -0,0 +1,249 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validators
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/state"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// formatCSV is the CSV export format.
+	formatCSV = "csv"
+	// formatJSON is the JSON export format.
+	formatJSON = "json"
+
+	// outFileMode is the mode of the file the validator set is written to.
+	outFileMode = 0o600
+)
+
+// Commands creates a new command for inspecting the validator set.
+func Commands[BeaconStateT BeaconState](
+	chainSpec primitives.ChainSpec,
+	newBackend state.BackendCreator[BeaconStateT],
+) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "validators",
+		Short:                      "validator set subcommands",
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2, //nolint:mnd // from sdk.
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewExportCommand(chainSpec, newBackend),
+	)
+
+	return cmd
+}
+
+// NewExportCommand creates a new command for exporting the validator set.
+func NewExportCommand[BeaconStateT BeaconState](
+	chainSpec primitives.ChainSpec,
+	newBackend state.BackendCreator[BeaconStateT],
+) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Exports the validator set at a slot",
+		Long: `Exports the validator set of the beacon state at the given slot,
+or of the latest beacon state if no slot is given, as CSV or JSON. Every
+validator is exported with its index, public key, effective balance and
+status. The state is read from the application database of the node, which
+must not be running.`,
+		Args: cobra.NoArgs,
+		RunE: exportValidators(chainSpec, newBackend),
+	}
+
+	cmd.Flags().String(slotFlag, defaultSlot, slotFlagMsg)
+	cmd.Flags().String(formatFlag, defaultFormat, formatFlagMsg)
+	cmd.Flags().String(outFlag, defaultOut, outFlagMsg)
+	return cmd
+}
+
+// validator is a validator of the exported validator set.
+type validator struct {
+	Index            uint64           `json:"index"`
+	Pubkey           crypto.BLSPubkey `json:"pubkey"`
+	EffectiveBalance uint64           `json:"effective_balance"`
+	Status           string           `json:"status"`
+}
+
+func exportValidators[BeaconStateT BeaconState](
+	chainSpec primitives.ChainSpec,
+	newBackend state.BackendCreator[BeaconStateT],
+) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, _ []string) error {
+		format, err := cmd.Flags().GetString(formatFlag)
+		if err != nil {
+			return err
+		}
+		if format != formatCSV && format != formatJSON {
+			return errors.Wrapf(ErrUnsupportedFormat, "%q", format)
+		}
+		slotFlagValue, err := cmd.Flags().GetString(slotFlag)
+		if err != nil {
+			return err
+		}
+
+		serverCtx := server.GetServerContextFromCmd(cmd)
+		db, err := server.OpenDB(
+			serverCtx.Config.RootDir,
+			server.GetAppDBBackend(serverCtx.Viper),
+		)
+		if err != nil {
+			return err
+		}
+		defer db.Close()
+
+		slot, err := state.ResolveSlot(slotFlagValue, db)
+		if err != nil {
+			return err
+		}
+
+		backend, err := newBackend(serverCtx.Logger, db, serverCtx.Viper)
+		if err != nil {
+			return err
+		}
+		st, err := backend.StateAtSlot(cmd.Context(), slot)
+		if err != nil {
+			return fmt.Errorf(
+				"%w at slot %d: %w", state.ErrStateUnavailable, slot, err,
+			)
+		}
+		vals, err := st.GetValidators()
+		if err != nil {
+			return err
+		}
+
+		bz, err := marshalValidators(
+			newValidators(vals, chainSpec.SlotToEpoch(slot)), format,
+		)
+		if err != nil {
+			return err
+		}
+		return writeValidators(cmd, bz)
+	}
+}
+
+// newValidators returns the exported validator set of the given validator
+// registry at the given epoch.
+func newValidators(
+	vals []*types.Validator,
+	epoch math.Epoch,
+) []validator {
+	exported := make([]validator, len(vals))
+	for i, val := range vals {
+		exported[i] = validator{
+			Index:            uint64(i),
+			Pubkey:           val.GetPubkey(),
+			EffectiveBalance: uint64(val.GetEffectiveBalance()),
+			Status:           status(val, epoch),
+		}
+	}
+	return exported
+}
+
+// status returns the status of the validator at the given epoch, as defined
+// by the beacon node API. Validators are never reported as withdrawal_done,
+// as their balances are not considered.
+func status(val *types.Validator, epoch math.Epoch) string {
+	farFutureEpoch := math.Epoch(constants.FarFutureEpoch)
+	switch {
+	case epoch < val.ActivationEpoch:
+		if val.ActivationEligibilityEpoch == farFutureEpoch {
+			return "pending_initialized"
+		}
+		return "pending_queued"
+	case epoch < val.ExitEpoch:
+		if val.IsSlashed() {
+			return "active_slashed"
+		}
+		if val.ExitEpoch == farFutureEpoch {
+			return "active_ongoing"
+		}
+		return "active_exiting"
+	case epoch < val.WithdrawableEpoch:
+		if val.IsSlashed() {
+			return "exited_slashed"
+		}
+		return "exited_unslashed"
+	default:
+		return "withdrawal_possible"
+	}
+}
+
+func marshalValidators(vals []validator, format string) ([]byte, error) {
+	if format == formatJSON {
+		bz, err := json.MarshalIndent(vals, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		return append(bz, '\n'), nil
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write([]string{
+		"index", "pubkey", "effective_balance", "status",
+	}); err != nil {
+		return nil, err
+	}
+	for _, val := range vals {
+		if err := w.Write([]string{
+			strconv.FormatUint(val.Index, 10),
+			val.Pubkey.String(),
+			strconv.FormatUint(val.EffectiveBalance, 10),
+			val.Status,
+		}); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
+
+func writeValidators(cmd *cobra.Command, bz []byte) error {
+	out, err := cmd.Flags().GetString(outFlag)
+	if err != nil {
+		return err
+	}
+
+	if out != "" {
+		return os.WriteFile(out, bz, outFileMode)
+	}
+	_, err = cmd.OutOrStdout().Write(bz)
+	return err
+}
@@ -0,0 +1,171 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validators_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/state"
+	"github.com/berachain/beacon-kit/mod/cli/pkg/commands/validators"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/config/spec"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/stretchr/testify/require"
+)
+
+var errSlotOutOfRange = errors.New("slot out of range")
+
+type testState struct {
+	validators []*types.Validator
+}
+
+func (s *testState) MarshalSSZ() ([]byte, error) {
+	return nil, nil
+}
+
+func (s *testState) MarshalJSON() ([]byte, error) {
+	return nil, nil
+}
+
+func (s *testState) GetValidators() ([]*types.Validator, error) {
+	return s.validators, nil
+}
+
+type testBackend struct {
+	latest math.Slot
+}
+
+// StateAtSlot returns a state with one more validator at every slot, the
+// validator activated last exits at slot 2.
+func (b testBackend) StateAtSlot(
+	_ context.Context,
+	slot math.Slot,
+) (*testState, error) {
+	if slot == 0 || slot > b.latest {
+		return nil, errSlotOutOfRange
+	}
+	farFutureEpoch := math.Epoch(constants.FarFutureEpoch)
+	vals := make([]*types.Validator, slot)
+	for i := range vals {
+		vals[i] = &types.Validator{
+			Pubkey:                     crypto.BLSPubkey{byte(i + 1)},
+			EffectiveBalance:           math.Gwei(32e9),
+			ActivationEligibilityEpoch: 0,
+			ActivationEpoch:            0,
+			ExitEpoch:                  farFutureEpoch,
+			WithdrawableEpoch:          farFutureEpoch,
+		}
+	}
+	if slot > 1 {
+		vals[slot-1].ExitEpoch = 2
+	}
+	return &testState{validators: vals}, nil
+}
+
+// newTestBackend returns a BackendCreator of a testBackend.
+func newTestBackend(latest math.Slot) state.BackendCreator[*testState] {
+	return func(
+		log.Logger, dbm.DB, servertypes.AppOptions,
+	) (state.StorageBackend[*testState], error) {
+		return testBackend{latest: latest}, nil
+	}
+}
+
+// runExport executes the export command against a temporary home directory
+// and returns its output.
+func runExport(t *testing.T, args ...string) ([]byte, error) {
+	t.Helper()
+	serverCtx := server.NewDefaultContext()
+	serverCtx.Config.SetRoot(t.TempDir())
+
+	cmd := validators.NewExportCommand(
+		spec.DevnetChainSpec(), newTestBackend(3),
+	)
+	out := &bytes.Buffer{}
+	cmd.SetOut(out)
+	cmd.SetArgs(args)
+	err := cmd.ExecuteContext(context.WithValue(
+		context.Background(), server.ServerContextKey, serverCtx,
+	))
+	return out.Bytes(), err
+}
+
+func TestExport(t *testing.T) {
+	pubkey := func(b byte) string {
+		return crypto.BLSPubkey{b}.String()
+	}
+
+	out, err := runExport(t, "--slot", "3")
+	require.NoError(t, err)
+	records, err := csv.NewReader(bytes.NewReader(out)).ReadAll()
+	require.NoError(t, err)
+	require.Equal(t, [][]string{
+		{"index", "pubkey", "effective_balance", "status"},
+		{"0", pubkey(1), "32000000000", "active_ongoing"},
+		{"1", pubkey(2), "32000000000", "active_ongoing"},
+		{"2", pubkey(3), "32000000000", "active_exiting"},
+	}, records)
+
+	file := filepath.Join(t.TempDir(), "validators.json")
+	out, err = runExport(t, "--slot", "2", "--format", "json", "--out", file)
+	require.NoError(t, err)
+	require.Empty(t, out)
+	bz, err := os.ReadFile(file)
+	require.NoError(t, err)
+	var vals []map[string]any
+	require.NoError(t, json.Unmarshal(bz, &vals))
+	require.Len(t, vals, 2)
+	require.Equal(t, map[string]any{
+		"index":             float64(1),
+		"pubkey":            pubkey(2),
+		"effective_balance": float64(32e9),
+		"status":            "active_exiting",
+	}, vals[1])
+}
+
+func TestExportErrors(t *testing.T) {
+	// Nothing has been committed to the database, so no state is available.
+	_, err := runExport(t)
+	require.ErrorIs(t, err, state.ErrStateUnavailable)
+
+	_, err = runExport(t, "--slot", "4")
+	require.ErrorIs(t, err, state.ErrStateUnavailable)
+	require.ErrorIs(t, err, errSlotOutOfRange)
+
+	_, err = runExport(t, "--slot", "two")
+	require.ErrorIs(t, err, state.ErrInvalidSlot)
+
+	_, err = runExport(t, "--format", "xml")
+	require.ErrorIs(t, err, validators.ErrUnsupportedFormat)
+}
@@ -101,6 +101,11 @@ func ConvertVersion(version string) (primitives.Version, error) {
 
 // ConvertGenesisValidatorRoot converts a string to a genesis validator root.
 func ConvertGenesisValidatorRoot(root string) (primitives.Root, error) {
+	return ConvertRoot(root)
+}
+
+// ConvertRoot converts a string to a root.
+func ConvertRoot(root string) (primitives.Root, error) {
 	rootBytes, err := bytes.FromHex(root)
 	if err != nil {
 		return primitives.Root{}, err
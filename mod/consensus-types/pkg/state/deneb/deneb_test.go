@@ -27,11 +27,13 @@
 package deneb_test
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/state/deneb"
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	ssz "github.com/ferranbt/fastssz"
 	"github.com/stretchr/testify/require"
 )
@@ -102,3 +104,55 @@ func TestBeaconState_MarshalSSZTo(t *testing.T) {
 	// The two byte slices should be equal
 	require.Equal(t, data, buf)
 }
+
+// generateLargeBeaconState generates a beacon state for the Deneb with the
+// given number of validators.
+func generateLargeBeaconState(numValidators int) *deneb.BeaconState {
+	state := generateValidBeaconState()
+	state.Slot = 42
+	state.BlockRoots = make([]primitives.Root, 8192)
+	state.StateRoots = make([]primitives.Root, 8192)
+	state.RandaoMixes = make([]primitives.Bytes32, 65536)
+	state.LatestExecutionPayloadHeader.ExtraData = []byte("extra")
+	for i := range numValidators {
+		state.Validators = append(state.Validators, &types.Validator{
+			EffectiveBalance:  math.Gwei(i),
+			WithdrawableEpoch: math.Epoch(i),
+		})
+		state.Balances = append(state.Balances, uint64(i))
+		state.Slashings = append(state.Slashings, uint64(i%7))
+	}
+	state.BlockRoots[1][0] = 1
+	state.RandaoMixes[2][0] = 2
+	return state
+}
+
+func TestBeaconState_MarshalSSZToWriter(t *testing.T) {
+	state := generateLargeBeaconState(10_000)
+	data, err := state.MarshalSSZ()
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, state.MarshalSSZToWriter(&buf))
+	require.Equal(t, data, buf.Bytes())
+
+	newState := &deneb.BeaconState{}
+	require.NoError(t, newState.UnmarshalSSZFromReader(&buf))
+	require.Equal(t, state, newState)
+}
+
+func TestBeaconState_UnmarshalSSZFromReader_Error(t *testing.T) {
+	data, err := generateLargeBeaconState(10).MarshalSSZ()
+	require.NoError(t, err)
+
+	// The encoding of the state ends before its last fields.
+	err = new(deneb.BeaconState).UnmarshalSSZFromReader(
+		bytes.NewReader(data[:len(data)-200]),
+	)
+	require.ErrorIs(t, err, ssz.ErrSize)
+
+	err = new(deneb.BeaconState).UnmarshalSSZFromReader(
+		bytes.NewReader([]byte{0x01, 0x02, 0x03}),
+	)
+	require.ErrorIs(t, err, ssz.ErrSize)
+}
@@ -0,0 +1,424 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deneb
+
+import (
+	"bufio"
+	"io"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	ssz "github.com/ferranbt/fastssz"
+)
+
+const (
+	// fixedSizeSSZ is the size of the fixed part of the SSZ encoding of the
+	// BeaconState, which holds the offsets of its variable size fields.
+	fixedSizeSSZ = 300
+	// rootSizeSSZ is the size of the SSZ encoding of a root or randao mix.
+	rootSizeSSZ = 32
+	// uint64SizeSSZ is the size of the SSZ encoding of a uint64.
+	uint64SizeSSZ = 8
+	// validatorSizeSSZ is the size of the SSZ encoding of a validator.
+	validatorSizeSSZ = 121
+	// maxRootsSSZ is the maximum length of the block and state roots.
+	maxRootsSSZ = 8192
+	// maxRandaoMixesSSZ is the maximum length of the randao mixes.
+	maxRandaoMixesSSZ = 65536
+	// maxRegistrySSZ is the maximum length of the validators, balances and
+	// slashings.
+	maxRegistrySSZ = 1099511627776
+)
+
+// offsetPositionsSSZ are the positions of the offsets of the variable size
+// fields of the BeaconState within the fixed part of its SSZ encoding.
+//
+//nolint:gochecknoglobals // constant lookup table.
+var offsetPositionsSSZ = []int{168, 172, 256, 260, 264, 268, 288}
+
+// MarshalSSZToWriter writes the SSZ encoding of the BeaconState object to w
+// one element at a time, such that the encoding of the whole state is never
+// held in memory. The bytes written are the same as those of MarshalSSZ.
+func (b *BeaconState) MarshalSSZToWriter(w io.Writer) error {
+	fixed, err := b.marshalFixedSSZ()
+	if err != nil {
+		return err
+	}
+
+	// Errors of the buffered writer are sticky, so they are only checked
+	// once all of the state has been written.
+	bw := bufio.NewWriter(w)
+	_, _ = bw.Write(fixed)
+
+	// Field (4) 'BlockRoots'
+	for _, root := range b.BlockRoots {
+		_, _ = bw.Write(root[:])
+	}
+
+	// Field (5) 'StateRoots'
+	for _, root := range b.StateRoots {
+		_, _ = bw.Write(root[:])
+	}
+
+	// Field (8) 'LatestExecutionPayloadHeader'
+	buf, err := b.LatestExecutionPayloadHeader.MarshalSSZ()
+	if err != nil {
+		return err
+	}
+	_, _ = bw.Write(buf)
+
+	// Field (9) 'Validators'
+	for _, val := range b.Validators {
+		if buf, err = val.MarshalSSZTo(buf[:0]); err != nil {
+			return err
+		}
+		_, _ = bw.Write(buf)
+	}
+
+	// Field (10) 'Balances'
+	for _, balance := range b.Balances {
+		_, _ = bw.Write(ssz.MarshalUint64(buf[:0], balance))
+	}
+
+	// Field (11) 'RandaoMixes'
+	for _, mix := range b.RandaoMixes {
+		_, _ = bw.Write(mix[:])
+	}
+
+	// Field (14) 'Slashings'
+	for _, slashing := range b.Slashings {
+		_, _ = bw.Write(ssz.MarshalUint64(buf[:0], slashing))
+	}
+	return bw.Flush()
+}
+
+// marshalFixedSSZ returns the fixed part of the SSZ encoding of the
+// BeaconState object, after checking the lengths of its variable size fields.
+func (b *BeaconState) marshalFixedSSZ() ([]byte, error) {
+	if err := b.checkListsSSZ(); err != nil {
+		return nil, err
+	}
+	if b.Fork == nil {
+		b.Fork = new(types.Fork)
+	}
+	if b.LatestBlockHeader == nil {
+		b.LatestBlockHeader = new(types.BeaconBlockHeader)
+	}
+	if b.Eth1Data == nil {
+		b.Eth1Data = new(types.Eth1Data)
+	}
+	if b.LatestExecutionPayloadHeader == nil {
+		b.LatestExecutionPayloadHeader = new(types.ExecutionPayloadHeaderDeneb)
+	}
+
+	var err error
+	dst := make([]byte, 0, fixedSizeSSZ)
+	offset := fixedSizeSSZ
+
+	// Field (0) 'GenesisValidatorsRoot'
+	dst = append(dst, b.GenesisValidatorsRoot[:]...)
+
+	// Field (1) 'Slot'
+	dst = ssz.MarshalUint64(dst, uint64(b.Slot))
+
+	// Field (2) 'Fork'
+	if dst, err = b.Fork.MarshalSSZTo(dst); err != nil {
+		return nil, err
+	}
+
+	// Field (3) 'LatestBlockHeader'
+	if dst, err = b.LatestBlockHeader.MarshalSSZTo(dst); err != nil {
+		return nil, err
+	}
+
+	// Offset (4) 'BlockRoots'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.BlockRoots) * rootSizeSSZ
+
+	// Offset (5) 'StateRoots'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.StateRoots) * rootSizeSSZ
+
+	// Field (6) 'Eth1Data'
+	if dst, err = b.Eth1Data.MarshalSSZTo(dst); err != nil {
+		return nil, err
+	}
+
+	// Field (7) 'Eth1DepositIndex'
+	dst = ssz.MarshalUint64(dst, b.Eth1DepositIndex)
+
+	// Offset (8) 'LatestExecutionPayloadHeader'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += b.LatestExecutionPayloadHeader.SizeSSZ()
+
+	// Offset (9) 'Validators'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.Validators) * validatorSizeSSZ
+
+	// Offset (10) 'Balances'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.Balances) * uint64SizeSSZ
+
+	// Offset (11) 'RandaoMixes'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.RandaoMixes) * rootSizeSSZ
+
+	// Field (12) 'NextWithdrawalIndex'
+	dst = ssz.MarshalUint64(dst, b.NextWithdrawalIndex)
+
+	// Field (13) 'NextWithdrawalValidatorIndex'
+	dst = ssz.MarshalUint64(dst, uint64(b.NextWithdrawalValidatorIndex))
+
+	// Offset (14) 'Slashings'
+	dst = ssz.WriteOffset(dst, offset)
+
+	// Field (15) 'TotalSlashing'
+	return ssz.MarshalUint64(dst, uint64(b.TotalSlashing)), nil
+}
+
+// checkListsSSZ returns an error if any of the lists of the BeaconState
+// object exceeds its maximum length.
+func (b *BeaconState) checkListsSSZ() error {
+	for _, list := range []struct {
+		name      string
+		size, max int
+	}{
+		{"BeaconState.BlockRoots", len(b.BlockRoots), maxRootsSSZ},
+		{"BeaconState.StateRoots", len(b.StateRoots), maxRootsSSZ},
+		{"BeaconState.Validators", len(b.Validators), maxRegistrySSZ},
+		{"BeaconState.Balances", len(b.Balances), maxRegistrySSZ},
+		{"BeaconState.RandaoMixes", len(b.RandaoMixes), maxRandaoMixesSSZ},
+		{"BeaconState.Slashings", len(b.Slashings), maxRegistrySSZ},
+	} {
+		if list.size > list.max {
+			return ssz.ErrListTooBigFn(list.name, list.size, list.max)
+		}
+	}
+	return nil
+}
+
+// UnmarshalSSZFromReader reads the SSZ encoding of a BeaconState object from
+// r one element at a time, such that the encoding of the whole state is never
+// held in memory. It reads r until EOF.
+func (b *BeaconState) UnmarshalSSZFromReader(r io.Reader) error {
+	br := bufio.NewReader(r)
+	buf := make([]byte, fixedSizeSSZ)
+	if err := readFullSSZ(br, buf); err != nil {
+		return err
+	}
+	offsets, err := b.unmarshalFixedSSZ(buf)
+	if err != nil {
+		return err
+	}
+
+	// Field (4) 'BlockRoots'
+	if b.BlockRoots, err = readListSSZ(
+		br, offsets[1]-offsets[0], rootSizeSSZ, maxRootsSSZ, decodeRoot,
+	); err != nil {
+		return err
+	}
+
+	// Field (5) 'StateRoots'
+	if b.StateRoots, err = readListSSZ(
+		br, offsets[2]-offsets[1], rootSizeSSZ, maxRootsSSZ, decodeRoot,
+	); err != nil {
+		return err
+	}
+
+	// Field (8) 'LatestExecutionPayloadHeader'
+	buf = make([]byte, offsets[3]-offsets[2])
+	if err = readFullSSZ(br, buf); err != nil {
+		return err
+	}
+	b.LatestExecutionPayloadHeader = new(types.ExecutionPayloadHeaderDeneb)
+	if err = b.LatestExecutionPayloadHeader.UnmarshalSSZ(buf); err != nil {
+		return err
+	}
+
+	// Field (9) 'Validators'
+	if b.Validators, err = readListSSZ(
+		br, offsets[4]-offsets[3], validatorSizeSSZ, maxRegistrySSZ,
+		decodeValidator,
+	); err != nil {
+		return err
+	}
+
+	// Field (10) 'Balances'
+	if b.Balances, err = readListSSZ(
+		br, offsets[5]-offsets[4], uint64SizeSSZ, maxRegistrySSZ, decodeUint64,
+	); err != nil {
+		return err
+	}
+
+	// Field (11) 'RandaoMixes'
+	if b.RandaoMixes, err = readListSSZ(
+		br, offsets[6]-offsets[5], rootSizeSSZ, maxRandaoMixesSSZ,
+		decodeBytes32,
+	); err != nil {
+		return err
+	}
+
+	// Field (14) 'Slashings', which runs until the end of the encoding.
+	b.Slashings, err = readSlashingsSSZ(br)
+	return err
+}
+
+// unmarshalFixedSSZ decodes the fixed size fields of the BeaconState object
+// from the fixed part of its SSZ encoding, and returns the offsets of its
+// variable size fields.
+func (b *BeaconState) unmarshalFixedSSZ(buf []byte) ([]int, error) {
+	// Field (0) 'GenesisValidatorsRoot'
+	copy(b.GenesisValidatorsRoot[:], buf[0:32])
+
+	// Field (1) 'Slot'
+	b.Slot = math.Slot(ssz.UnmarshallUint64(buf[32:40]))
+
+	// Field (2) 'Fork'
+	b.Fork = new(types.Fork)
+	if err := b.Fork.UnmarshalSSZ(buf[40:56]); err != nil {
+		return nil, err
+	}
+
+	// Field (3) 'LatestBlockHeader'
+	b.LatestBlockHeader = new(types.BeaconBlockHeader)
+	if err := b.LatestBlockHeader.UnmarshalSSZ(buf[56:168]); err != nil {
+		return nil, err
+	}
+
+	// Field (6) 'Eth1Data'
+	b.Eth1Data = new(types.Eth1Data)
+	if err := b.Eth1Data.UnmarshalSSZ(buf[176:248]); err != nil {
+		return nil, err
+	}
+
+	// Field (7) 'Eth1DepositIndex'
+	b.Eth1DepositIndex = ssz.UnmarshallUint64(buf[248:256])
+
+	// Field (12) 'NextWithdrawalIndex'
+	b.NextWithdrawalIndex = ssz.UnmarshallUint64(buf[272:280])
+
+	// Field (13) 'NextWithdrawalValidatorIndex'
+	b.NextWithdrawalValidatorIndex = math.ValidatorIndex(
+		ssz.UnmarshallUint64(buf[280:288]),
+	)
+
+	// Field (15) 'TotalSlashing'
+	b.TotalSlashing = math.Gwei(ssz.UnmarshallUint64(buf[292:300]))
+
+	// Offsets (4, 5, 8, 9, 10, 11, 14), which are in order such that the size
+	// of each variable size field but the last one is known.
+	offsets := make([]int, 0, len(offsetPositionsSSZ))
+	for _, pos := range offsetPositionsSSZ {
+		//#nosec:G701 // offsets are 4 bytes wide.
+		offset := int(ssz.ReadOffset(buf[pos : pos+4]))
+		if len(offsets) == 0 && offset != fixedSizeSSZ {
+			return nil, ssz.ErrInvalidVariableOffset
+		} else if len(offsets) > 0 && offset < offsets[len(offsets)-1] {
+			return nil, ssz.ErrOffset
+		}
+		offsets = append(offsets, offset)
+	}
+	return offsets, nil
+}
+
+// readListSSZ reads a list of fixed size elements, whose encoding spans size
+// bytes, from r, decoding each of its elements with decode.
+func readListSSZ[T any](
+	r io.Reader,
+	size, elemSize, limit int,
+	decode func([]byte) (T, error),
+) ([]T, error) {
+	num, err := ssz.DivideInt2(size, elemSize, limit)
+	if err != nil {
+		return nil, err
+	}
+	buf := make([]byte, elemSize)
+	list := make([]T, num)
+	for ii := range list {
+		if err = readFullSSZ(r, buf); err != nil {
+			return nil, err
+		}
+		if list[ii], err = decode(buf); err != nil {
+			return nil, err
+		}
+	}
+	return list, nil
+}
+
+// readSlashingsSSZ reads the slashings of a BeaconState object, which are
+// the last field of its SSZ encoding, from r until EOF.
+func readSlashingsSSZ(r *bufio.Reader) ([]uint64, error) {
+	buf := make([]byte, uint64SizeSSZ)
+	slashings := []uint64{}
+	for {
+		if _, err := r.Peek(1); errors.Is(err, io.EOF) {
+			return slashings, nil
+		} else if err != nil {
+			return nil, err
+		}
+		if len(slashings) == maxRegistrySSZ {
+			return nil, ssz.ErrListTooBigFn(
+				"BeaconState.Slashings", len(slashings)+1, maxRegistrySSZ,
+			)
+		}
+		if err := readFullSSZ(r, buf); err != nil {
+			return nil, err
+		}
+		slashings = append(slashings, ssz.UnmarshallUint64(buf))
+	}
+}
+
+// readFullSSZ reads exactly len(buf) bytes from r into buf, returning
+// ssz.ErrSize if the encoding ends before buf is filled.
+func readFullSSZ(r io.Reader, buf []byte) error {
+	_, err := io.ReadFull(r, buf)
+	if errors.IsAny(err, io.EOF, io.ErrUnexpectedEOF) {
+		return ssz.ErrSize
+	}
+	return err
+}
+
+// decodeRoot decodes a root from its SSZ encoding.
+func decodeRoot(buf []byte) (primitives.Root, error) {
+	var root primitives.Root
+	copy(root[:], buf)
+	return root, nil
+}
+
+// decodeBytes32 decodes a randao mix from its SSZ encoding.
+func decodeBytes32(buf []byte) (primitives.Bytes32, error) {
+	var mix primitives.Bytes32
+	copy(mix[:], buf)
+	return mix, nil
+}
+
+// decodeUint64 decodes a uint64 from its SSZ encoding.
+func decodeUint64(buf []byte) (uint64, error) {
+	return ssz.UnmarshallUint64(buf), nil
+}
+
+// decodeValidator decodes a validator from its SSZ encoding.
+func decodeValidator(buf []byte) (*types.Validator, error) {
+	val := new(types.Validator)
+	return val, val.UnmarshalSSZ(buf)
+}
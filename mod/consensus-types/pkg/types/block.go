@@ -21,10 +21,15 @@
 package types
 
 import (
+	stdmath "math"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	cmttypes "github.com/cometbft/cometbft/types"
 )
 
 // BeaconBlock is the interface for a beacon block.
@@ -102,6 +107,64 @@ func (w *BeaconBlock) IsNil() bool {
 		w.RawBeaconBlock.IsNil()
 }
 
+// ValidateMetadata checks that the metadata of the block, as returned by
+// GetSlot, GetProposerIndex and GetParentBlockRoot, is well-formed. The slot
+// of a block is its height and must fit in an int64, the proposer index must
+// be within the validator registry limit of the chain spec, and only the
+// genesis block may have a zero parent root.
+func (w *BeaconBlock) ValidateMetadata(cs primitives.ChainSpec) error {
+	if w.IsNil() {
+		return ErrNilBlock
+	}
+
+	if slot := w.GetSlot(); slot > stdmath.MaxInt64 {
+		return errors.Wrapf(ErrSlotOutOfRange, "slot %d", slot)
+	}
+
+	limit := cs.ValidatorRegistryLimit()
+	if idx := w.GetProposerIndex(); idx.Unwrap() >= limit {
+		return errors.Wrapf(
+			ErrProposerIndexOutOfRange,
+			"proposer index %d, limit %d", idx, limit,
+		)
+	}
+
+	if w.GetSlot() != 0 && w.GetParentBlockRoot() == (common.Root{}) {
+		return errors.Wrapf(ErrZeroParentRoot, "slot %d", w.GetSlot())
+	}
+	return nil
+}
+
+// MaxSizeSSZ returns the maximum size in bytes of the SSZ encoding of the
+// block, which is bounded by the maximum block size of the CometBFT consensus
+// params active at the slot of the block.
+func (w *BeaconBlock) MaxSizeSSZ(cs primitives.ChainSpec) int {
+	cfg := cs.GetCometBFTConfigForSlot(w.GetSlot())
+	params, ok := cfg.(*cmttypes.ConsensusParams)
+	if !ok || params == nil || params.Block.MaxBytes <= 0 {
+		// A non-positive max bytes defers to the hard limit of CometBFT.
+		return cmttypes.MaxBlockSizeBytes
+	}
+	return int(min(params.Block.MaxBytes, cmttypes.MaxBlockSizeBytes))
+}
+
+// CheckSize returns an error if the SSZ encoding of the block exceeds the
+// maximum size of the block, such that oversized blocks can be rejected
+// before they are broadcast.
+func (w *BeaconBlock) CheckSize(cs primitives.ChainSpec) error {
+	if w.IsNil() {
+		return ErrNilBlock
+	}
+
+	size, maxSize := w.SizeSSZ(), w.MaxSizeSSZ(cs)
+	if size > maxSize {
+		return errors.Wrapf(
+			ErrBlockTooLarge, "size %d, max size %d", size, maxSize,
+		)
+	}
+	return nil
+}
+
 // BeaconBlockDeneb represents a block in the beacon chain during
 // the Deneb fork.
 //
@@ -26,9 +26,12 @@ import (
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	cmttypes "github.com/cometbft/cometbft/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -190,6 +193,150 @@ func TestNewWithVersionInvalidForkVersion(t *testing.T) {
 	require.ErrorIs(t, err, types.ErrForkVersionNotSupported)
 }
 
+func TestBeaconBlockValidateMetadata(t *testing.T) {
+	cs := chain.NewChainSpec(chain.SpecData[
+		common.DomainType, math.Epoch,
+		common.ExecutionAddress, math.Slot, any,
+	]{
+		ValidatorRegistryLimit: 8,
+	})
+
+	tests := []struct {
+		name          string
+		slot          math.Slot
+		proposerIndex math.ValidatorIndex
+		parentRoot    common.Root
+		expectedErr   error
+	}{
+		{
+			name:       "valid non-genesis block",
+			slot:       10,
+			parentRoot: common.Root{1, 2, 3},
+		},
+		{
+			name: "genesis block with zero parent root",
+			slot: 0,
+		},
+		{
+			name:        "non-genesis block with zero parent root",
+			slot:        1,
+			expectedErr: types.ErrZeroParentRoot,
+		},
+		{
+			name:        "slot exceeding the maximum height",
+			slot:        math.Slot(1 << 63),
+			parentRoot:  common.Root{1},
+			expectedErr: types.ErrSlotOutOfRange,
+		},
+		{
+			name:          "proposer index at the registry limit",
+			slot:          10,
+			proposerIndex: 8,
+			parentRoot:    common.Root{1},
+			expectedErr:   types.ErrProposerIndexOutOfRange,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			block, err := (&types.BeaconBlock{}).NewWithVersion(
+				tt.slot, tt.proposerIndex, tt.parentRoot, version.Deneb,
+			)
+			require.NoError(t, err)
+			err = block.ValidateMetadata(cs)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestBeaconBlockValidateMetadataNil(t *testing.T) {
+	var block *types.BeaconBlock
+	require.ErrorIs(t, block.ValidateMetadata(nil), types.ErrNilBlock)
+	block = (&types.BeaconBlock{}).Empty(version.Deneb)
+	require.ErrorIs(t, block.ValidateMetadata(nil), types.ErrNilBlock)
+}
+
+// newMaxBytesChainSpec returns a chain spec whose CometBFT consensus params
+// limit the size of a block to the given max bytes.
+func newMaxBytesChainSpec(maxBytes int64) chain.Spec[
+	common.DomainType, math.Epoch, common.ExecutionAddress, math.Slot, any,
+] {
+	params := cmttypes.DefaultConsensusParams()
+	params.Block.MaxBytes = maxBytes
+	return chain.NewChainSpec(chain.SpecData[
+		common.DomainType, math.Epoch,
+		common.ExecutionAddress, math.Slot, any,
+	]{
+		CometValues: params,
+	})
+}
+
+func TestBeaconBlockMaxSizeSSZ(t *testing.T) {
+	block := &types.BeaconBlock{
+		RawBeaconBlock: generateValidBeaconBlockDeneb(),
+	}
+	require.Equal(t, 1024, block.MaxSizeSSZ(newMaxBytesChainSpec(1024)))
+
+	// A non-positive max bytes defers to the hard limit of CometBFT.
+	require.Equal(
+		t, cmttypes.MaxBlockSizeBytes,
+		block.MaxSizeSSZ(newMaxBytesChainSpec(-1)),
+	)
+	require.Equal(
+		t, cmttypes.MaxBlockSizeBytes,
+		block.MaxSizeSSZ(newMaxBytesChainSpec(cmttypes.MaxBlockSizeBytes+1)),
+	)
+}
+
+func TestBeaconBlockCheckSize(t *testing.T) {
+	block := &types.BeaconBlock{
+		RawBeaconBlock: generateValidBeaconBlockDeneb(),
+	}
+	size := int64(block.SizeSSZ())
+
+	tests := []struct {
+		name        string
+		maxBytes    int64
+		expectedErr error
+	}{
+		{
+			name:     "just under the limit",
+			maxBytes: size + 1,
+		},
+		{
+			name:     "at the limit",
+			maxBytes: size,
+		},
+		{
+			name:        "just over the limit",
+			maxBytes:    size - 1,
+			expectedErr: types.ErrBlockTooLarge,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := block.CheckSize(newMaxBytesChainSpec(tt.maxBytes))
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestBeaconBlockCheckSizeNil(t *testing.T) {
+	var block *types.BeaconBlock
+	require.ErrorIs(
+		t, block.CheckSize(newMaxBytesChainSpec(1024)), types.ErrNilBlock,
+	)
+}
+
 func TestBeaconBlockDeneb_GetTree(t *testing.T) {
 	block := generateValidBeaconBlockDeneb()
 	tree, err := block.GetTree()
@@ -26,6 +26,8 @@
 package types
 
 import (
+	"sync/atomic"
+
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
@@ -48,6 +50,21 @@ const (
 	// in the merkle tree built from the block body.
 	KZGMerkleIndexDeneb = 26
 
+	// MaxBlobCommitmentsPerBlockDeneb is the maximum number of
+	// BlobKzgCommitments in the BeaconBlockBodyDeneb.
+	MaxBlobCommitmentsPerBlockDeneb uint64 = 16
+
+	// DepositsPositionDeneb is the position of Deposits in the block body.
+	DepositsPositionDeneb uint64 = 3
+
+	// ExecutionPayloadPositionDeneb is the position of ExecutionPayload in
+	// the block body.
+	ExecutionPayloadPositionDeneb uint64 = 4
+
+	// DepositsMerkleIndexDeneb is the merkle index of Deposits' root in the
+	// merkle tree built from the block body.
+	DepositsMerkleIndexDeneb = 22
+
 	// Size of LogsBloom in bytes.
 	LogsBloomSize = 256
 
@@ -59,6 +76,11 @@ type BeaconBlockBody struct {
 	RawBeaconBlockBody
 }
 
+// rootCache is implemented by bodies that memoize their hash tree root.
+type rootCache interface {
+	cachedRoot() *atomic.Pointer[common.Root]
+}
+
 // RawBeaconBlockBody is an interface for the different beacon block body.
 func (b *BeaconBlockBody) Empty(forkVersion uint32) *BeaconBlockBody {
 	switch forkVersion {
@@ -75,6 +97,43 @@ func (b *BeaconBlockBody) Empty(forkVersion uint32) *BeaconBlockBody {
 	}
 }
 
+// GetBlobKzgCommitments returns the KZG commitments of the blobs of the body,
+// or an empty list if the body is nil or has no blobs.
+func (
+	b *BeaconBlockBody,
+) GetBlobKzgCommitments() eip4844.KZGCommitments[common.ExecutionHash] {
+	if b == nil || b.RawBeaconBlockBody == nil || b.RawBeaconBlockBody.IsNil() {
+		return eip4844.KZGCommitments[common.ExecutionHash]{}
+	}
+	commitments := b.RawBeaconBlockBody.GetBlobKzgCommitments()
+	if commitments == nil {
+		return eip4844.KZGCommitments[common.ExecutionHash]{}
+	}
+	return commitments
+}
+
+// HashTreeRoot returns the hash tree root of the body. The root is cached on
+// the underlying body, so that it is only recomputed after the body has been
+// mutated through one of its setters.
+func (b *BeaconBlockBody) HashTreeRoot() ([32]byte, error) {
+	cache, ok := b.RawBeaconBlockBody.(rootCache)
+	if !ok {
+		return b.RawBeaconBlockBody.HashTreeRoot()
+	}
+
+	// Use root if found.
+	if root := cache.cachedRoot().Load(); root != nil {
+		return *root, nil
+	}
+
+	root, err := b.RawBeaconBlockBody.HashTreeRoot()
+	if err != nil {
+		return [32]byte{}, err
+	}
+	cache.cachedRoot().Store((*common.Root)(&root))
+	return root, nil
+}
+
 // BlockBodyKZGOffset returns the offset of the KZG commitments in the block
 // body.
 // TODO: I still feel like we need to clean this up somehow.
@@ -101,6 +160,16 @@ type BeaconBlockBodyBase struct {
 	Graffiti [32]byte `ssz-size:"32"`
 	// Deposits is the list of deposits included in the body.
 	Deposits []*Deposit `              ssz-max:"16"`
+
+	// root is the hash tree root of the body. It is a cache to avoid
+	// recomputing it every time, and is cleared by the setters of the body.
+	// Writing to the fields of the body directly does not clear it.
+	root atomic.Pointer[common.Root]
+}
+
+// cachedRoot returns the cache of the hash tree root of the body.
+func (b *BeaconBlockBodyBase) cachedRoot() *atomic.Pointer[common.Root] {
+	return &b.root
 }
 
 // GetRandaoReveal returns the RandaoReveal of the Body.
@@ -111,6 +180,7 @@ func (b *BeaconBlockBodyBase) GetRandaoReveal() crypto.BLSSignature {
 // SetRandaoReveal sets the RandaoReveal of the Body.
 func (b *BeaconBlockBodyBase) SetRandaoReveal(reveal crypto.BLSSignature) {
 	b.RandaoReveal = reveal
+	b.root.Store(nil)
 }
 
 // GetEth1Data returns the Eth1Data of the Body.
@@ -122,6 +192,7 @@ func (b *BeaconBlockBodyBase) GetEth1Data() *Eth1Data {
 // BeaconBlockBodyDeneb.
 func (b *BeaconBlockBodyDeneb) SetEth1Data(eth1Data *Eth1Data) {
 	b.Eth1Data = eth1Data
+	b.root.Store(nil)
 }
 
 // GetGraffiti returns the Graffiti of the Body.
@@ -137,6 +208,7 @@ func (b *BeaconBlockBodyBase) GetDeposits() []*Deposit {
 // SetDeposits sets the Deposits of the BeaconBlockBodyBase.
 func (b *BeaconBlockBodyBase) SetDeposits(deposits []*Deposit) {
 	b.Deposits = deposits
+	b.root.Store(nil)
 }
 
 // BeaconBlockBodyDeneb represents the body of a beacon block in the Deneb
@@ -168,6 +240,7 @@ func (b *BeaconBlockBodyDeneb) SetExecutionData(
 	executionData *ExecutionPayload,
 ) error {
 	var ok bool
+	b.root.Store(nil)
 	b.ExecutionPayload, ok = executionData.
 		InnerExecutionPayload.(*ExecutableDataDeneb)
 	if !ok {
@@ -189,6 +262,7 @@ func (b *BeaconBlockBodyDeneb) SetBlobKzgCommitments(
 	commitments eip4844.KZGCommitments[common.ExecutionHash],
 ) {
 	b.BlobKzgCommitments = commitments
+	b.root.Store(nil)
 }
 
 // GetTopLevelRoots returns the top-level roots of the BeaconBlockBodyDeneb.
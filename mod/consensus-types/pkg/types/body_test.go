@@ -21,6 +21,7 @@
 package types_test
 
 import (
+	"sync"
 	"testing"
 
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
@@ -104,6 +105,58 @@ func TestBeaconBlockBodyDeneb_SetBlobKzgCommitments(t *testing.T) {
 	require.Equal(t, commitments, body.GetBlobKzgCommitments())
 }
 
+func TestBeaconBlockBody_GetBlobKzgCommitments(t *testing.T) {
+	// The maximum number of commitments is the ssz-max of the
+	// BlobKzgCommitments of the BeaconBlockBodyDeneb.
+	maxCommitments := make([]eip4844.KZGCommitment, 16)
+	for i := range maxCommitments {
+		maxCommitments[i] = eip4844.KZGCommitment{byte(i)}
+	}
+
+	tests := []struct {
+		name        string
+		commitments []eip4844.KZGCommitment
+	}{
+		{name: "zero commitments"},
+		{
+			name:        "one commitment",
+			commitments: []eip4844.KZGCommitment{{0x01}},
+		},
+		{
+			name:        "max commitments",
+			commitments: maxCommitments,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body := &types.BeaconBlockBody{
+				RawBeaconBlockBody: &types.BeaconBlockBodyDeneb{
+					BlobKzgCommitments: tt.commitments,
+				},
+			}
+			commitments := body.GetBlobKzgCommitments()
+			require.NotNil(t, commitments)
+			require.Len(t, commitments, len(tt.commitments))
+			for i, c := range commitments {
+				require.Equal(t, tt.commitments[i], c)
+			}
+		})
+	}
+}
+
+func TestBeaconBlockBody_GetBlobKzgCommitmentsNil(t *testing.T) {
+	var body *types.BeaconBlockBody
+	require.NotNil(t, body.GetBlobKzgCommitments())
+	require.Empty(t, body.GetBlobKzgCommitments())
+
+	body = &types.BeaconBlockBody{
+		RawBeaconBlockBody: (*types.BeaconBlockBodyDeneb)(nil),
+	}
+	require.NotNil(t, body.GetBlobKzgCommitments())
+	require.Empty(t, body.GetBlobKzgCommitments())
+}
+
 func TestBeaconBlockBodyDeneb_SetRandaoReveal(t *testing.T) {
 	body := types.BeaconBlockBodyDeneb{}
 	randaoReveal := crypto.BLSSignature{1, 2, 3}
@@ -163,3 +216,91 @@ func TestBeaconBlockBody_Empty(t *testing.T) {
 	_, ok := body.RawBeaconBlockBody.(*types.BeaconBlockBodyDeneb)
 	require.True(t, ok)
 }
+
+func TestBeaconBlockBody_HashTreeRootCache(t *testing.T) {
+	mutations := map[string]func(*types.BeaconBlockBodyDeneb){
+		"randao reveal": func(b *types.BeaconBlockBodyDeneb) {
+			b.SetRandaoReveal(crypto.BLSSignature{7})
+		},
+		"eth1 data": func(b *types.BeaconBlockBodyDeneb) {
+			b.SetEth1Data(&types.Eth1Data{DepositCount: 1})
+		},
+		"deposits": func(b *types.BeaconBlockBodyDeneb) {
+			b.SetDeposits([]*types.Deposit{{Index: 1}})
+		},
+		"execution data": func(b *types.BeaconBlockBodyDeneb) {
+			require.NoError(t, b.SetExecutionData(&types.ExecutionPayload{
+				InnerExecutionPayload: &types.ExecutableDataDeneb{
+					LogsBloom: make([]byte, types.LogsBloomSize),
+					Number:    1,
+				},
+			}))
+		},
+		"blob kzg commitments": func(b *types.BeaconBlockBodyDeneb) {
+			b.SetBlobKzgCommitments(
+				eip4844.KZGCommitments[common.ExecutionHash]{{1}},
+			)
+		},
+	}
+
+	for name, mutate := range mutations {
+		t.Run(name, func(t *testing.T) {
+			raw := generateBeaconBlockBodyDeneb()
+			fresh, err := raw.HashTreeRoot()
+			require.NoError(t, err)
+
+			// The root is cached on the underlying body, so it is shared by
+			// every wrapper of the body.
+			root, err := (&types.BeaconBlockBody{
+				RawBeaconBlockBody: &raw,
+			}).HashTreeRoot()
+			require.NoError(t, err)
+			require.Equal(t, fresh, root)
+
+			// Writing to a field directly bypasses the cache.
+			raw.Graffiti = [32]byte{8}
+			cached, err := (&types.BeaconBlockBody{
+				RawBeaconBlockBody: &raw,
+			}).HashTreeRoot()
+			require.NoError(t, err)
+			require.Equal(t, root, cached)
+
+			// Mutating the body through a setter invalidates the cache.
+			mutate(&raw)
+			fresh, err = raw.HashTreeRoot()
+			require.NoError(t, err)
+			root, err = (&types.BeaconBlockBody{
+				RawBeaconBlockBody: &raw,
+			}).HashTreeRoot()
+			require.NoError(t, err)
+			require.Equal(t, fresh, root)
+			require.NotEqual(t, cached, root)
+		})
+	}
+}
+
+func TestBeaconBlockBody_HashTreeRootConcurrent(t *testing.T) {
+	raw := generateBeaconBlockBodyDeneb()
+	body := &types.BeaconBlockBody{RawBeaconBlockBody: &raw}
+	expected, err := raw.HashTreeRoot()
+	require.NoError(t, err)
+
+	var (
+		wg    sync.WaitGroup
+		roots = make([][32]byte, 8)
+		errs  = make([]error, len(roots))
+	)
+	for i := range roots {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			roots[i], errs[i] = body.HashTreeRoot()
+		}()
+	}
+	wg.Wait()
+
+	for i, root := range roots {
+		require.NoError(t, errs[i])
+		require.Equal(t, expected, root)
+	}
+}
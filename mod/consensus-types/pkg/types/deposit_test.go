@@ -21,6 +21,8 @@
 package types_test
 
 import (
+	"crypto/sha256"
+	"encoding/binary"
 	"testing"
 
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
@@ -111,6 +113,54 @@ func TestDeposit_GetTree(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestDeposit_DepositDataRoot(t *testing.T) {
+	deposit := generateValidDeposit()
+	for i := range deposit.Pubkey {
+		deposit.Pubkey[i] = byte(i)
+	}
+	for i := range deposit.Credentials {
+		deposit.Credentials[i] = byte(i + 1)
+	}
+	for i := range deposit.Signature {
+		deposit.Signature[i] = byte(i + 2)
+	}
+	deposit.Amount = math.Gwei(32e9)
+
+	root, err := deposit.DepositDataRoot()
+	require.NoError(t, err)
+	require.Equal(t, depositContractLeaf(deposit), [32]byte(root))
+
+	// The index of the deposit is not part of the deposit data.
+	deposit.Index++
+	indexedRoot, err := deposit.DepositDataRoot()
+	require.NoError(t, err)
+	require.Equal(t, root, indexedRoot)
+}
+
+// depositContractLeaf computes the leaf of the deposit the way the deposit
+// contract does.
+func depositContractLeaf(d *types.Deposit) [32]byte {
+	hash := func(chunks ...[]byte) []byte {
+		h := sha256.New()
+		for _, c := range chunks {
+			h.Write(c)
+		}
+		return h.Sum(nil)
+	}
+	amount := make([]byte, 32)
+	binary.LittleEndian.PutUint64(amount, uint64(d.Amount))
+
+	pubkeyRoot := hash(d.Pubkey[:], make([]byte, 16))
+	signatureRoot := hash(
+		hash(d.Signature[:64]),
+		hash(d.Signature[64:], make([]byte, 32)),
+	)
+	return [32]byte(hash(
+		hash(pubkeyRoot, d.Credentials[:]),
+		hash(amount, signatureRoot),
+	))
+}
+
 func TestDeposit_UnmarshalSSZ_ErrSize(t *testing.T) {
 	// Create a byte slice of incorrect size
 	buf := make([]byte, 10) // size less than 192
@@ -38,4 +38,34 @@ var (
 
 	// ErrNilPayloadHeader is an error for when the payload header is nil.
 	ErrNilPayloadHeader = errors.New("nil payload header")
+
+	// ErrNilBlockBody is an error for when the block body is nil.
+	ErrNilBlockBody = errors.New("nil block body")
+
+	// ErrDepositIndexOutOfRange is an error for when a deposit index is not
+	// within the deposits of a block body.
+	ErrDepositIndexOutOfRange = errors.New("deposit index out of range")
+
+	// ErrNilBlock is an error for when the block is nil.
+	ErrNilBlock = errors.New("nil block")
+
+	// ErrSlotOutOfRange is an error for when the slot of a block cannot be
+	// the height of a block.
+	ErrSlotOutOfRange = errors.New("block slot out of range")
+
+	// ErrProposerIndexOutOfRange is an error for when the proposer index of a
+	// block exceeds the validator registry limit.
+	ErrProposerIndexOutOfRange = errors.New("proposer index out of range")
+
+	// ErrZeroParentRoot is an error for when a block other than the genesis
+	// block has a zero parent root.
+	ErrZeroParentRoot = errors.New("zero parent root on non-genesis block")
+
+	// ErrInvalidBlockSignature is an error for when the signature of a block
+	// was not produced by its proposer.
+	ErrInvalidBlockSignature = errors.New("invalid block signature")
+
+	// ErrBlockTooLarge is an error for when the SSZ encoding of a block
+	// exceeds the maximum block size.
+	ErrBlockTooLarge = errors.New("block exceeds max block size")
 )
@@ -0,0 +1,350 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"encoding/json"
+	"math/big"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// The types below follow the JSON representation used by the Ethereum beacon
+// API, where field names are snake_case, uint64s are encoded as decimal
+// strings and byte arrays are encoded as 0x-prefixed hex strings.
+
+// beaconBlockJSON is the beacon API JSON representation of a BeaconBlock.
+type beaconBlockJSON struct {
+	Slot          uint64               `json:"slot,string"`
+	ProposerIndex uint64               `json:"proposer_index,string"`
+	ParentRoot    common.Root          `json:"parent_root"`
+	StateRoot     common.Root          `json:"state_root"`
+	Body          *beaconBlockBodyJSON `json:"body"`
+}
+
+// beaconBlockBodyJSON is the beacon API JSON representation of a
+// BeaconBlockBody.
+type beaconBlockBodyJSON struct {
+	RandaoReveal       crypto.BLSSignature     `json:"randao_reveal"`
+	Eth1Data           *eth1DataJSON           `json:"eth1_data"`
+	Graffiti           bytes.B32               `json:"graffiti"`
+	Deposits           []*depositJSON          `json:"deposits"`
+	ExecutionPayload   *executionPayloadJSON   `json:"execution_payload"`
+	BlobKzgCommitments []eip4844.KZGCommitment `json:"blob_kzg_commitments"`
+}
+
+// eth1DataJSON is the beacon API JSON representation of an Eth1Data.
+type eth1DataJSON struct {
+	DepositRoot  common.Root          `json:"deposit_root"`
+	DepositCount uint64               `json:"deposit_count,string"`
+	BlockHash    common.ExecutionHash `json:"block_hash"`
+}
+
+// depositJSON is the JSON representation of a Deposit. Deposits in this
+// chain carry their index instead of a merkle proof, so the deposit data
+// fields are inlined alongside the index.
+type depositJSON struct {
+	Pubkey      crypto.BLSPubkey    `json:"pubkey"`
+	Credentials bytes.B32           `json:"withdrawal_credentials"`
+	Amount      uint64              `json:"amount,string"`
+	Signature   crypto.BLSSignature `json:"signature"`
+	Index       uint64              `json:"index,string"`
+}
+
+// executionPayloadJSON is the beacon API JSON representation of an
+// ExecutableDataDeneb.
+type executionPayloadJSON struct {
+	ParentHash    common.ExecutionHash    `json:"parent_hash"`
+	FeeRecipient  common.ExecutionAddress `json:"fee_recipient"`
+	StateRoot     bytes.B32               `json:"state_root"`
+	ReceiptsRoot  bytes.B32               `json:"receipts_root"`
+	LogsBloom     bytes.Bytes             `json:"logs_bloom"`
+	PrevRandao    bytes.B32               `json:"prev_randao"`
+	BlockNumber   uint64                  `json:"block_number,string"`
+	GasLimit      uint64                  `json:"gas_limit,string"`
+	GasUsed       uint64                  `json:"gas_used,string"`
+	Timestamp     uint64                  `json:"timestamp,string"`
+	ExtraData     bytes.Bytes             `json:"extra_data"`
+	BaseFeePerGas string                  `json:"base_fee_per_gas"`
+	BlockHash     common.ExecutionHash    `json:"block_hash"`
+	Transactions  []bytes.Bytes           `json:"transactions"`
+	Withdrawals   []*withdrawalJSON       `json:"withdrawals"`
+	BlobGasUsed   uint64                  `json:"blob_gas_used,string"`
+	ExcessBlobGas uint64                  `json:"excess_blob_gas,string"`
+}
+
+// withdrawalJSON is the beacon API JSON representation of a Withdrawal.
+type withdrawalJSON struct {
+	Index          uint64                  `json:"index,string"`
+	ValidatorIndex uint64                  `json:"validator_index,string"`
+	Address        common.ExecutionAddress `json:"address"`
+	Amount         uint64                  `json:"amount,string"`
+}
+
+// MarshalJSON marshals the BeaconBlock into its beacon API JSON
+// representation.
+func (w *BeaconBlock) MarshalJSON() ([]byte, error) {
+	if w.IsNil() {
+		return []byte("null"), nil
+	}
+	block, ok := w.RawBeaconBlock.(*BeaconBlockDeneb)
+	if !ok {
+		return nil, ErrForkVersionNotSupported
+	}
+	return json.Marshal(&beaconBlockJSON{
+		Slot:          block.Slot,
+		ProposerIndex: block.ProposerIndex,
+		ParentRoot:    block.ParentBlockRoot,
+		StateRoot:     block.StateRoot,
+		Body:          newBeaconBlockBodyJSON(block.Body),
+	})
+}
+
+// UnmarshalJSON unmarshals the BeaconBlock from its beacon API JSON
+// representation. Since Deneb is the only supported fork, the block is
+// always decoded as a BeaconBlockDeneb.
+func (w *BeaconBlock) UnmarshalJSON(input []byte) error {
+	var dec beaconBlockJSON
+	if err := json.Unmarshal(input, &dec); err != nil {
+		return err
+	}
+	block := &BeaconBlockDeneb{
+		BeaconBlockHeaderBase: BeaconBlockHeaderBase{
+			Slot:            dec.Slot,
+			ProposerIndex:   dec.ProposerIndex,
+			ParentBlockRoot: dec.ParentRoot,
+			StateRoot:       dec.StateRoot,
+		},
+	}
+	if dec.Body != nil {
+		var err error
+		if block.Body, err = dec.Body.toBeaconBlockBodyDeneb(); err != nil {
+			return err
+		}
+	}
+	w.RawBeaconBlock = block
+	return nil
+}
+
+// MarshalJSON marshals the BeaconBlockBody into its beacon API JSON
+// representation.
+func (b *BeaconBlockBody) MarshalJSON() ([]byte, error) {
+	if b == nil || b.RawBeaconBlockBody == nil ||
+		b.RawBeaconBlockBody.IsNil() {
+		return []byte("null"), nil
+	}
+	body, ok := b.RawBeaconBlockBody.(*BeaconBlockBodyDeneb)
+	if !ok {
+		return nil, ErrForkVersionNotSupported
+	}
+	return json.Marshal(newBeaconBlockBodyJSON(body))
+}
+
+// UnmarshalJSON unmarshals the BeaconBlockBody from its beacon API JSON
+// representation. Since Deneb is the only supported fork, the body is
+// always decoded as a BeaconBlockBodyDeneb.
+func (b *BeaconBlockBody) UnmarshalJSON(input []byte) error {
+	var dec *beaconBlockBodyJSON
+	if err := json.Unmarshal(input, &dec); err != nil {
+		return err
+	} else if dec == nil {
+		b.RawBeaconBlockBody = nil
+		return nil
+	}
+	body, err := dec.toBeaconBlockBodyDeneb()
+	if err != nil {
+		return err
+	}
+	b.RawBeaconBlockBody = body
+	return nil
+}
+
+// newBeaconBlockBodyJSON converts a BeaconBlockBodyDeneb into its JSON
+// representation.
+func newBeaconBlockBodyJSON(body *BeaconBlockBodyDeneb) *beaconBlockBodyJSON {
+	if body == nil {
+		return nil
+	}
+
+	enc := &beaconBlockBodyJSON{
+		RandaoReveal: body.RandaoReveal,
+		Graffiti:     body.Graffiti,
+		Deposits:     make([]*depositJSON, 0, len(body.Deposits)),
+		BlobKzgCommitments: append(
+			make([]eip4844.KZGCommitment, 0, len(body.BlobKzgCommitments)),
+			body.BlobKzgCommitments...,
+		),
+	}
+	if body.Eth1Data != nil {
+		enc.Eth1Data = &eth1DataJSON{
+			DepositRoot:  body.Eth1Data.DepositRoot,
+			DepositCount: body.Eth1Data.DepositCount,
+			BlockHash:    body.Eth1Data.BlockHash,
+		}
+	}
+	for _, deposit := range body.Deposits {
+		enc.Deposits = append(enc.Deposits, &depositJSON{
+			Pubkey:      deposit.Pubkey,
+			Credentials: bytes.B32(deposit.Credentials),
+			Amount:      deposit.Amount.Unwrap(),
+			Signature:   deposit.Signature,
+			Index:       deposit.Index,
+		})
+	}
+	if payload := body.ExecutionPayload; payload != nil {
+		enc.ExecutionPayload = &executionPayloadJSON{
+			ParentHash:    payload.ParentHash,
+			FeeRecipient:  payload.FeeRecipient,
+			StateRoot:     payload.StateRoot,
+			ReceiptsRoot:  payload.ReceiptsRoot,
+			LogsBloom:     payload.LogsBloom,
+			PrevRandao:    payload.Random,
+			BlockNumber:   payload.Number.Unwrap(),
+			GasLimit:      payload.GasLimit.Unwrap(),
+			GasUsed:       payload.GasUsed.Unwrap(),
+			Timestamp:     payload.Timestamp.Unwrap(),
+			ExtraData:     payload.ExtraData,
+			BaseFeePerGas: payload.BaseFeePerGas.UnwrapBig().String(),
+			BlockHash:     payload.BlockHash,
+			Transactions: make(
+				[]bytes.Bytes, 0, len(payload.Transactions),
+			),
+			Withdrawals: make(
+				[]*withdrawalJSON, 0, len(payload.Withdrawals),
+			),
+			BlobGasUsed:   payload.BlobGasUsed.Unwrap(),
+			ExcessBlobGas: payload.ExcessBlobGas.Unwrap(),
+		}
+		for _, tx := range payload.Transactions {
+			enc.ExecutionPayload.Transactions = append(
+				enc.ExecutionPayload.Transactions, tx,
+			)
+		}
+		for _, withdrawal := range payload.Withdrawals {
+			enc.ExecutionPayload.Withdrawals = append(
+				enc.ExecutionPayload.Withdrawals, &withdrawalJSON{
+					Index:          withdrawal.Index.Unwrap(),
+					ValidatorIndex: withdrawal.Validator.Unwrap(),
+					Address:        withdrawal.Address,
+					Amount:         withdrawal.Amount.Unwrap(),
+				},
+			)
+		}
+	}
+	return enc
+}
+
+// toBeaconBlockBodyDeneb converts the JSON representation of a block body
+// into a BeaconBlockBodyDeneb.
+//
+//nolint:mnd // the base fee is encoded in base 10.
+func (dec *beaconBlockBodyJSON) toBeaconBlockBodyDeneb() (
+	*BeaconBlockBodyDeneb, error,
+) {
+	body := &BeaconBlockBodyDeneb{
+		BeaconBlockBodyBase: BeaconBlockBodyBase{
+			RandaoReveal: dec.RandaoReveal,
+			Graffiti:     dec.Graffiti,
+			Deposits:     make([]*Deposit, 0, len(dec.Deposits)),
+		},
+		BlobKzgCommitments: dec.BlobKzgCommitments,
+	}
+	if dec.Eth1Data != nil {
+		body.Eth1Data = &Eth1Data{
+			DepositRoot:  dec.Eth1Data.DepositRoot,
+			DepositCount: dec.Eth1Data.DepositCount,
+			BlockHash:    dec.Eth1Data.BlockHash,
+		}
+	}
+	for _, deposit := range dec.Deposits {
+		if deposit == nil {
+			return nil, errors.New("nil deposit in block body")
+		}
+		body.Deposits = append(body.Deposits, &Deposit{
+			Pubkey:      deposit.Pubkey,
+			Credentials: WithdrawalCredentials(deposit.Credentials),
+			Amount:      math.Gwei(deposit.Amount),
+			Signature:   deposit.Signature,
+			Index:       deposit.Index,
+		})
+	}
+
+	payload := dec.ExecutionPayload
+	if payload == nil {
+		return body, nil
+	}
+	baseFee, ok := new(big.Int).SetString(payload.BaseFeePerGas, 10)
+	if !ok {
+		return nil, errors.Newf(
+			"invalid base fee per gas: %q", payload.BaseFeePerGas,
+		)
+	}
+	baseFeePerGas, err := math.NewU256LFromBigInt(baseFee)
+	if err != nil {
+		return nil, err
+	}
+	body.ExecutionPayload = &ExecutableDataDeneb{
+		ParentHash:    payload.ParentHash,
+		FeeRecipient:  payload.FeeRecipient,
+		StateRoot:     payload.StateRoot,
+		ReceiptsRoot:  payload.ReceiptsRoot,
+		LogsBloom:     payload.LogsBloom,
+		Random:        payload.PrevRandao,
+		Number:        math.U64(payload.BlockNumber),
+		GasLimit:      math.U64(payload.GasLimit),
+		GasUsed:       math.U64(payload.GasUsed),
+		Timestamp:     math.U64(payload.Timestamp),
+		ExtraData:     payload.ExtraData,
+		BaseFeePerGas: baseFeePerGas,
+		BlockHash:     payload.BlockHash,
+		Transactions:  make([][]byte, 0, len(payload.Transactions)),
+		Withdrawals: make(
+			[]*engineprimitives.Withdrawal, 0, len(payload.Withdrawals),
+		),
+		BlobGasUsed:   math.U64(payload.BlobGasUsed),
+		ExcessBlobGas: math.U64(payload.ExcessBlobGas),
+	}
+	for _, tx := range payload.Transactions {
+		body.ExecutionPayload.Transactions = append(
+			body.ExecutionPayload.Transactions, tx,
+		)
+	}
+	for _, withdrawal := range payload.Withdrawals {
+		if withdrawal == nil {
+			return nil, errors.New("nil withdrawal in execution payload")
+		}
+		body.ExecutionPayload.Withdrawals = append(
+			body.ExecutionPayload.Withdrawals, &engineprimitives.Withdrawal{
+				Index:     math.U64(withdrawal.Index),
+				Validator: math.ValidatorIndex(withdrawal.ValidatorIndex),
+				Address:   withdrawal.Address,
+				Amount:    math.Gwei(withdrawal.Amount),
+			},
+		)
+	}
+	return body, nil
+}
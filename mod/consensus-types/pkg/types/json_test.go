@@ -0,0 +1,150 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+// generateSampleBeaconBlock generates a beacon block with every field set.
+func generateSampleBeaconBlock() *types.BeaconBlock {
+	logsBloom := make([]byte, types.LogsBloomSize)
+	logsBloom[0] = 0x0e
+	return &types.BeaconBlock{
+		RawBeaconBlock: &types.BeaconBlockDeneb{
+			BeaconBlockHeaderBase: types.BeaconBlockHeaderBase{
+				Slot:            12345,
+				ProposerIndex:   7,
+				ParentBlockRoot: bytes.B32{0x01},
+				StateRoot:       bytes.B32{0x02},
+			},
+			Body: &types.BeaconBlockBodyDeneb{
+				BeaconBlockBodyBase: types.BeaconBlockBodyBase{
+					RandaoReveal: bytes.B96{0x03},
+					Eth1Data: &types.Eth1Data{
+						DepositRoot:  bytes.B32{0x04},
+						DepositCount: 2,
+						BlockHash:    common.ExecutionHash{0x05},
+					},
+					Graffiti: [32]byte{0x06},
+					Deposits: []*types.Deposit{{
+						Pubkey:      bytes.B48{0x07},
+						Credentials: types.WithdrawalCredentials{0x08},
+						Amount:      32e9,
+						Signature:   bytes.B96{0x09},
+						Index:       1,
+					}},
+				},
+				ExecutionPayload: &types.ExecutableDataDeneb{
+					ParentHash:   common.ExecutionHash{0x0a},
+					FeeRecipient: common.ExecutionAddress{0x0b},
+					StateRoot:    bytes.B32{0x0c},
+					ReceiptsRoot: bytes.B32{0x0d},
+					LogsBloom:    logsBloom,
+					Random:       bytes.B32{0x0f},
+					Number:       100,
+					GasLimit:     30_000_000,
+					GasUsed:      21_000,
+					Timestamp:    1_718_000_000,
+					ExtraData:    []byte("beacon-kit"),
+					BaseFeePerGas: math.MustNewU256LFromBigInt(
+						big.NewInt(7_000_000_000),
+					),
+					BlockHash:    common.ExecutionHash{0x10},
+					Transactions: [][]byte{{0x02, 0xf8, 0x01}},
+					Withdrawals: []*engineprimitives.Withdrawal{{
+						Index:     3,
+						Validator: 4,
+						Address:   common.ExecutionAddress{0x11},
+						Amount:    1000,
+					}},
+					BlobGasUsed:   131072,
+					ExcessBlobGas: 0,
+				},
+				BlobKzgCommitments: []eip4844.KZGCommitment{{0x12}},
+			},
+		},
+	}
+}
+
+func TestBeaconBlockMarshalJSON(t *testing.T) {
+	golden, err := os.ReadFile("testdata/beacon_block_deneb.json")
+	require.NoError(t, err)
+
+	bz, err := json.Marshal(generateSampleBeaconBlock())
+	require.NoError(t, err)
+	require.JSONEq(t, string(golden), string(bz))
+}
+
+func TestBeaconBlockUnmarshalJSON(t *testing.T) {
+	golden, err := os.ReadFile("testdata/beacon_block_deneb.json")
+	require.NoError(t, err)
+
+	block := new(types.BeaconBlock)
+	require.NoError(t, json.Unmarshal(golden, block))
+
+	// The decoded block must be byte-identical when re-encoded as SSZ.
+	expected, err := generateSampleBeaconBlock().MarshalSSZ()
+	require.NoError(t, err)
+	actual, err := block.MarshalSSZ()
+	require.NoError(t, err)
+	require.Equal(t, expected, actual)
+
+	bz, err := json.Marshal(block)
+	require.NoError(t, err)
+	require.JSONEq(t, string(golden), string(bz))
+}
+
+func TestBeaconBlockUnmarshalJSONInvalidBaseFee(t *testing.T) {
+	block := new(types.BeaconBlock)
+	err := json.Unmarshal([]byte(`{
+		"slot": "1",
+		"proposer_index": "1",
+		"body": {"execution_payload": {"base_fee_per_gas": "0x1"}}
+	}`), block)
+	require.ErrorContains(t, err, "invalid base fee per gas")
+}
+
+func TestBeaconBlockBodyJSONRoundTrip(t *testing.T) {
+	body := generateSampleBeaconBlock().GetBody()
+	bz, err := json.Marshal(body)
+	require.NoError(t, err)
+
+	decoded := new(types.BeaconBlockBody)
+	require.NoError(t, json.Unmarshal(bz, decoded))
+
+	expected, err := body.MarshalSSZ()
+	require.NoError(t, err)
+	actual, err := decoded.MarshalSSZ()
+	require.NoError(t, err)
+	require.Equal(t, expected, actual)
+}
@@ -0,0 +1,141 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/ssz"
+)
+
+// ProveDeposit builds the merkle proof of the deposit at the given index
+// in the block body's deposits. The proof goes from the deposit's hash tree
+// root up to the body root, at the generalized index
+// DepositsMerkleIndexDeneb*MaxDepositsPerBlock + index.
+func ProveDeposit(body *BeaconBlockBody, index uint64) ([][32]byte, error) {
+	if body == nil || body.RawBeaconBlockBody == nil || body.IsNil() {
+		return nil, ErrNilBlockBody
+	}
+	denebBody, ok := body.RawBeaconBlockBody.(*BeaconBlockBodyDeneb)
+	if !ok {
+		return nil, ErrForkVersionNotSupported
+	}
+
+	// Build the merkle proof to the deposit within the list of deposits.
+	depositsProof, err := buildDepositsProof(denebBody.GetDeposits(), index)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the merkle proof for the body root.
+	bodyProof, err := buildDepositsBodyProof(denebBody)
+	if err != nil {
+		return nil, err
+	}
+
+	// By property of the merkle tree, we can concatenate the
+	// two proofs to get the final proof.
+	return append(depositsProof, bodyProof...), nil
+}
+
+// buildDepositsProof builds the proof of the deposit at the given index
+// up to the root of the list of deposits.
+func buildDepositsProof(
+	deposits []*Deposit,
+	index uint64,
+) ([][32]byte, error) {
+	if index >= uint64(len(deposits)) {
+		return nil, ErrDepositIndexOutOfRange
+	}
+
+	var err error
+	leaves := make([][32]byte, len(deposits))
+	for i, deposit := range deposits {
+		if leaves[i], err = deposit.HashTreeRoot(); err != nil {
+			return nil, err
+		}
+	}
+
+	tree, err := merkle.NewTreeWithMaxLeaves[[32]byte, [32]byte](
+		leaves, constants.MaxDepositsPerBlock,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return tree.MerkleProofWithMixin(index)
+}
+
+// buildDepositsBodyProof builds the proof of the deposits root up to the
+// body root.
+func buildDepositsBodyProof(body *BeaconBlockBodyDeneb) ([][32]byte, error) {
+	membersRoots, err := body.GetTopLevelRoots()
+	if err != nil {
+		return nil, err
+	}
+
+	// The execution payload and KZG commitments roots are siblings on the
+	// path from the deposits root, so they must match the roots used by the
+	// body's hash tree root. GetTopLevelRoots leaves out the KZG commitments
+	// root entirely.
+	membersRoots[ExecutionPayloadPositionDeneb], err = body.
+		ExecutionPayload.HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+	membersRoots[KZGPositionDeneb], err = blobKzgCommitmentsRoot(
+		body.GetBlobKzgCommitments(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	tree, err := merkle.NewTreeWithMaxLeaves[[32]byte, [32]byte](
+		membersRoots, BodyLengthDeneb,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return tree.MerkleProof(DepositsPositionDeneb)
+}
+
+// blobKzgCommitmentsRoot returns the hash tree root of the list of KZG
+// commitments.
+func blobKzgCommitmentsRoot(
+	commitments eip4844.KZGCommitments[common.ExecutionHash],
+) ([32]byte, error) {
+	var err error
+	htrs := make([][32]byte, len(commitments))
+	for i, commitment := range commitments {
+		if htrs[i], err = commitment.HashTreeRoot(); err != nil {
+			return [32]byte{}, err
+		}
+	}
+	root, err := ssz.Merkleize[math.U64, [32]byte](
+		htrs, MaxBlobCommitmentsPerBlockDeneb,
+	)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return merkle.MixinLength(root, uint64(len(commitments))), nil
+}
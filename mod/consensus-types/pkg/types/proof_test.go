@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProveDeposit(t *testing.T) {
+	body := generateSampleBeaconBlock().GetBody()
+	denebBody, ok := body.RawBeaconBlockBody.(*types.BeaconBlockBodyDeneb)
+	require.True(t, ok)
+	for i := range uint64(4) {
+		denebBody.Deposits = append(denebBody.Deposits, &types.Deposit{
+			Pubkey: bytes.B48{byte(i)},
+			Amount: 32e9,
+			Index:  i + 2,
+		})
+	}
+	denebBody.BlobKzgCommitments = append(
+		denebBody.BlobKzgCommitments, eip4844.KZGCommitment{0x13},
+	)
+
+	bodyRoot, err := body.HashTreeRoot()
+	require.NoError(t, err)
+
+	for i, deposit := range denebBody.Deposits {
+		proof, proofErr := types.ProveDeposit(body, uint64(i))
+		require.NoError(t, proofErr)
+
+		leaf, leafErr := deposit.HashTreeRoot()
+		require.NoError(t, leafErr)
+		gIndex := types.DepositsMerkleIndexDeneb*
+			constants.MaxDepositsPerBlock + uint64(i)
+		require.True(t, verifyMerkleBranch(leaf, proof, gIndex, bodyRoot))
+
+		// A proof must not verify for any other deposit.
+		leaf[0] ^= 0xff
+		require.False(t, verifyMerkleBranch(leaf, proof, gIndex, bodyRoot))
+	}
+}
+
+func TestProveDepositErrors(t *testing.T) {
+	body := generateSampleBeaconBlock().GetBody()
+	_, err := types.ProveDeposit(body, uint64(len(body.GetDeposits())))
+	require.ErrorIs(t, err, types.ErrDepositIndexOutOfRange)
+
+	_, err = types.ProveDeposit(nil, 0)
+	require.ErrorIs(t, err, types.ErrNilBlockBody)
+}
+
+// verifyMerkleBranch verifies a merkle branch for the leaf at the given
+// generalized index, independently of the merkle package.
+func verifyMerkleBranch(
+	leaf [32]byte,
+	branch [][32]byte,
+	gIndex uint64,
+	root [32]byte,
+) bool {
+	node := leaf
+	for _, sibling := range branch {
+		if gIndex%2 == 1 {
+			node = sha256.Sum256(append(sibling[:], node[:]...))
+		} else {
+			node = sha256.Sum256(append(node[:], sibling[:]...))
+		}
+		gIndex /= 2
+	}
+	return gIndex == 1 && node == root
+}
@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/ssz"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// SignedBeaconBlock is a beacon block along with the signature of its
+// proposer, as defined in the Ethereum 2.0 specification. Blocks are not
+// signed by the node itself, it is used to validate blocks obtained
+// externally, such as by tooling.
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#signedbeaconblock
+//
+//nolint:lll
+type SignedBeaconBlock struct {
+	// Message is the signed block.
+	Message *BeaconBlock
+	// Signature is the signature of the proposer over the block.
+	Signature crypto.BLSSignature
+}
+
+// NewSignedBeaconBlock signs the given block with the given signer, under the
+// beacon proposer domain of the fork active at the slot of the block.
+func NewSignedBeaconBlock(
+	blk *BeaconBlock,
+	cs primitives.ChainSpec,
+	genesisValidatorsRoot common.Root,
+	signer crypto.BLSSigner,
+) (*SignedBeaconBlock, error) {
+	signingRoot, err := computeBlockSigningRoot(blk, cs, genesisValidatorsRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := signer.Sign(signingRoot[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return &SignedBeaconBlock{Message: blk, Signature: signature}, nil
+}
+
+// VerifySignature verifies that the signature of the block was produced by
+// the given public key over the block, under the beacon proposer domain of
+// the fork active at the slot of the block.
+func (b *SignedBeaconBlock) VerifySignature(
+	cs primitives.ChainSpec,
+	genesisValidatorsRoot common.Root,
+	pubkey crypto.BLSPubkey,
+	signatureVerificationFn func(
+		pubkey crypto.BLSPubkey, message []byte, signature crypto.BLSSignature,
+	) error,
+) error {
+	signingRoot, err := computeBlockSigningRoot(
+		b.Message, cs, genesisValidatorsRoot,
+	)
+	if err != nil {
+		return err
+	}
+
+	if err = signatureVerificationFn(
+		pubkey, signingRoot[:], b.Signature,
+	); err != nil {
+		return errors.Join(err, ErrInvalidBlockSignature)
+	}
+	return nil
+}
+
+// computeBlockSigningRoot computes the root the proposer of the given block
+// signs.
+func computeBlockSigningRoot(
+	blk *BeaconBlock,
+	cs primitives.ChainSpec,
+	genesisValidatorsRoot common.Root,
+) (common.Root, error) {
+	if blk.IsNil() {
+		return common.Root{}, ErrNilBlock
+	}
+
+	domain, err := NewForkData(
+		version.FromUint32[common.Version](
+			cs.ActiveForkVersionForSlot(blk.GetSlot()),
+		),
+		genesisValidatorsRoot,
+	).ComputeDomain(cs.DomainTypeProposer())
+	if err != nil {
+		return common.Root{}, err
+	}
+
+	return ssz.ComputeSigningRoot(blk, domain)
+}
@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	stdmath "math"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+// hashSigner signs messages by hashing them along with its public key, such
+// that signatures are bound to both the message and the signer.
+type hashSigner struct {
+	pubkey crypto.BLSPubkey
+}
+
+func (s hashSigner) PublicKey() crypto.BLSPubkey { return s.pubkey }
+
+func (s hashSigner) Sign(msg []byte) (crypto.BLSSignature, error) {
+	var signature crypto.BLSSignature
+	digest := sha256.Sum256(append(s.pubkey[:], msg...))
+	for i := 0; i < len(signature); i += len(digest) {
+		copy(signature[i:], digest[:])
+	}
+	return signature, nil
+}
+
+func (hashSigner) VerifySignature(
+	pubkey crypto.BLSPubkey, msg []byte, signature crypto.BLSSignature,
+) error {
+	expected, err := hashSigner{pubkey: pubkey}.Sign(msg)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(expected[:], signature[:]) {
+		return errors.New("signature mismatch")
+	}
+	return nil
+}
+
+func TestSignedBeaconBlock_VerifySignature(t *testing.T) {
+	cs := chain.NewChainSpec(chain.SpecData[
+		common.DomainType, math.Epoch,
+		common.ExecutionAddress, math.Slot, any,
+	]{
+		SlotsPerEpoch:      32,
+		ElectraForkEpoch:   stdmath.MaxUint64,
+		DomainTypeProposer: common.DomainType{0x00, 0x00, 0x00, 0x00},
+	})
+	genesisValidatorsRoot := common.Root{1, 2, 3}
+	signer := hashSigner{pubkey: crypto.BLSPubkey{4, 5, 6}}
+
+	signed, err := types.NewSignedBeaconBlock(
+		&types.BeaconBlock{RawBeaconBlock: generateValidBeaconBlockDeneb()},
+		cs, genesisValidatorsRoot, signer,
+	)
+	require.NoError(t, err)
+	require.NoError(t, signed.VerifySignature(
+		cs, genesisValidatorsRoot, signer.PublicKey(), signer.VerifySignature,
+	))
+
+	// The signature is bound to the signer and the network.
+	err = signed.VerifySignature(
+		cs, genesisValidatorsRoot, crypto.BLSPubkey{7}, signer.VerifySignature,
+	)
+	require.ErrorIs(t, err, types.ErrInvalidBlockSignature)
+	err = signed.VerifySignature(
+		cs, common.Root{7}, signer.PublicKey(), signer.VerifySignature,
+	)
+	require.ErrorIs(t, err, types.ErrInvalidBlockSignature)
+
+	// The signature does not hold for a tampered block.
+	tampered := generateValidBeaconBlockDeneb()
+	tampered.ProposerIndex++
+	signed.Message = &types.BeaconBlock{RawBeaconBlock: tampered}
+	err = signed.VerifySignature(
+		cs, genesisValidatorsRoot, signer.PublicKey(), signer.VerifySignature,
+	)
+	require.ErrorIs(t, err, types.ErrInvalidBlockSignature)
+
+	signed.Message = nil
+	err = signed.VerifySignature(
+		cs, genesisValidatorsRoot, signer.PublicKey(), signer.VerifySignature,
+	)
+	require.ErrorIs(t, err, types.ErrNilBlock)
+}
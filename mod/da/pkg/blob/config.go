@@ -0,0 +1,43 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blob
+
+import "runtime"
+
+// verifyWorkersDivisor is the divisor of GOMAXPROCS that gives the default
+// number of workers of the Verifier.
+const verifyWorkersDivisor = 2
+
+// Config is the configuration of the Verifier that is set by the node.
+type Config struct {
+	// VerifyWorkers is the number of workers the KZG proofs of the blob
+	// sidecars of a block are verified across.
+	VerifyWorkers int
+}
+
+// DefaultConfig returns the default configuration of the Verifier, which
+// verifies KZG proofs across half of GOMAXPROCS workers, leaving the other
+// half to the rest of the node.
+func DefaultConfig() Config {
+	return Config{
+		VerifyWorkers: max(1, runtime.GOMAXPROCS(0)/verifyWorkersDivisor),
+	}
+}
@@ -37,16 +37,23 @@ type Verifier struct {
 	proofVerifier kzg.BlobProofVerifier
 	// metrics collects and reports metrics related to the verification process.
 	metrics *verifierMetrics
+	// workers is the number of workers the KZG proofs of the sidecars of a
+	// block are verified across.
+	workers int
 }
 
-// NewVerifier creates a new Verifier with the given proof verifier.
+// NewVerifier creates a new Verifier with the given proof verifier. The KZG
+// proofs of the sidecars of a block are verified across cfg.VerifyWorkers
+// workers, or a single worker if it is not positive.
 func NewVerifier(
 	proofVerifier kzg.BlobProofVerifier,
 	telemetrySink TelemetrySink,
+	cfg Config,
 ) *Verifier {
 	return &Verifier{
 		proofVerifier: proofVerifier,
 		metrics:       newVerifierMetrics(telemetrySink),
+		workers:       max(1, cfg.VerifyWorkers),
 	}
 }
 
@@ -98,7 +105,9 @@ func (bv *Verifier) VerifyInclusionProofs(
 	return scs.VerifyInclusionProofs(kzgOffset)
 }
 
-// VerifyKZGProofs verifies the sidecars.
+// VerifyKZGProofs verifies the sidecars. The sidecars, which all belong to
+// the same block, are split into contiguous groups that are each verified
+// by one of the workers of the Verifier.
 func (bv *Verifier) VerifyKZGProofs(
 	scs *types.BlobSidecars,
 ) error {
@@ -108,6 +117,29 @@ func (bv *Verifier) VerifyKZGProofs(
 		bv.proofVerifier.GetImplementation(),
 	)
 
+	workers := min(bv.workers, len(scs.Sidecars))
+	if workers <= 1 {
+		return bv.verifyKZGProofs(scs)
+	}
+
+	var (
+		g    errgroup.Group
+		size = (len(scs.Sidecars) + workers - 1) / workers
+	)
+	for i := 0; i < len(scs.Sidecars); i += size {
+		group := &types.BlobSidecars{
+			Sidecars: scs.Sidecars[i:min(i+size, len(scs.Sidecars))],
+		}
+		g.Go(func() error {
+			return bv.verifyKZGProofs(group)
+		})
+	}
+	return g.Wait()
+}
+
+// verifyKZGProofs verifies the KZG proofs of the given sidecars on the
+// calling goroutine.
+func (bv *Verifier) verifyKZGProofs(scs *types.BlobSidecars) error {
 	switch len(scs.Sidecars) {
 	case 0:
 		return nil
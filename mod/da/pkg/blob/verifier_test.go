@@ -0,0 +1,173 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blob_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/da/pkg/blob"
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg/gokzg"
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg/noop"
+	kzgtypes "github.com/berachain/beacon-kit/mod/da/pkg/kzg/types"
+	"github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	gokzg4844 "github.com/crate-crypto/go-kzg-4844"
+	"github.com/stretchr/testify/require"
+)
+
+const baseDir = "../../../../testing/files/"
+
+// noopSink is a telemetry sink that discards all metrics.
+type noopSink struct{}
+
+func (noopSink) MeasureSince(string, time.Time, ...string) {}
+
+// countingVerifier is a proof verifier that records the commitments of the
+// blobs it verifies.
+type countingVerifier struct {
+	*noop.Verifier
+	mu       sync.Mutex
+	verified map[eip4844.KZGCommitment]int
+}
+
+func newCountingVerifier() *countingVerifier {
+	return &countingVerifier{
+		Verifier: noop.NewVerifier(),
+		verified: make(map[eip4844.KZGCommitment]int),
+	}
+}
+
+func (v *countingVerifier) VerifyBlobProof(
+	_ *eip4844.Blob, _ eip4844.KZGProof, commitment eip4844.KZGCommitment,
+) error {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.verified[commitment]++
+	return nil
+}
+
+func (v *countingVerifier) VerifyBlobProofBatch(
+	args *kzgtypes.BlobProofArgs,
+) error {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	for _, commitment := range args.Commitments {
+		v.verified[commitment]++
+	}
+	return nil
+}
+
+// newSidecars returns n sidecars with distinct commitments.
+func newSidecars(n int) *types.BlobSidecars {
+	scs := &types.BlobSidecars{Sidecars: make([]*types.BlobSidecar, n)}
+	for i := range scs.Sidecars {
+		scs.Sidecars[i] = &types.BlobSidecar{
+			Index:         uint64(i),
+			KzgCommitment: eip4844.KZGCommitment{byte(i + 1)},
+		}
+	}
+	return scs
+}
+
+func TestVerifyKZGProofsWorkers(t *testing.T) {
+	for _, workers := range []int{0, 1, 2, 4, 6, 8} {
+		for _, n := range []int{0, 1, 5, 6} {
+			t.Run(fmt.Sprintf("%d workers %d sidecars", workers, n),
+				func(t *testing.T) {
+					pv := newCountingVerifier()
+					v := blob.NewVerifier(
+						pv, noopSink{}, blob.Config{VerifyWorkers: workers},
+					)
+					scs := newSidecars(n)
+					require.NoError(t, v.VerifyKZGProofs(scs))
+
+					// Every sidecar is verified exactly once.
+					require.Len(t, pv.verified, n)
+					for _, sc := range scs.Sidecars {
+						require.Equal(t, 1, pv.verified[sc.KzgCommitment])
+					}
+				})
+		}
+	}
+}
+
+func TestDefaultConfig(t *testing.T) {
+	require.Positive(t, blob.DefaultConfig().VerifyWorkers)
+}
+
+// loadSidecars returns n copies of the sidecar of the valid blob, proof and
+// commitment of the test data, along with a verifier of their proofs.
+func loadSidecars(
+	b *testing.B, n int,
+) (*gokzg.Verifier, *types.BlobSidecars) {
+	b.Helper()
+	setup, err := os.ReadFile(filepath.Join(baseDir, "kzg-trusted-setup.json"))
+	require.NoError(b, err)
+	var ts gokzg4844.JSONTrustedSetup
+	require.NoError(b, json.Unmarshal(setup, &ts))
+	pv, err := gokzg.NewVerifier(&ts)
+	require.NoError(b, err)
+
+	data, err := os.ReadFile(filepath.Join(baseDir, "test_data.json"))
+	require.NoError(b, err)
+	var test struct {
+		Input struct {
+			Blob       eip4844.Blob          `json:"blob"`
+			Commitment eip4844.KZGCommitment `json:"commitment"`
+			Proof      eip4844.KZGProof      `json:"proof"`
+		} `json:"input"`
+	}
+	require.NoError(b, json.Unmarshal(data, &test))
+
+	scs := &types.BlobSidecars{Sidecars: make([]*types.BlobSidecar, n)}
+	for i := range scs.Sidecars {
+		scs.Sidecars[i] = &types.BlobSidecar{
+			Index:         uint64(i),
+			Blob:          test.Input.Blob,
+			KzgCommitment: test.Input.Commitment,
+			KzgProof:      test.Input.Proof,
+		}
+	}
+	return pv, scs
+}
+
+func BenchmarkVerifyKZGProofs(b *testing.B) {
+	pv, scs := loadSidecars(b, 6)
+	for _, workers := range []int{1, 2, 3, 6} {
+		b.Run(fmt.Sprintf("%d workers", workers), func(b *testing.B) {
+			v := blob.NewVerifier(
+				pv, noopSink{}, blob.Config{VerifyWorkers: workers},
+			)
+			b.ResetTimer()
+			for range b.N {
+				if err := v.VerifyKZGProofs(scs); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
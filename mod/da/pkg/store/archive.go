@@ -0,0 +1,288 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package store
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"hash"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+)
+
+const (
+	// checksumEntryName is the name of the trailing entry of an archive,
+	// which holds the hex encoded SHA-256 checksum of its sidecar entries.
+	checksumEntryName = "checksum.sha256"
+
+	// archiveEntryMode is the mode of the entries of an archive.
+	archiveEntryMode = 0o600
+)
+
+// Export writes all the sidecars of the store to w as a gzip compressed tar
+// archive, streaming them slot by slot. Each sidecar is written to an entry
+// named after its slot and KZG commitment, and the archive ends with an
+// entry holding the checksum of all the sidecar entries.
+func (s *Store[BeaconBlockT]) Export(ctx context.Context, w io.Writer) error {
+	db, ok := s.IndexDB.(ArchivableIndexDB)
+	if !ok {
+		return ErrIndexDBNotArchivable
+	}
+	slots, err := db.Indexes()
+	if err != nil {
+		return err
+	}
+
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+	checksum := sha256.New()
+	var count int
+	for _, slot := range slots {
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+		values, rangeErr := db.GetRange(slot, slot+1)
+		if rangeErr != nil {
+			return rangeErr
+		}
+		for _, bz := range values[slot] {
+			sc := new(types.BlobSidecar)
+			if err = sc.UnmarshalSSZ(bz); err != nil {
+				return err
+			}
+			if err = writeArchiveEntry(
+				tw, checksum, archiveEntryName(slot, sc), bz,
+			); err != nil {
+				return err
+			}
+			count++
+		}
+	}
+
+	if err = writeArchiveEntry(
+		tw, nil, checksumEntryName,
+		[]byte(hex.EncodeToString(checksum.Sum(nil))),
+	); err != nil {
+		return err
+	}
+	if err = errors.Join(tw.Close(), gw.Close()); err != nil {
+		return err
+	}
+
+	s.logger.Info("successfully exported blob sidecars", "count", count)
+	return nil
+}
+
+// Import reads the sidecars of an archive written by Export from r into the
+// store, under the slots they were exported from. The store must not hold
+// any sidecars. The sidecars are written as they are read, and removed again
+// if the archive turns out to be invalid, such as when its checksum does not
+// match its sidecars.
+func (s *Store[BeaconBlockT]) Import(ctx context.Context, r io.Reader) error {
+	db, ok := s.IndexDB.(ArchivableIndexDB)
+	if !ok {
+		return ErrIndexDBNotArchivable
+	}
+	slots, err := db.Indexes()
+	if err != nil {
+		return err
+	}
+	if len(slots) > 0 {
+		return ErrStoreNotEmpty
+	}
+
+	imported := &importedRange{}
+	if err = s.importArchive(ctx, db, r, imported); err != nil {
+		if imported.count > 0 {
+			err = errors.Join(
+				err, db.Prune(imported.first, imported.last+1),
+			)
+		}
+		return err
+	}
+
+	s.logger.Info(
+		"successfully imported blob sidecars", "count", imported.count,
+	)
+	return nil
+}
+
+// importedRange is the range of slots sidecars have been imported for.
+type importedRange struct {
+	first, last uint64
+	count       int
+}
+
+// add adds the given slot to the range.
+func (r *importedRange) add(slot uint64) {
+	if r.count == 0 || slot < r.first {
+		r.first = slot
+	}
+	r.last = max(r.last, slot)
+	r.count++
+}
+
+// importArchive reads the sidecars of the archive from r into the given db,
+// recording the slots written to in imported.
+func (s *Store[BeaconBlockT]) importArchive(
+	ctx context.Context,
+	db IndexDB,
+	r io.Reader,
+	imported *importedRange,
+) error {
+	gr, err := gzip.NewReader(r)
+	if err != nil {
+		return errors.Join(ErrInvalidArchive, err)
+	}
+	defer gr.Close()
+
+	tr := tar.NewReader(gr)
+	checksum := sha256.New()
+	for {
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+		hdr, nextErr := tr.Next()
+		if errors.Is(nextErr, io.EOF) {
+			return errors.Wrap(ErrInvalidArchive, "missing checksum")
+		} else if nextErr != nil {
+			return errors.Join(ErrInvalidArchive, nextErr)
+		}
+		if hdr.Name == checksumEntryName {
+			return verifyChecksum(tr, checksum)
+		}
+
+		slot, sc, bz, readErr := readArchiveEntry(tr, hdr)
+		if readErr != nil {
+			return readErr
+		}
+		writeChecksum(checksum, hdr.Name, bz)
+		if err = db.Set(slot, sc.KzgCommitment[:], bz); err != nil {
+			return err
+		}
+		imported.add(slot)
+	}
+}
+
+// archiveEntryName returns the name of the archive entry of the sidecar
+// stored for the given slot.
+func archiveEntryName(slot uint64, sc *types.BlobSidecar) string {
+	return strconv.FormatUint(slot, 10) + "/" +
+		hex.EncodeToString(sc.KzgCommitment[:])
+}
+
+// writeArchiveEntry writes an entry with the given name and content to the
+// archive, adding it to the checksum if it is not nil.
+func writeArchiveEntry(
+	tw *tar.Writer,
+	checksum hash.Hash,
+	name string,
+	content []byte,
+) error {
+	if err := tw.WriteHeader(&tar.Header{
+		Name:     name,
+		Mode:     archiveEntryMode,
+		Size:     int64(len(content)),
+		Typeflag: tar.TypeReg,
+	}); err != nil {
+		return err
+	}
+	if _, err := tw.Write(content); err != nil {
+		return err
+	}
+	if checksum != nil {
+		writeChecksum(checksum, name, content)
+	}
+	return nil
+}
+
+// readArchiveEntry reads the sidecar of the given archive entry, along with
+// the slot it was exported from and its SSZ encoding.
+func readArchiveEntry(
+	tr *tar.Reader,
+	hdr *tar.Header,
+) (uint64, *types.BlobSidecar, []byte, error) {
+	sc := new(types.BlobSidecar)
+	if hdr.Size != int64(sc.SizeSSZ()) {
+		return 0, nil, nil, errors.Wrapf(
+			ErrInvalidArchive, "entry %q has size %d", hdr.Name, hdr.Size,
+		)
+	}
+	slotName, _, _ := strings.Cut(hdr.Name, "/")
+	slot, err := strconv.ParseUint(slotName, 10, 64)
+	if err != nil {
+		return 0, nil, nil, errors.Wrapf(
+			ErrInvalidArchive, "entry %q has no slot", hdr.Name,
+		)
+	}
+
+	bz := make([]byte, hdr.Size)
+	if _, err = io.ReadFull(tr, bz); err != nil {
+		return 0, nil, nil, errors.Join(ErrInvalidArchive, err)
+	}
+	if err = sc.UnmarshalSSZ(bz); err != nil {
+		return 0, nil, nil, errors.Join(ErrInvalidArchive, err)
+	}
+	if archiveEntryName(slot, sc) != hdr.Name {
+		return 0, nil, nil, errors.Wrapf(
+			ErrInvalidArchive, "entry %q does not match its sidecar",
+			hdr.Name,
+		)
+	}
+	return slot, sc, bz, nil
+}
+
+// writeChecksum adds the entry with the given name and content to the
+// checksum. The length of the content is included so that the boundaries of
+// the entries are unambiguous.
+func writeChecksum(checksum hash.Hash, name string, content []byte) {
+	checksum.Write([]byte(name))
+	checksum.Write(binary.LittleEndian.AppendUint64(
+		nil, uint64(len(content)),
+	))
+	checksum.Write(content)
+}
+
+// verifyChecksum verifies that the checksum entry read from tr, which must be
+// the last entry of the archive, matches the given checksum.
+func verifyChecksum(tr *tar.Reader, checksum hash.Hash) error {
+	want := []byte(hex.EncodeToString(checksum.Sum(nil)))
+	got, err := io.ReadAll(io.LimitReader(tr, int64(len(want))+1))
+	if err != nil {
+		return errors.Join(ErrInvalidArchive, err)
+	}
+	if !bytes.Equal(got, want) {
+		return errors.Wrap(ErrInvalidArchive, "checksum mismatch")
+	}
+	if _, err = tr.Next(); !errors.Is(err, io.EOF) {
+		return errors.Wrap(ErrInvalidArchive, "entries after checksum")
+	}
+	return nil
+}
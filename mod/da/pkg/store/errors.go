@@ -34,4 +34,41 @@ var (
 	ErrAttemptedToVerifyNilSidecars = errors.New(
 		"attempted to verify nil sidecars",
 	)
+
+	// ErrIndexDBNotPrunable is returned when an attempt is made to prune a
+	// store whose IndexDB does not support pruning.
+	ErrIndexDBNotPrunable = errors.New("index db does not support pruning")
+
+	// ErrConflictingSidecar is returned when an attempt is made to store a
+	// sidecar whose index is already stored for the slot with a different
+	// KZG commitment.
+	ErrConflictingSidecar = errors.New(
+		"sidecar conflicts with stored sidecar",
+	)
+
+	// ErrIndexDBNotRangeable is returned when an attempt is made to read a
+	// range from a store whose IndexDB does not support range reads.
+	ErrIndexDBNotRangeable = errors.New(
+		"index db does not support range reads",
+	)
+
+	// ErrIndexDBNotReadable is returned when an attempt is made to read a
+	// single value from a store whose IndexDB does not support it.
+	ErrIndexDBNotReadable = errors.New(
+		"index db does not support single reads",
+	)
+
+	// ErrIndexDBNotArchivable is returned when an attempt is made to export
+	// or import a store whose IndexDB cannot list the indexes it stores.
+	ErrIndexDBNotArchivable = errors.New(
+		"index db does not support archives",
+	)
+
+	// ErrStoreNotEmpty is returned when an archive is imported into a store
+	// that already holds sidecars.
+	ErrStoreNotEmpty = errors.New("store is not empty")
+
+	// ErrInvalidArchive is returned when an archive being imported is
+	// malformed, such as when its checksum does not match its sidecars.
+	ErrInvalidArchive = errors.New("invalid sidecar archive")
 )
@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package store
+
+import (
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+)
+
+const (
+	// DefaultMaxRangeSlots is the default maximum number of slots read by a
+	// single range query.
+	DefaultMaxRangeSlots = 128
+	// DefaultCompactionInterval is the default interval at which the IndexDB
+	// is compacted.
+	DefaultCompactionInterval = time.Hour
+)
+
+// Config is the configuration of the Store that is set by the node.
+type Config struct {
+	// CompactionInterval is the interval at which the IndexDB is compacted,
+	// compaction is disabled if it is zero.
+	CompactionInterval time.Duration
+	// ReadReplica is the database reads are served from in preference to
+	// the IndexDB, if set.
+	ReadReplica RangeIndexDB
+}
+
+// Option is a functional option for the Store.
+type Option[BeaconBlockBodyT BeaconBlockBody] func(
+	*Store[BeaconBlockBodyT],
+) error
+
+// WithMaxRangeSlots sets the maximum number of slots read by a single range
+// query.
+func WithMaxRangeSlots[BeaconBlockBodyT BeaconBlockBody](
+	maxRangeSlots uint64,
+) Option[BeaconBlockBodyT] {
+	return func(s *Store[BeaconBlockBodyT]) error {
+		if maxRangeSlots == 0 {
+			return errors.New("max range slots must be greater than zero")
+		}
+		s.maxRangeSlots = maxRangeSlots
+		return nil
+	}
+}
+
+// WithCompactionInterval sets the interval at which the IndexDB is compacted
+// once its compaction is started, if it is a CompactableIndexDB. Compaction is
+// disabled if the interval is zero.
+func WithCompactionInterval[BeaconBlockBodyT BeaconBlockBody](
+	interval time.Duration,
+) Option[BeaconBlockBodyT] {
+	return func(s *Store[BeaconBlockBodyT]) error {
+		if interval < 0 {
+			return errors.New("compaction interval must not be negative")
+		}
+		s.compactionInterval = interval
+		return nil
+	}
+}
+
+// WithReadReplica sets a replica of the IndexDB that sidecars are read from
+// in preference to the IndexDB, e.g. to spread the load of serving them.
+// Reads fall back to the IndexDB when the replica does not have the requested
+// sidecars, such as while it is catching up, or fails to be read. Sidecars
+// are only ever written to the IndexDB.
+func WithReadReplica[BeaconBlockBodyT BeaconBlockBody](
+	replica RangeIndexDB,
+) Option[BeaconBlockBodyT] {
+	return func(s *Store[BeaconBlockBodyT]) error {
+		if replica == nil {
+			return errors.New("read replica must not be nil")
+		}
+		s.replica = replica
+		return nil
+	}
+}
@@ -21,7 +21,10 @@
 package store
 
 import (
+	"context"
+
 	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
 func BuildPruneRangeFn[
@@ -29,11 +32,40 @@ func BuildPruneRangeFn[
 	BlockEventT BlockEvent[BeaconBlockT],
 ](cs primitives.ChainSpec) func(BlockEventT) (uint64, uint64) {
 	return func(event BlockEventT) (uint64, uint64) {
-		window := cs.MinEpochsForBlobsSidecarsRequest() * cs.SlotsPerEpoch()
-		if event.Data().GetSlot().Unwrap() < window {
-			return 0, 0
-		}
+		return 0, primitives.EarliestAvailableBlobSlot(
+			cs, event.Data().GetSlot(),
+		).Unwrap()
+	}
+}
+
+// Prunable adapts a Store to the Prunable interface of the storage pruner,
+// pruning the Store through Store.Prune at most once every epoch.
+type Prunable[BeaconBlockBodyT BeaconBlockBody] struct {
+	store *Store[BeaconBlockBodyT]
+	// next is the lowest end of a range that triggers the next prune.
+	next uint64
+}
+
+// NewPrunable returns a Prunable that prunes the given Store.
+func NewPrunable[BeaconBlockBodyT BeaconBlockBody](
+	store *Store[BeaconBlockBodyT],
+) *Prunable[BeaconBlockBodyT] {
+	return &Prunable[BeaconBlockBodyT]{store: store}
+}
 
-		return 0, event.Data().GetSlot().Unwrap() - window
+// Prune removes the sidecars stored for slots below end. The start of the
+// range is ignored, since Store.Prune always prunes from the first slot.
+// Nothing is pruned until end has advanced by at least an epoch since the
+// last prune.
+func (p *Prunable[BeaconBlockBodyT]) Prune(_, end uint64) error {
+	if end < p.next {
+		return nil
+	}
+	if _, err := p.store.Prune(
+		context.Background(), math.Slot(end),
+	); err != nil {
+		return err
 	}
+	p.next = end + p.store.chainSpec.SlotsPerEpoch()
+	return nil
 }
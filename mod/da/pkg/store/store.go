@@ -21,8 +21,15 @@
 package store
 
 import (
+	"cmp"
 	"context"
+	"io/fs"
+	"maps"
+	"slices"
+	"sync/atomic"
+	"time"
 
+	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	"github.com/berachain/beacon-kit/mod/da/pkg/types"
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/log"
@@ -39,6 +46,15 @@ type Store[BeaconBlockBodyT BeaconBlockBody] struct {
 	logger log.Logger[any]
 	// chainSpec contains the chain specification.
 	chainSpec primitives.ChainSpec
+	// maxRangeSlots is the maximum number of slots read by a range query.
+	maxRangeSlots uint64
+	// compactionInterval is the interval at which the IndexDB is compacted.
+	compactionInterval time.Duration
+	// pruning is set while the Store is pruning the IndexDB.
+	pruning atomic.Bool
+	// replica is the database reads are served from in preference to the
+	// IndexDB, if any.
+	replica RangeIndexDB
 }
 
 // New creates a new instance of the AvailabilityStore.
@@ -46,14 +62,64 @@ func New[BeaconBlockT BeaconBlockBody](
 	db IndexDB,
 	logger log.Logger[any],
 	chainSpec primitives.ChainSpec,
+	opts ...Option[BeaconBlockT],
 ) *Store[BeaconBlockT] {
-	return &Store[BeaconBlockT]{
-		IndexDB:   db,
-		chainSpec: chainSpec,
-		logger:    logger,
+	s := &Store[BeaconBlockT]{
+		IndexDB:            db,
+		chainSpec:          chainSpec,
+		logger:             logger,
+		maxRangeSlots:      DefaultMaxRangeSlots,
+		compactionInterval: DefaultCompactionInterval,
+	}
+	for _, opt := range opts {
+		if err := opt(s); err != nil {
+			panic(errors.Wrap(err, "failed to apply option"))
+		}
+	}
+	return s
+}
+
+// StartCompaction compacts the IndexDB in the background at the compaction
+// interval until the context is cancelled. Nothing is started if compaction
+// is disabled or the IndexDB is not a CompactableIndexDB.
+func (s *Store[BeaconBlockBodyT]) StartCompaction(ctx context.Context) {
+	db, ok := s.IndexDB.(CompactableIndexDB)
+	if !ok || s.compactionInterval == 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(s.compactionInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				s.compact(db)
+			}
+		}
+	}()
+}
+
+// compact compacts the IndexDB, unless it is being pruned, in which case the
+// compaction is skipped until the next interval.
+func (s *Store[BeaconBlockBodyT]) compact(db CompactableIndexDB) {
+	if s.pruning.Load() {
+		s.logger.Debug("skipping compaction of blob sidecars while pruning")
+		return
+	}
+	if err := db.Compact(); err != nil {
+		s.logger.Error("failed to compact blob sidecars", "error", err)
 	}
 }
 
+// IsInitialized reports whether the store is backed by an IndexDB and is
+// thus ready to serve and persist sidecars.
+func (s *Store[BeaconBlockBodyT]) IsInitialized() bool {
+	return s != nil && s.IndexDB != nil
+}
+
 // IsDataAvailable ensures that all blobs referenced in the block are
 // stored before it returns without an error.
 func (s *Store[BeaconBlockBodyT]) IsDataAvailable(
@@ -63,7 +129,7 @@ func (s *Store[BeaconBlockBodyT]) IsDataAvailable(
 ) bool {
 	for _, commitment := range body.GetBlobKzgCommitments() {
 		// Check if the block data is available in the IndexDB
-		blockData, err := s.IndexDB.Has(uint64(slot), commitment[:])
+		blockData, err := s.has(uint64(slot), commitment[:])
 		if err != nil || !blockData {
 			return false
 		}
@@ -71,8 +137,64 @@ func (s *Store[BeaconBlockBodyT]) IsDataAvailable(
 	return true
 }
 
+// has reports whether the value of the key at the index is stored. The read
+// replica is checked first, if any, falling back to the IndexDB if it does
+// not have the value or fails to be read.
+func (s *Store[BeaconBlockBodyT]) has(index uint64, key []byte) (bool, error) {
+	if s.replica != nil {
+		ok, err := s.replica.Has(index, key)
+		if err == nil && ok {
+			return true, nil
+		}
+		if err != nil {
+			s.logger.Warn(
+				"Failed to read from DA read replica, falling back to primary",
+				"index", index, "error", err,
+			)
+		}
+	}
+	return s.IndexDB.Has(index, key)
+}
+
+// Get returns the value stored for the key at the index. It is read from the
+// read replica, if any, falling back to the IndexDB if the replica does not
+// have the value or fails to be read. It fails with ErrIndexDBNotReadable if
+// the IndexDB does not support reading single values.
+func (s *Store[BeaconBlockBodyT]) Get(
+	index uint64,
+	key []byte,
+) ([]byte, error) {
+	db, ok := s.IndexDB.(ReadableIndexDB)
+	if !ok {
+		return nil, ErrIndexDBNotReadable
+	}
+	if replica, isReadable := s.replica.(ReadableIndexDB); isReadable {
+		value, err := replica.Get(index, key)
+		if err == nil {
+			return value, nil
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			s.logger.Warn(
+				"Failed to read from DA read replica, falling back to primary",
+				"index", index, "error", err,
+			)
+		}
+	}
+	return db.Get(index, key)
+}
+
 // Persist ensures the sidecar data remains accessible, utilizing parallel
-// processing for efficiency.
+// processing for efficiency. Sidecars that are not valid under the chain spec
+// are rejected without being written, as are sidecars whose inclusion proof
+// does not tie their KZG commitment to the body root of their block, in which
+// case ErrInvalidInclusionProof names the index of each offending blob.
+//
+// Persisting is idempotent per slot and blob index: sidecars already stored
+// for the slot with the same KZG commitment are skipped. If a sidecar with
+// the same index but a different commitment is stored, the sidecars are
+// rejected with ErrConflictingSidecar and none of them are written, as the
+// stored sidecar can only be removed by pruning its slot. Conflicts are only
+// detected if the IndexDB supports range reads.
 func (s *Store[BeaconBlockT]) Persist(
 	slot math.Slot,
 	sidecars *types.BlobSidecars,
@@ -82,6 +204,11 @@ func (s *Store[BeaconBlockT]) Persist(
 		return nil
 	}
 
+	// Reject malformed sidecars before anything is written.
+	if err := sidecars.Validate(s.chainSpec); err != nil {
+		return err
+	}
+
 	// Check to see if we are required to store the sidecar anymore, if
 	// this sidecar is from outside the required DA period, we can skip it.
 	if !s.chainSpec.WithinDAPeriod(
@@ -94,17 +221,32 @@ func (s *Store[BeaconBlockT]) Persist(
 		return nil
 	}
 
+	// Reject sidecars that cannot be attributed to the body of their block.
+	if err := sidecars.VerifyInclusionProofs(
+		ctypes.BlockBodyKZGOffset(
+			sidecars.Sidecars[0].BeaconBlockHeader.GetSlot(), s.chainSpec,
+		),
+	); err != nil {
+		return err
+	}
+
+	// Skip the sidecars that are already stored for the slot.
+	toStore, err := s.filterStored(slot, sidecars.Sidecars)
+	if err != nil {
+		return err
+	}
+
 	// Store each sidecar in parallel.
-	if err := errors.Join(iter.Map(
-		sidecars.Sidecars,
+	if err = errors.Join(iter.Map(
+		toStore,
 		func(sidecar **types.BlobSidecar) error {
 			if *sidecar == nil {
 				return ErrAttemptedToStoreNilSidecar
 			}
 			sc := *sidecar
-			bz, err := sc.MarshalSSZ()
-			if err != nil {
-				return err
+			bz, marshalErr := sc.MarshalSSZ()
+			if marshalErr != nil {
+				return marshalErr
 			}
 			return s.Set(uint64(slot), sc.KzgCommitment[:], bz)
 		},
@@ -115,3 +257,177 @@ func (s *Store[BeaconBlockT]) Persist(
 	s.logger.Info("successfully stored all blob sidecars 🚗", "slot", slot)
 	return nil
 }
+
+// filterStored returns the sidecars that are not yet stored for the slot. It
+// fails with ErrConflictingSidecar if any sidecar is stored for the slot with
+// the same index but a different commitment. All sidecars are returned if the
+// IndexDB does not support range reads.
+func (s *Store[BeaconBlockT]) filterStored(
+	slot math.Slot,
+	sidecars []*types.BlobSidecar,
+) ([]*types.BlobSidecar, error) {
+	db, ok := s.IndexDB.(RangeIndexDB)
+	if !ok {
+		return sidecars, nil
+	}
+
+	values, err := db.GetRange(slot.Unwrap(), slot.Unwrap()+1)
+	if err != nil {
+		return nil, err
+	}
+	stored := make(map[uint64]*types.BlobSidecar, len(values[slot.Unwrap()]))
+	for _, bz := range values[slot.Unwrap()] {
+		sc := new(types.BlobSidecar)
+		if err = sc.UnmarshalSSZ(bz); err != nil {
+			return nil, err
+		}
+		stored[sc.Index] = sc
+	}
+
+	toStore := make([]*types.BlobSidecar, 0, len(sidecars))
+	for _, sc := range sidecars {
+		if sc == nil {
+			toStore = append(toStore, sc)
+			continue
+		}
+		existing, found := stored[sc.Index]
+		switch {
+		case !found:
+			toStore = append(toStore, sc)
+		case existing.KzgCommitment != sc.KzgCommitment:
+			s.logger.Warn(
+				"rejecting sidecar conflicting with stored sidecar",
+				"slot", slot,
+				"index", sc.Index,
+				"stored_commitment", existing.KzgCommitment,
+				"commitment", sc.KzgCommitment,
+			)
+			return nil, errors.Wrapf(
+				ErrConflictingSidecar, "slot %d, index %d", slot, sc.Index,
+			)
+		}
+	}
+	return toStore, nil
+}
+
+// Prune removes all the sidecars stored for slots below beforeSlot and
+// returns the number of sidecars that were removed.
+func (s *Store[BeaconBlockT]) Prune(
+	ctx context.Context,
+	beforeSlot math.Slot,
+) (int, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	db, ok := s.IndexDB.(PrunableIndexDB)
+	if !ok {
+		return 0, ErrIndexDBNotPrunable
+	}
+	s.pruning.Store(true)
+	defer s.pruning.Store(false)
+
+	count, err := db.CountRange(0, beforeSlot.Unwrap())
+	if err != nil {
+		return 0, err
+	}
+	if err = db.Prune(0, beforeSlot.Unwrap()); err != nil {
+		return 0, err
+	}
+
+	s.logger.Info(
+		"successfully pruned blob sidecars ✂️",
+		"before_slot", beforeSlot,
+		"count", count,
+	)
+	return count, nil
+}
+
+// GetSidecarsRange returns the sidecars stored for the slots in
+// [startSlot, endSlot], keyed by slot. Slots without any stored sidecars are
+// skipped, and at most maxRangeSlots slots starting at startSlot are read.
+// The range is read from the read replica, if any, and the slots it lacks are
+// read from the IndexDB. The whole range is read from the IndexDB if the
+// replica fails to be read.
+func (s *Store[BeaconBlockT]) GetSidecarsRange(
+	ctx context.Context,
+	startSlot, endSlot math.Slot,
+) (map[math.Slot]*types.BlobSidecars, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	db, ok := s.IndexDB.(RangeIndexDB)
+	if !ok {
+		return nil, ErrIndexDBNotRangeable
+	}
+
+	result := make(map[math.Slot]*types.BlobSidecars)
+	if startSlot > endSlot {
+		return result, nil
+	}
+
+	end := min(endSlot.Unwrap()+1, startSlot.Unwrap()+s.maxRangeSlots)
+	values, err := s.getRange(db, startSlot.Unwrap(), end)
+	if err != nil {
+		return nil, err
+	}
+
+	for slot, bzs := range values {
+		sidecars := make([]*types.BlobSidecar, 0, len(bzs))
+		for _, bz := range bzs {
+			sc := new(types.BlobSidecar)
+			if err = sc.UnmarshalSSZ(bz); err != nil {
+				return nil, err
+			}
+			sidecars = append(sidecars, sc)
+		}
+		slices.SortFunc(sidecars, func(a, b *types.BlobSidecar) int {
+			return cmp.Compare(a.Index, b.Index)
+		})
+		result[math.Slot(slot)] = &types.BlobSidecars{Sidecars: sidecars}
+	}
+	return result, nil
+}
+
+// getRange returns the values stored in [from, to) of the read replica, if
+// any, with the indexes it has no values for read from the given database.
+// The replica cannot tell an index without values from one it has not caught
+// up with yet, so the database is consulted for every index the replica
+// lacks, reading runs of consecutive indexes at once.
+func (s *Store[BeaconBlockT]) getRange(
+	db RangeIndexDB,
+	from, to uint64,
+) (map[uint64][][]byte, error) {
+	if s.replica == nil {
+		return db.GetRange(from, to)
+	}
+	values, err := s.replica.GetRange(from, to)
+	if err != nil {
+		s.logger.Warn(
+			"Failed to read from DA read replica, falling back to primary",
+			"from", from, "to", to, "error", err,
+		)
+		return db.GetRange(from, to)
+	}
+
+	for start := from; start < to; {
+		if _, ok := values[start]; ok {
+			start++
+			continue
+		}
+		end := start + 1
+		for ; end < to; end++ {
+			if _, ok := values[end]; ok {
+				break
+			}
+		}
+		missing, rangeErr := db.GetRange(start, end)
+		if rangeErr != nil {
+			return nil, rangeErr
+		}
+		maps.Copy(values, missing)
+		start = end
+	}
+	return values, nil
+}
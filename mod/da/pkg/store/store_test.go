@@ -0,0 +1,724 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package store_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"io/fs"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/da/pkg/store"
+	"github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle"
+	"github.com/stretchr/testify/require"
+)
+
+// testIndexDB is an in-memory PrunableIndexDB used for testing.
+type testIndexDB struct {
+	data map[uint64]map[string][]byte
+}
+
+func newTestIndexDB() *testIndexDB {
+	return &testIndexDB{data: make(map[uint64]map[string][]byte)}
+}
+
+func (db *testIndexDB) Has(index uint64, key []byte) (bool, error) {
+	_, ok := db.data[index][string(key)]
+	return ok, nil
+}
+
+func (db *testIndexDB) Set(index uint64, key []byte, value []byte) error {
+	if db.data[index] == nil {
+		db.data[index] = make(map[string][]byte)
+	}
+	db.data[index][string(key)] = value
+	return nil
+}
+
+func (db *testIndexDB) CountRange(from, to uint64) (int, error) {
+	var count int
+	for index, values := range db.data {
+		if index >= from && index < to {
+			count += len(values)
+		}
+	}
+	return count, nil
+}
+
+func (db *testIndexDB) Prune(from, to uint64) error {
+	for index := range db.data {
+		if index >= from && index < to {
+			delete(db.data, index)
+		}
+	}
+	return nil
+}
+
+func (db *testIndexDB) Get(index uint64, key []byte) ([]byte, error) {
+	value, ok := db.data[index][string(key)]
+	if !ok {
+		return nil, fs.ErrNotExist
+	}
+	return value, nil
+}
+
+func (db *testIndexDB) GetRange(
+	from, to uint64,
+) (map[uint64][][]byte, error) {
+	values := make(map[uint64][][]byte)
+	for index, kv := range db.data {
+		if index < from || index >= to {
+			continue
+		}
+		for _, v := range kv {
+			values[index] = append(values[index], v)
+		}
+	}
+	return values, nil
+}
+
+func (db *testIndexDB) Indexes() ([]uint64, error) {
+	indexes := make([]uint64, 0, len(db.data))
+	for index, values := range db.data {
+		if len(values) > 0 {
+			indexes = append(indexes, index)
+		}
+	}
+	slices.Sort(indexes)
+	return indexes, nil
+}
+
+// plainIndexDB only exposes the methods of a plain IndexDB.
+type plainIndexDB struct {
+	store.IndexDB
+}
+
+// compactingIndexDB is a testIndexDB that counts its compactions. If
+// pruneStarted is set, pruning signals it and blocks until unblockPrune is
+// closed.
+type compactingIndexDB struct {
+	*testIndexDB
+	compactions  atomic.Int64
+	pruneStarted chan struct{}
+	unblockPrune chan struct{}
+}
+
+func (db *compactingIndexDB) Compact() error {
+	db.compactions.Add(1)
+	return nil
+}
+
+func (db *compactingIndexDB) Prune(from, to uint64) error {
+	if db.pruneStarted != nil {
+		close(db.pruneStarted)
+		<-db.unblockPrune
+	}
+	return db.testIndexDB.Prune(from, to)
+}
+
+// testCommitments is the commitments type of the testBeaconBlockBody.
+type testCommitments = eip4844.KZGCommitments[common.ExecutionHash]
+
+// testBeaconBlockBody is a beacon block body without any commitments.
+type testBeaconBlockBody struct{}
+
+func (testBeaconBlockBody) GetBlobKzgCommitments() testCommitments {
+	return nil
+}
+
+func TestStorePrune(t *testing.T) {
+	db := newTestIndexDB()
+	for slot := uint64(1); slot <= 10; slot++ {
+		require.NoError(t, db.Set(slot, []byte("commitment-0"), []byte{}))
+		require.NoError(t, db.Set(slot, []byte("commitment-1"), []byte{}))
+	}
+	s := store.New[*testBeaconBlockBody](db, noop.NewLogger(), nil)
+
+	count, err := s.Prune(context.Background(), math.Slot(6))
+	require.NoError(t, err)
+	require.Equal(t, 10, count)
+
+	for slot := uint64(1); slot <= 10; slot++ {
+		has, hasErr := db.Has(slot, []byte("commitment-0"))
+		require.NoError(t, hasErr)
+		require.Equal(t, slot >= 6, has, "slot %d", slot)
+	}
+
+	// Pruning again below the same slot is a no-op.
+	count, err = s.Prune(context.Background(), math.Slot(6))
+	require.NoError(t, err)
+	require.Zero(t, count)
+}
+
+func TestStorePruneNotPrunable(t *testing.T) {
+	s := store.New[*testBeaconBlockBody](
+		plainIndexDB{newTestIndexDB()}, noop.NewLogger(), nil,
+	)
+	_, err := s.Prune(context.Background(), math.Slot(1))
+	require.ErrorIs(t, err, store.ErrIndexDBNotPrunable)
+}
+
+func TestStorePruneCanceledContext(t *testing.T) {
+	s := store.New[*testBeaconBlockBody](
+		newTestIndexDB(), noop.NewLogger(), nil,
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := s.Prune(ctx, math.Slot(1))
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestPrunable(t *testing.T) {
+	db := newTestIndexDB()
+	for slot := uint64(1); slot <= 20; slot++ {
+		require.NoError(t, db.Set(slot, []byte("commitment"), []byte{}))
+	}
+	cs := chain.NewChainSpec(chain.SpecData[
+		common.DomainType, math.Epoch,
+		common.ExecutionAddress, math.Slot, any,
+	]{
+		SlotsPerEpoch: 4,
+	})
+	p := store.NewPrunable(store.New[*testBeaconBlockBody](
+		db, noop.NewLogger(), cs,
+	))
+
+	// The first prune removes the slots below the end of the range, and the
+	// following ones are skipped until the end has advanced by an epoch.
+	for _, tc := range []struct {
+		end    uint64
+		lowest uint64
+	}{
+		{end: 5, lowest: 5},
+		{end: 8, lowest: 5},
+		{end: 9, lowest: 9},
+		{end: 12, lowest: 9},
+		{end: 15, lowest: 15},
+	} {
+		require.NoError(t, p.Prune(0, tc.end))
+		for slot := uint64(1); slot <= 20; slot++ {
+			has, err := db.Has(slot, []byte("commitment"))
+			require.NoError(t, err)
+			require.Equal(
+				t, slot >= tc.lowest, has, "end %d, slot %d", tc.end, slot,
+			)
+		}
+	}
+}
+
+func TestStoreGetSidecarsRange(t *testing.T) {
+	db := newTestIndexDB()
+	for _, slot := range []uint64{2, 3, 6, 9} {
+		for _, index := range []uint64{1, 0} {
+			sc := &types.BlobSidecar{
+				Index: index,
+				BeaconBlockHeader: &ctypes.BeaconBlockHeader{
+					BeaconBlockHeaderBase: ctypes.BeaconBlockHeaderBase{
+						Slot: slot,
+					},
+				},
+				InclusionProof: make([][32]byte, 8),
+			}
+			bz, err := sc.MarshalSSZ()
+			require.NoError(t, err)
+			require.NoError(t, db.Set(slot, []byte{byte(index)}, bz))
+		}
+	}
+
+	s := store.New(
+		db, noop.NewLogger(), nil,
+		store.WithMaxRangeSlots[*testBeaconBlockBody](5),
+	)
+
+	// The gaps at slots 4 and 5 are skipped and the range is capped to
+	// five slots, so slot 9 is not returned.
+	result, err := s.GetSidecarsRange(
+		context.Background(), math.Slot(2), math.Slot(10),
+	)
+	require.NoError(t, err)
+	require.Len(t, result, 3)
+	for _, slot := range []math.Slot{2, 3, 6} {
+		sidecars, ok := result[slot]
+		require.True(t, ok, "slot %d", slot)
+		require.Equal(t, 2, sidecars.Len())
+		for i, sc := range sidecars.Sidecars {
+			require.Equal(t, uint64(i), sc.Index)
+			require.Equal(t, slot, sc.BeaconBlockHeader.GetSlot())
+		}
+	}
+
+	result, err = s.GetSidecarsRange(
+		context.Background(), math.Slot(4), math.Slot(5),
+	)
+	require.NoError(t, err)
+	require.Empty(t, result)
+}
+
+func TestStoreGetSidecarsRangeNotRangeable(t *testing.T) {
+	s := store.New[*testBeaconBlockBody](
+		plainIndexDB{newTestIndexDB()}, noop.NewLogger(), nil,
+	)
+	_, err := s.GetSidecarsRange(
+		context.Background(), math.Slot(0), math.Slot(1),
+	)
+	require.ErrorIs(t, err, store.ErrIndexDBNotRangeable)
+}
+
+// failingIndexDB is a testIndexDB that fails to be read.
+type failingIndexDB struct {
+	*testIndexDB
+}
+
+func (failingIndexDB) Has(uint64, []byte) (bool, error) {
+	return false, errors.New("replica unavailable")
+}
+
+func (failingIndexDB) Get(uint64, []byte) ([]byte, error) {
+	return nil, errors.New("replica unavailable")
+}
+
+func (failingIndexDB) GetRange(uint64, uint64) (map[uint64][][]byte, error) {
+	return nil, errors.New("replica unavailable")
+}
+
+// setTestSidecar stores a sidecar with the given index at the given slot.
+func setTestSidecar(t *testing.T, db store.IndexDB, slot, index uint64) {
+	t.Helper()
+	sc := &types.BlobSidecar{
+		Index: index,
+		BeaconBlockHeader: &ctypes.BeaconBlockHeader{
+			BeaconBlockHeaderBase: ctypes.BeaconBlockHeaderBase{Slot: slot},
+		},
+		InclusionProof: make([][32]byte, 8),
+	}
+	bz, err := sc.MarshalSSZ()
+	require.NoError(t, err)
+	require.NoError(t, db.Set(slot, []byte{byte(index)}, bz))
+}
+
+func TestStoreReadReplica(t *testing.T) {
+	primary, replica := newTestIndexDB(), newTestIndexDB()
+	setTestSidecar(t, primary, 1, 0)
+	setTestSidecar(t, primary, 2, 0)
+	// The replica has caught up with slot 1 and additionally stores a second
+	// sidecar for it that only it can serve.
+	setTestSidecar(t, replica, 1, 0)
+	setTestSidecar(t, replica, 1, 1)
+
+	s := store.New(
+		primary, noop.NewLogger(), nil,
+		store.WithReadReplica[*testBeaconBlockBody](replica),
+	)
+
+	// Slot 1 is read from the replica.
+	result, err := s.GetSidecarsRange(
+		context.Background(), math.Slot(1), math.Slot(1),
+	)
+	require.NoError(t, err)
+	require.Equal(t, 2, result[1].Len())
+
+	// The replica lacks slot 2, so only that slot is read from the primary.
+	result, err = s.GetSidecarsRange(
+		context.Background(), math.Slot(1), math.Slot(2),
+	)
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	require.Equal(t, 2, result[1].Len())
+	require.Equal(t, 1, result[2].Len())
+
+	// Single sidecars are read from the replica, falling back to the primary
+	// if the replica lacks them.
+	bz, err := s.Get(1, []byte{1})
+	require.NoError(t, err)
+	require.NotEmpty(t, bz)
+	bz, err = s.Get(2, []byte{0})
+	require.NoError(t, err)
+	require.NotEmpty(t, bz)
+	_, err = s.Get(3, []byte{0})
+	require.Error(t, err)
+}
+
+func TestStoreReadReplicaSparseRange(t *testing.T) {
+	primary, replica := newTestIndexDB(), newTestIndexDB()
+	for _, slot := range []uint64{2, 5, 9} {
+		setTestSidecar(t, primary, slot, 0)
+	}
+	// The replica has caught up with slots 2 and 5, which it serves with a
+	// second sidecar only it stores, but not with slot 9.
+	for _, slot := range []uint64{2, 5} {
+		setTestSidecar(t, replica, slot, 0)
+		setTestSidecar(t, replica, slot, 1)
+	}
+
+	s := store.New(
+		primary, noop.NewLogger(), nil,
+		store.WithReadReplica[*testBeaconBlockBody](replica),
+	)
+	result, err := s.GetSidecarsRange(
+		context.Background(), math.Slot(0), math.Slot(10),
+	)
+	require.NoError(t, err)
+	require.Len(t, result, 3)
+	require.Equal(t, 2, result[2].Len())
+	require.Equal(t, 2, result[5].Len())
+	require.Equal(t, 1, result[9].Len())
+}
+
+func TestStoreReadReplicaFailure(t *testing.T) {
+	primary := newTestIndexDB()
+	setTestSidecar(t, primary, 1, 0)
+
+	s := store.New(
+		primary, noop.NewLogger(), nil,
+		store.WithReadReplica[*testBeaconBlockBody](
+			failingIndexDB{newTestIndexDB()},
+		),
+	)
+	result, err := s.GetSidecarsRange(
+		context.Background(), math.Slot(1), math.Slot(1),
+	)
+	require.NoError(t, err)
+	require.Equal(t, 1, result[1].Len())
+	bz, err := s.Get(1, []byte{0})
+	require.NoError(t, err)
+	require.NotEmpty(t, bz)
+}
+
+// committedBody is a beacon block body with the given commitments.
+type committedBody testCommitments
+
+func (b committedBody) GetBlobKzgCommitments() testCommitments {
+	return testCommitments(b)
+}
+
+func TestStoreReadReplicaDataAvailability(t *testing.T) {
+	body := committedBody{{1}, {2}}
+	primary, replica := newTestIndexDB(), newTestIndexDB()
+	for _, c := range body {
+		require.NoError(t, primary.Set(1, c[:], []byte{1}))
+	}
+	// The replica lags behind and only has the first blob.
+	require.NoError(t, replica.Set(1, body[0][:], []byte{1}))
+
+	for _, r := range []store.RangeIndexDB{
+		replica, failingIndexDB{replica},
+	} {
+		s := store.New(
+			primary, noop.NewLogger(), nil,
+			store.WithReadReplica[committedBody](r),
+		)
+		require.True(t, s.IsDataAvailable(context.Background(), 1, body))
+		require.False(t, s.IsDataAvailable(context.Background(), 2, body))
+	}
+}
+
+func TestStorePersistRejectsInvalidSidecars(t *testing.T) {
+	db := newTestIndexDB()
+	cs := chain.NewChainSpec(chain.SpecData[
+		common.DomainType, math.Epoch,
+		common.ExecutionAddress, math.Slot, any,
+	]{
+		MaxBlobsPerBlock: 2,
+		BytesPerBlob:     uint64(len(eip4844.Blob{})),
+	})
+	s := store.New[*testBeaconBlockBody](db, noop.NewLogger(), cs)
+
+	sidecars := make([]*types.BlobSidecar, 3)
+	for i := range sidecars {
+		sidecars[i] = &types.BlobSidecar{
+			Index:             uint64(i),
+			KzgCommitment:     eip4844.KZGCommitment{byte(i)},
+			BeaconBlockHeader: &ctypes.BeaconBlockHeader{},
+			InclusionProof:    make([][32]byte, 8),
+		}
+	}
+	err := s.Persist(1, &types.BlobSidecars{Sidecars: sidecars})
+	require.ErrorIs(t, err, types.ErrTooManySidecars)
+	require.Empty(t, db.data)
+}
+
+// testMaxBlobCommitments is the maximum number of KZG commitments per block
+// of the chain spec of the store returned by newTestPersistStore.
+const testMaxBlobCommitments = 16
+
+// newTestSidecars returns sidecars included at slot 1 with the given KZG
+// commitments, indexed in order. The inclusion proof of each sidecar is
+// valid for the body root of its block header.
+func newTestSidecars(commitments ...byte) *types.BlobSidecars {
+	sidecars := make([]*types.BlobSidecar, len(commitments))
+	for i, commitment := range commitments {
+		sidecars[i] = &types.BlobSidecar{
+			Index:         uint64(i),
+			KzgCommitment: eip4844.KZGCommitment{commitment},
+			BeaconBlockHeader: &ctypes.BeaconBlockHeader{
+				BeaconBlockHeaderBase: ctypes.BeaconBlockHeaderBase{
+					Slot: 1,
+				},
+			},
+			InclusionProof: make([][32]byte, 8),
+		}
+		for j := range sidecars[i].InclusionProof {
+			sidecars[i].InclusionProof[j] = [32]byte{byte(j + 1)}
+		}
+		leaf, err := sidecars[i].KzgCommitment.HashTreeRoot()
+		if err != nil {
+			panic(err)
+		}
+		sidecars[i].BeaconBlockHeader.BodyRoot = merkle.RootFromBranch(
+			leaf, sidecars[i].InclusionProof, 8,
+			ctypes.KZGMerkleIndexDeneb*testMaxBlobCommitments+uint64(i),
+		)
+	}
+	return &types.BlobSidecars{Sidecars: sidecars}
+}
+
+// newTestPersistStore returns a store that accepts up to two sidecars per
+// block and keeps them for the whole test.
+func newTestPersistStore(
+	db store.IndexDB,
+) *store.Store[*testBeaconBlockBody] {
+	cs := chain.NewChainSpec(chain.SpecData[
+		common.DomainType, math.Epoch,
+		common.ExecutionAddress, math.Slot, any,
+	]{
+		SlotsPerEpoch:                    32,
+		ElectraForkEpoch:                 math.Epoch(^uint64(0)),
+		MinEpochsForBlobsSidecarsRequest: 1,
+		MaxBlobCommitmentsPerBlock:       testMaxBlobCommitments,
+		MaxBlobsPerBlock:                 2,
+		BytesPerBlob:                     uint64(len(eip4844.Blob{})),
+	})
+	return store.New[*testBeaconBlockBody](db, noop.NewLogger(), cs)
+}
+
+func TestStorePersistIdempotent(t *testing.T) {
+	db := newTestIndexDB()
+	s := newTestPersistStore(db)
+
+	require.NoError(t, s.Persist(1, newTestSidecars(1, 2)))
+	require.Len(t, db.data[1], 2)
+
+	// Re-writing the same sidecars does not duplicate them.
+	require.NoError(t, s.Persist(1, newTestSidecars(1, 2)))
+	require.Len(t, db.data[1], 2)
+}
+
+func TestStorePersistConflicting(t *testing.T) {
+	db := newTestIndexDB()
+	s := newTestPersistStore(db)
+
+	require.NoError(t, s.Persist(1, newTestSidecars(1)))
+	stored := db.data[1]
+
+	// The sidecar at index 0 has a different commitment, so the new sidecar
+	// at index 1 is not written either.
+	err := s.Persist(1, newTestSidecars(3, 4))
+	require.ErrorIs(t, err, store.ErrConflictingSidecar)
+	require.Equal(t, stored, db.data[1])
+}
+
+func TestStorePersistInvalidInclusionProof(t *testing.T) {
+	db := newTestIndexDB()
+	s := newTestPersistStore(db)
+
+	// The proof of the blob at index 1 no longer ties its commitment to the
+	// body root of its block, so neither of the blobs is written.
+	sidecars := newTestSidecars(1, 2)
+	sidecars.Sidecars[1].InclusionProof[3][0] ^= 0xff
+	err := s.Persist(1, sidecars)
+	require.ErrorIs(t, err, types.ErrInvalidInclusionProof)
+	require.ErrorContains(t, err, "blob index 1")
+	require.Empty(t, db.data)
+}
+
+func TestStoreCompaction(t *testing.T) {
+	const interval = 20 * time.Millisecond
+	db := &compactingIndexDB{testIndexDB: newTestIndexDB()}
+	s := store.New[*testBeaconBlockBody](
+		db, noop.NewLogger(), nil,
+		store.WithCompactionInterval[*testBeaconBlockBody](interval),
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	start := time.Now()
+	s.StartCompaction(ctx)
+	require.Eventually(t, func() bool {
+		return db.compactions.Load() >= 3
+	}, time.Second, time.Millisecond)
+	require.GreaterOrEqual(t, time.Since(start), 3*interval)
+
+	// No compaction happens once the context is cancelled.
+	cancel()
+	time.Sleep(interval)
+	compactions := db.compactions.Load()
+	time.Sleep(3 * interval)
+	require.Equal(t, compactions, db.compactions.Load())
+}
+
+func TestStoreCompactionDisabled(t *testing.T) {
+	db := &compactingIndexDB{testIndexDB: newTestIndexDB()}
+	s := store.New[*testBeaconBlockBody](
+		db, noop.NewLogger(), nil,
+		store.WithCompactionInterval[*testBeaconBlockBody](0),
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s.StartCompaction(ctx)
+	time.Sleep(50 * time.Millisecond)
+	require.Zero(t, db.compactions.Load())
+}
+
+func TestStoreCompactionSkippedWhilePruning(t *testing.T) {
+	const interval = 10 * time.Millisecond
+	db := &compactingIndexDB{
+		testIndexDB:  newTestIndexDB(),
+		pruneStarted: make(chan struct{}),
+		unblockPrune: make(chan struct{}),
+	}
+	s := store.New[*testBeaconBlockBody](
+		db, noop.NewLogger(), nil,
+		store.WithCompactionInterval[*testBeaconBlockBody](interval),
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s.StartCompaction(ctx)
+
+	pruned := make(chan error)
+	go func() {
+		_, err := s.Prune(context.Background(), math.Slot(1))
+		pruned <- err
+	}()
+	<-db.pruneStarted
+
+	// Wait for a compaction that may have started before the prune.
+	time.Sleep(interval)
+	compactions := db.compactions.Load()
+	time.Sleep(5 * interval)
+	require.Equal(t, compactions, db.compactions.Load())
+
+	close(db.unblockPrune)
+	require.NoError(t, <-pruned)
+	require.Eventually(t, func() bool {
+		return db.compactions.Load() > compactions
+	}, time.Second, time.Millisecond)
+}
+
+func TestStoreExportImport(t *testing.T) {
+	ctx := context.Background()
+	src := newTestPersistStore(newTestIndexDB())
+	require.NoError(t, src.Persist(1, newTestSidecars(1, 2)))
+	require.NoError(t, src.Persist(3, newTestSidecars(3)))
+
+	archive := &bytes.Buffer{}
+	require.NoError(t, src.Export(ctx, archive))
+
+	db := newTestIndexDB()
+	dst := newTestPersistStore(db)
+	require.NoError(t, dst.Import(ctx, bytes.NewReader(archive.Bytes())))
+
+	want, err := src.GetSidecarsRange(ctx, 0, 10)
+	require.NoError(t, err)
+	got, err := dst.GetSidecarsRange(ctx, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	require.Equal(t, want, got)
+
+	// Archives are only imported into empty stores.
+	err = dst.Import(ctx, bytes.NewReader(archive.Bytes()))
+	require.ErrorIs(t, err, store.ErrStoreNotEmpty)
+}
+
+func TestStoreImportInvalidArchive(t *testing.T) {
+	ctx := context.Background()
+	src := newTestPersistStore(newTestIndexDB())
+	require.NoError(t, src.Persist(1, newTestSidecars(1, 2)))
+	archive := &bytes.Buffer{}
+	require.NoError(t, src.Export(ctx, archive))
+
+	for name, bz := range map[string][]byte{
+		"bad checksum": rewriteArchive(t, archive.Bytes(), "00"),
+		"no checksum":  rewriteArchive(t, archive.Bytes(), ""),
+		"truncated":    archive.Bytes()[:archive.Len()/2],
+	} {
+		db := newTestIndexDB()
+		err := newTestPersistStore(db).Import(ctx, bytes.NewReader(bz))
+		require.ErrorIs(t, err, store.ErrInvalidArchive, name)
+		// The sidecars imported before the archive was found to be invalid
+		// are removed again.
+		require.Empty(t, db.data, name)
+	}
+
+	err := newTestPersistStore(plainIndexDB{newTestIndexDB()}).Import(
+		ctx, bytes.NewReader(archive.Bytes()),
+	)
+	require.ErrorIs(t, err, store.ErrIndexDBNotArchivable)
+}
+
+// rewriteArchive returns the given archive with its checksum replaced, or
+// removed if checksum is empty.
+func rewriteArchive(t *testing.T, archive []byte, checksum string) []byte {
+	t.Helper()
+	gr, err := gzip.NewReader(bytes.NewReader(archive))
+	require.NoError(t, err)
+	tr := tar.NewReader(gr)
+
+	out := &bytes.Buffer{}
+	gw := gzip.NewWriter(out)
+	tw := tar.NewWriter(gw)
+	for {
+		hdr, nextErr := tr.Next()
+		if nextErr == io.EOF {
+			break
+		}
+		require.NoError(t, nextErr)
+		content, readErr := io.ReadAll(tr)
+		require.NoError(t, readErr)
+		if hdr.Name == "checksum.sha256" {
+			if checksum == "" {
+				continue
+			}
+			content = []byte(checksum)
+			hdr.Size = int64(len(content))
+		}
+		require.NoError(t, tw.WriteHeader(hdr))
+		_, err = tw.Write(content)
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gw.Close())
+	return out.Bytes()
+}
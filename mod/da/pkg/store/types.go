@@ -42,6 +42,45 @@ type IndexDB interface {
 	Set(index uint64, key []byte, value []byte) error
 }
 
+// PrunableIndexDB is an IndexDB that supports removing ranges of indexes.
+type PrunableIndexDB interface {
+	IndexDB
+	// CountRange returns the number of values stored in [from, to).
+	CountRange(from, to uint64) (int, error)
+	// Prune removes all values stored in [from, to).
+	Prune(from, to uint64) error
+}
+
+// ReadableIndexDB is an IndexDB that supports reading single values.
+type ReadableIndexDB interface {
+	IndexDB
+	// Get returns the value of the key at the index.
+	Get(index uint64, key []byte) ([]byte, error)
+}
+
+// RangeIndexDB is an IndexDB that supports reading ranges of indexes.
+type RangeIndexDB interface {
+	IndexDB
+	// GetRange returns all values stored in [from, to), keyed by index.
+	GetRange(from, to uint64) (map[uint64][][]byte, error)
+}
+
+// ArchivableIndexDB is an IndexDB that lists the indexes it stores values
+// for, such that all of its values can be exported.
+type ArchivableIndexDB interface {
+	RangeIndexDB
+	PrunableIndexDB
+	// Indexes returns the indexes values are stored for, in ascending order.
+	Indexes() ([]uint64, error)
+}
+
+// CompactableIndexDB is an IndexDB that benefits from periodic compaction.
+type CompactableIndexDB interface {
+	IndexDB
+	// Compact compacts the underlying storage of the database.
+	Compact() error
+}
+
 // BeaconBlockBody is the body of a beacon block.
 type BeaconBlockBody interface {
 	// GetBlobKzgCommitments returns the KZG commitments for the blob.
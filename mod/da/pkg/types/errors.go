@@ -38,4 +38,17 @@ var (
 	// inclusion.
 	ErrInvalidInclusionProof = errors.New(
 		"invalid KZG commitment inclusion proof")
+
+	// ErrTooManySidecars is returned when a set of sidecars exceeds the
+	// maximum number of blobs per block.
+	ErrTooManySidecars = errors.New("too many blob sidecars")
+
+	// ErrBlobTooLarge is returned when the blob of a sidecar exceeds the
+	// number of bytes per blob.
+	ErrBlobTooLarge = errors.New("blob exceeds the bytes per blob")
+
+	// ErrInconsistentCommitments is returned when the KZG commitments or the
+	// indices of a set of sidecars do not match its number of sidecars.
+	ErrInconsistentCommitments = errors.New(
+		"inconsistent KZG commitments in blob sidecars")
 )
@@ -22,6 +22,7 @@ package types
 
 import (
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle"
@@ -74,16 +75,29 @@ func BuildBlobSidecar(
 func (b *BlobSidecar) HasValidInclusionProof(
 	kzgOffset uint64,
 ) bool {
+	return b.VerifyInclusionProof(
+		b.BeaconBlockHeader.BodyRoot, kzgOffset,
+	) == nil
+}
+
+// VerifyInclusionProof verifies that the KZG commitment of the blob is
+// included in the block body with the given root, where the KZG commitments
+// of the block body start at the given offset. It returns
+// ErrInvalidInclusionProof naming the index of the blob if it is not.
+func (b *BlobSidecar) VerifyInclusionProof(
+	blockBodyRoot [32]byte,
+	kzgOffset uint64,
+) error {
 	// Calculate the hash tree root of the KZG commitment.
 	leaf, err := b.KzgCommitment.HashTreeRoot()
 	if err != nil {
-		return false
+		return err
 	}
 
 	gIndex := kzgOffset + b.Index
 
 	// Verify the inclusion proof.
-	return merkle.IsValidMerkleBranch(
+	if !merkle.IsValidMerkleBranch(
 		leaf,
 		b.InclusionProof,
 		//#nosec:G701 // safe.
@@ -91,6 +105,11 @@ func (b *BlobSidecar) HasValidInclusionProof(
 			len(b.InclusionProof),
 		), // TODO: use KZG_INCLUSION_PROOF_DEPTH calculation.
 		gIndex,
-		b.BeaconBlockHeader.BodyRoot,
-	)
+		blockBodyRoot,
+	) {
+		return errors.Wrapf(
+			ErrInvalidInclusionProof, "blob index %d", b.Index,
+		)
+	}
+	return nil
 }
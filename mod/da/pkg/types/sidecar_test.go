@@ -27,6 +27,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/da/pkg/types"
 	byteslib "github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -73,3 +74,34 @@ func TestSidecarMarshalling(t *testing.T) {
 		"The original and unmarshalled sidecars should be equal",
 	)
 }
+
+func TestSidecarVerifyInclusionProof(t *testing.T) {
+	const kzgOffset = 26 * 16
+	sidecar := types.BlobSidecar{
+		Index:             2,
+		KzgCommitment:     eip4844.KZGCommitment{1, 2, 3},
+		BeaconBlockHeader: &ctypes.BeaconBlockHeader{},
+		InclusionProof:    make([][32]byte, 8),
+	}
+	for i := range sidecar.InclusionProof {
+		sidecar.InclusionProof[i] = byteslib.ToBytes32([]byte{byte(i)})
+	}
+	leaf, err := sidecar.KzgCommitment.HashTreeRoot()
+	require.NoError(t, err)
+	bodyRoot := merkle.RootFromBranch(
+		leaf, sidecar.InclusionProof, 8, kzgOffset+sidecar.Index,
+	)
+
+	require.NoError(t, sidecar.VerifyInclusionProof(bodyRoot, kzgOffset))
+
+	// The proof does not hold for another block body.
+	err = sidecar.VerifyInclusionProof([32]byte{1}, kzgOffset)
+	require.ErrorIs(t, err, types.ErrInvalidInclusionProof)
+	require.ErrorContains(t, err, "blob index 2")
+
+	// Nor does a corrupted proof hold for the block body.
+	sidecar.InclusionProof[5][31] ^= 0x01
+	err = sidecar.VerifyInclusionProof(bodyRoot, kzgOffset)
+	require.ErrorIs(t, err, types.ErrInvalidInclusionProof)
+	require.ErrorContains(t, err, "blob index 2")
+}
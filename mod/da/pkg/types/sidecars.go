@@ -22,6 +22,8 @@ package types
 
 import (
 	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 	"github.com/sourcegraph/conc/iter"
 )
 
@@ -63,6 +65,55 @@ func (bs *BlobSidecars) ValidateBlockRoots() error {
 	return nil
 }
 
+// Validate ensures that the sidecars are within the limits of the given chain
+// spec. The number of sidecars must not exceed the maximum number of blobs per
+// block, no blob may exceed the number of bytes per blob, and every sidecar
+// must have a distinct index within the block and a distinct KZG commitment.
+func (bs *BlobSidecars) Validate(cs primitives.ChainSpec) error {
+	maxBlobs := cs.MaxBlobsPerBlock()
+	if uint64(bs.Len()) > maxBlobs {
+		return errors.Wrapf(
+			ErrTooManySidecars, "got %d sidecars, the limit is %d",
+			bs.Len(), maxBlobs,
+		)
+	}
+
+	var (
+		indices     = make(map[uint64]struct{}, bs.Len())
+		commitments = make(map[eip4844.KZGCommitment]struct{}, bs.Len())
+	)
+	for _, sc := range bs.Sidecars {
+		if sc == nil {
+			return ErrAttemptedToVerifyNilSidecar
+		}
+		if size := uint64(len(sc.Blob)); size > cs.BytesPerBlob() {
+			return errors.Wrapf(
+				ErrBlobTooLarge, "blob %d has %d bytes, the limit is %d",
+				sc.Index, size, cs.BytesPerBlob(),
+			)
+		}
+		if sc.Index >= maxBlobs {
+			return errors.Wrapf(
+				ErrInconsistentCommitments,
+				"sidecar index %d is out of range for %d blobs per block",
+				sc.Index, maxBlobs,
+			)
+		}
+		indices[sc.Index] = struct{}{}
+		commitments[sc.KzgCommitment] = struct{}{}
+	}
+
+	if len(indices) != bs.Len() || len(commitments) != bs.Len() {
+		return errors.Wrapf(
+			ErrInconsistentCommitments,
+			"got %d distinct indices and %d distinct commitments "+
+				"for %d sidecars",
+			len(indices), len(commitments), bs.Len(),
+		)
+	}
+	return nil
+}
+
 // VerifyInclusionProofs verifies the inclusion proofs for all sidecars.
 func (bs *BlobSidecars) VerifyInclusionProofs(
 	kzgOffset uint64,
@@ -76,15 +127,32 @@ func (bs *BlobSidecars) VerifyInclusionProofs(
 			}
 
 			// Verify the KZG inclusion proof.
-			if !sc.HasValidInclusionProof(kzgOffset) {
-				return ErrInvalidInclusionProof
-			}
-			return nil
+			return sc.VerifyInclusionProof(
+				sc.BeaconBlockHeader.BodyRoot, kzgOffset,
+			)
 		},
 	)...)
 }
 
 // Len returns the number of sidecars in the sidecar.
 func (bs *BlobSidecars) Len() int {
+	if bs == nil {
+		return 0
+	}
 	return len(bs.Sidecars)
 }
+
+// TotalBytes returns the total ssz encoded size in bytes of all the
+// sidecars, skipping any nil entries.
+func (bs *BlobSidecars) TotalBytes() int {
+	if bs == nil {
+		return 0
+	}
+	var total int
+	for _, sc := range bs.Sidecars {
+		if sc != nil {
+			total += sc.SizeSSZ()
+		}
+	}
+	return total
+}
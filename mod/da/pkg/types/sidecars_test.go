@@ -25,8 +25,12 @@ import (
 
 	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	"github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives"
 	byteslib "github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/stretchr/testify/require"
 )
 
@@ -147,3 +151,135 @@ func TestValidateBlockRoots(t *testing.T) {
 		"Validating sidecar with invalid roots should produce an error",
 	)
 }
+
+func TestBlobSidecarsLenAndTotalBytes(t *testing.T) {
+	sidecarSize := (&types.BlobSidecar{}).SizeSSZ()
+	newSidecars := func(n int) *types.BlobSidecars {
+		sidecars := make([]*types.BlobSidecar, n)
+		for i := range sidecars {
+			sidecars[i] = &types.BlobSidecar{
+				BeaconBlockHeader: &ctypes.BeaconBlockHeader{},
+			}
+		}
+		return &types.BlobSidecars{Sidecars: sidecars}
+	}
+
+	tests := []struct {
+		name          string
+		sidecars      *types.BlobSidecars
+		expectedLen   int
+		expectedBytes int
+	}{
+		{
+			name:          "nil",
+			sidecars:      nil,
+			expectedLen:   0,
+			expectedBytes: 0,
+		},
+		{
+			name:          "empty",
+			sidecars:      &types.BlobSidecars{},
+			expectedLen:   0,
+			expectedBytes: 0,
+		},
+		{
+			name:          "single",
+			sidecars:      newSidecars(1),
+			expectedLen:   1,
+			expectedBytes: sidecarSize,
+		},
+		{
+			name:          "max blob count",
+			sidecars:      newSidecars(6),
+			expectedLen:   6,
+			expectedBytes: 6 * sidecarSize,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expectedLen, tt.sidecars.Len())
+			require.Equal(t, tt.expectedBytes, tt.sidecars.TotalBytes())
+		})
+	}
+}
+
+func TestBlobSidecarsValidate(t *testing.T) {
+	newSpec := func(bytesPerBlob uint64) primitives.ChainSpec {
+		return chain.NewChainSpec(chain.SpecData[
+			common.DomainType, math.Epoch,
+			common.ExecutionAddress, math.Slot, any,
+		]{
+			MaxBlobsPerBlock: 2,
+			BytesPerBlob:     bytesPerBlob,
+		})
+	}
+	newSidecar := func(index uint64, commitment byte) *types.BlobSidecar {
+		return &types.BlobSidecar{
+			Index:             index,
+			KzgCommitment:     eip4844.KZGCommitment{commitment},
+			BeaconBlockHeader: &ctypes.BeaconBlockHeader{},
+		}
+	}
+
+	tests := []struct {
+		name         string
+		sidecars     []*types.BlobSidecar
+		bytesPerBlob uint64
+		expectedErr  error
+	}{
+		{
+			name:     "valid",
+			sidecars: []*types.BlobSidecar{newSidecar(0, 1), newSidecar(1, 2)},
+		},
+		{
+			name: "over the blob limit",
+			sidecars: []*types.BlobSidecar{
+				newSidecar(0, 1), newSidecar(1, 2), newSidecar(2, 3),
+			},
+			expectedErr: types.ErrTooManySidecars,
+		},
+		{
+			name:         "blob too large",
+			sidecars:     []*types.BlobSidecar{newSidecar(0, 1)},
+			bytesPerBlob: 1024,
+			expectedErr:  types.ErrBlobTooLarge,
+		},
+		{
+			name:        "nil sidecar",
+			sidecars:    []*types.BlobSidecar{newSidecar(0, 1), nil},
+			expectedErr: types.ErrAttemptedToVerifyNilSidecar,
+		},
+		{
+			name:        "duplicate commitment",
+			sidecars:    []*types.BlobSidecar{newSidecar(0, 1), newSidecar(1, 1)},
+			expectedErr: types.ErrInconsistentCommitments,
+		},
+		{
+			name:        "duplicate index",
+			sidecars:    []*types.BlobSidecar{newSidecar(1, 1), newSidecar(1, 2)},
+			expectedErr: types.ErrInconsistentCommitments,
+		},
+		{
+			name:        "index out of range",
+			sidecars:    []*types.BlobSidecar{newSidecar(2, 1)},
+			expectedErr: types.ErrInconsistentCommitments,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bytesPerBlob := tt.bytesPerBlob
+			if bytesPerBlob == 0 {
+				bytesPerBlob = uint64(len(eip4844.Blob{}))
+			}
+			sidecars := &types.BlobSidecars{Sidecars: tt.sidecars}
+			err := sidecars.Validate(newSpec(bytesPerBlob))
+			if tt.expectedErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
@@ -42,6 +42,8 @@ var (
 // functions, as object capabilities aren't needed for testing.
 type BeaconApp struct {
 	*runtime.App
+	// beaconModule is the module running the beacon chain.
+	beaconModule beacon.AppModule
 }
 
 // NewBeaconKitApp returns a reference to an initialized BeaconApp.
@@ -77,6 +79,7 @@ func (app *BeaconApp) setupBeaconModule() {
 	if !ok {
 		panic("beacon module not found")
 	}
+	app.beaconModule = beaconModule
 
 	// Set the beacon module's handlers.
 	app.SetPrepareProposal(
@@ -97,3 +100,9 @@ func (app *BeaconApp) setupBeaconModule() {
 		panic(err)
 	}
 }
+
+// Shutdown releases the blocks held while block processing is paused, once
+// the node shuts down and before the consensus engine is stopped.
+func (app *BeaconApp) Shutdown() {
+	app.beaconModule.Shutdown()
+}
@@ -21,28 +21,58 @@
 package builder
 
 import (
+	"cmp"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
 	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
 
-	"cosmossdk.io/client/v2/autocli"
+	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
 	"github.com/berachain/beacon-kit/mod/beacon/blockchain"
 	cmdlib "github.com/berachain/beacon-kit/mod/cli/pkg/commands"
+	configcmd "github.com/berachain/beacon-kit/mod/cli/pkg/commands/config"
+	genesiscmd "github.com/berachain/beacon-kit/mod/cli/pkg/commands/genesis"
 	consensustypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	dablob "github.com/berachain/beacon-kit/mod/da/pkg/blob"
 	dastore "github.com/berachain/beacon-kit/mod/da/pkg/store"
-	datypes "github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/config/flags"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/config/spec"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/node"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/services/admin"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/services/health"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/types"
 	"github.com/berachain/beacon-kit/mod/primitives"
-	"github.com/berachain/beacon-kit/mod/runtime/pkg/runtime"
-	depositdb "github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	pversion "github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/p2p"
+	cmttypes "github.com/cometbft/cometbft/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/config"
+	sdkflags "github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type NodeBuilder[NodeT types.NodeI] struct {
@@ -52,40 +82,825 @@ type NodeBuilder[NodeT types.NodeI] struct {
 	description  string
 	depInjectCfg depinject.Config
 
+	// logger is the logger used by the node, if unset the node logs to
+	// stdout.
+	logger log.Logger
+	// logFormat is the format of the logs of the node, either json or text,
+	// if unset the logs are written as text.
+	logFormat string
+	// viper is the viper instance used by the node, if unset the global
+	// viper instance is used.
+	viper *viper.Viper
+
+	// appConfig and appConfigTemplate are used to populate the app.toml
+	// of the node.
+	appConfig         any
+	appConfigTemplate string
+	// cometConfig is the template used to populate the config.toml of the
+	// node, it is copied before use such that it is never mutated.
+	cometConfig *cmtcfg.Config
+	// chainSpec is the chain spec of the node, if unset it is provided by
+	// components.ProvideChainSpec.
+	chainSpec primitives.ChainSpec
+	// healthServerAddr is the address the health server listens on, the
+	// health server is disabled if it is empty.
+	healthServerAddr string
+	// adminSocketPath is the path of the unix socket the admin server
+	// listens on, the admin server is disabled if it is empty.
+	adminSocketPath string
+	// keyringBackend is the backend of the keyring of the node, if unset the
+	// backend of the client context is used.
+	keyringBackend string
+	// inMemoryStores is set if the stores of the node are kept in memory.
+	inMemoryStores bool
+	// readOnly is set if the node only syncs and serves queries, without
+	// ever proposing blocks.
+	readOnly bool
+	// skipBlobVerification is set if the KZG proofs of blob sidecars are not
+	// verified before they are stored.
+	skipBlobVerification bool
+	// pprofAddr is the address the pprof server listens on, the pprof server
+	// is disabled if it is empty.
+	pprofAddr string
+	// skipStartupBanner is set if the banner summarizing the node is not
+	// logged when the node starts.
+	skipStartupBanner bool
+	// daCompactionInterval is the interval at which the availability store
+	// is compacted, if unset dastore.DefaultCompactionInterval is used.
+	daCompactionInterval *time.Duration
+	// daReadReplica is the replica of the database of the availability
+	// store that sidecars are read from, if unset they are read from the
+	// database itself.
+	daReadReplica dastore.RangeIndexDB
+	// shutdownTimeout is the time the node waits for its closers to finish
+	// once it shuts down, if unset node.DefaultShutdownTimeout is used.
+	shutdownTimeout *time.Duration
+	// rootCtx is the parent context the node is run with, if unset the node
+	// is only shut down on OS signals.
+	rootCtx context.Context
+	// buildTimeout is the time the dependencies of the node are given to be
+	// resolved, if unset DefaultBuildTimeout is used.
+	buildTimeout *time.Duration
+	// rootResolution is the time spent resolving the dependencies of the
+	// root command, it is recorded through the telemetry of the node once
+	// the application is created.
+	rootResolution time.Duration
+	// telemetry is the config of the sink the node pushes its metrics to, if
+	// unset metrics are emitted through the global telemetry of the SDK.
+	telemetry *TelemetryConfig
+	// prometheusListenAddr is the address CometBFT serves its Prometheus
+	// metrics on, alongside the metrics of the node, if unset the address
+	// set in the config of the node is used.
+	prometheusListenAddr string
+	// tracerProvider traces the processing of blocks by the node, if unset
+	// the processing of blocks is not traced.
+	tracerProvider trace.TracerProvider
+	// importConcurrency is the number of workers the blob sidecars of
+	// imported blocks are verified across, if unset GOMAXPROCS is used.
+	importConcurrency *int
+	// importRateLimit is the number of incoming blocks verified per
+	// second, if unset blocks are verified without limit.
+	importRateLimit *int
+	// blobVerifyWorkers is the number of workers the KZG proofs of the blob
+	// sidecars of a block are verified across, if unset half of GOMAXPROCS
+	// is used.
+	blobVerifyWorkers *int
+	// engineEndpoint is the endpoint of the engine API of the execution
+	// client, if unset the endpoint set in the config of the node is used.
+	engineEndpoint *components.EngineEndpoint
+	// proposerTimeout is the time the node waits for the execution payload
+	// of the blocks it proposes, if unset the timeout set in the config of
+	// the node is used.
+	proposerTimeout *time.Duration
+	// stateRetention is the number of slots below the latest one whose
+	// state is retained, if unset the pruning set in the config of the node
+	// is used.
+	stateRetention *uint64
+	// consensusParams override the CometBFT consensus params of the chain
+	// spec, if unset the consensus params of the chain spec are used.
+	consensusParams *cmtproto.ConsensusParams
+	// initialHeight is the height of the first block of the chain, if unset
+	// the chain starts at height 1.
+	initialHeight *int64
+	// genesisFile is the path of the genesis file of the node, if unset the
+	// genesis file in the config directory of the home of the node is used.
+	genesisFile string
+	// minGasPrices are the minimum gas prices the node accepts transactions
+	// at, if unset the minimum gas prices of the app config are used.
+	minGasPrices *string
+	// seeds are the seed nodes of the node, if unset the seeds of the
+	// config.toml of the node are used.
+	seeds []string
+	// persistentPeers are the persistent peers of the node, if unset the
+	// persistent peers of the config.toml of the node are used.
+	persistentPeers []string
+	// daStoreDir is the directory of the availability store, if unset
+	// data/blobs under the home of the node is used.
+	daStoreDir string
+	// depositStoreDir is the directory of the deposit store, if unset data
+	// under the home of the node is used.
+	depositStoreDir string
+	// providerOverrides replace the providers sharing an output type with
+	// them, as described by WithProviderOverride.
+	providerOverrides []any
+	// extraModules are registered with the module manager of the node in
+	// addition to the modules wired by the dependency injection framework.
+	extraModules []module.AppModule
+
 	// components is a list of components to provide.
 	components []any
+
+	// buildOnce ensures the node is only built once, such that repeated
+	// calls to Build return the result of the first one until Reset is
+	// called.
+	buildOnce sync.Once
+	// container is the container of dependencies resolved by the first
+	// build of the node, if it succeeded.
+	container *Container
+	// buildErr is the error the first build of the node failed with, if
+	// any.
+	buildErr error
 }
 
-// New returns a new NodeBuilder.
+// New returns a new NodeBuilder. The name, description, app config and comet
+// config of the node default to DefaultName, DefaultDescription,
+// DefaultAppConfig and DefaultCometConfig respectively.
 func New[NodeT types.NodeI](opts ...Opt[NodeT]) *NodeBuilder[NodeT] {
 	nb := &NodeBuilder[NodeT]{
 		node: node.New[NodeT](),
 	}
+	return nb.With(append([]Opt[NodeT]{
+		WithName[NodeT](DefaultName),
+		WithDescription[NodeT](DefaultDescription),
+		WithAppConfig[NodeT](DefaultAppConfig(), DefaultAppConfigTemplate()),
+		WithCometConfig[NodeT](DefaultCometConfig()),
+	}, opts...)...)
+}
+
+// With applies the given options to the NodeBuilder in addition to the ones
+// it was created with. Options applied after the node was built only take
+// effect once Reset is called.
+func (nb *NodeBuilder[NodeT]) With(opts ...Opt[NodeT]) *NodeBuilder[NodeT] {
 	for _, opt := range opts {
 		opt(nb)
 	}
 	return nb
 }
 
-// Build builds the application.
+// Reset clears the result of the previous build of the node, such that the
+// next call to Build builds a new node with the options of the NodeBuilder,
+// including the ones applied by With since. The node returned by the
+// previous build is left untouched. Reset must not be called concurrently
+// with Build.
+func (nb *NodeBuilder[NodeT]) Reset() {
+	nb.node = node.New[NodeT]()
+	nb.buildOnce = sync.Once{}
+	nb.container = nil
+	nb.buildErr = nil
+}
+
+// Build builds the application. The node is only built by the first call,
+// subsequent calls return the same node and error as the first one without
+// resolving the dependencies of the node again, unless Reset is called in
+// between.
 func (nb *NodeBuilder[NodeT]) Build() (NodeT, error) {
-	rootCmd, err := nb.buildRootCmd()
+	node, _, err := nb.BuildWithContainer()
+	return node, err
+}
+
+// BuildWithContainer builds the application and additionally returns the
+// container of dependencies that were resolved to build its root command.
+// Like Build, it only builds the node once, and subsequent calls to either
+// return the result of the first one.
+func (nb *NodeBuilder[NodeT]) BuildWithContainer() (
+	NodeT, *Container, error,
+) {
+	nb.buildOnce.Do(func() {
+		nb.container, nb.buildErr = nb.build()
+	})
+	return nb.node, nb.container, nb.buildErr
+}
+
+// build builds the root command of the node and returns the container of
+// dependencies that were resolved to build it.
+func (nb *NodeBuilder[NodeT]) build() (*Container, error) {
+	if err := nb.validate(); err != nil {
+		return nil, err
+	}
+
+	rootCmd, container, err := nb.buildRootCmd()
 	if err != nil {
-		return nb.node, err
+		return nil, err
 	}
 
 	nb.node.SetRootCmd(rootCmd)
-	return nb.node, nil
+	nb.configureNode()
+	nb.setNodeInfo(nb.chainSpecName(), container.ChainSpec)
+	return container, nil
 }
 
-// buildRootCmd builds the root command for the application.
-func (nb *NodeBuilder[NodeT]) buildRootCmd() (*cobra.Command, error) {
+// configureNode applies the options of the NodeBuilder that are set on the
+// node itself, such that they are applied anew to the node created by Reset.
+func (nb *NodeBuilder[NodeT]) configureNode() {
+	nb.node.SetAppName(nb.name)
+	nb.node.SetAppDescription(nb.description)
+	if nb.shutdownTimeout != nil {
+		nb.node.SetShutdownTimeout(*nb.shutdownTimeout)
+	}
+	if nb.rootCtx != nil {
+		nb.node.SetRootContext(nb.rootCtx)
+	}
+}
+
+// chainSpecName returns the name of the chain spec the node is built with,
+// or CustomChainSpecName if it was set to an unknown chain spec.
+func (nb *NodeBuilder[NodeT]) chainSpecName() string {
+	if nb.chainSpec == nil {
+		return components.ChainSpecName(nil)
+	}
+	if name, ok := spec.NameOf(nb.chainSpec); ok {
+		return name
+	}
+	return CustomChainSpecName
+}
+
+// setNodeInfo sets the metadata of the node from the builder and the given
+// chain spec.
+func (nb *NodeBuilder[NodeT]) setNodeInfo(
+	specName string,
+	cs primitives.ChainSpec,
+) {
+	nb.node.SetNodeInfo(types.NodeInfo{
+		Name:          nb.name,
+		Version:       version.Version,
+		ChainID:       cs.DepositEth1ChainID(),
+		ChainSpecName: specName,
+	})
+}
+
+// validate ensures that the NodeBuilder is configured such that a valid
+// root command can be built from it.
+func (nb *NodeBuilder[NodeT]) validate() error {
+	if err := nb.validateName(); err != nil {
+		return err
+	}
+	if err := nb.validateLogFormat(); err != nil {
+		return err
+	}
+	if err := nb.validateKeyringBackend(); err != nil {
+		return err
+	}
+	if err := nb.validateDurations(); err != nil {
+		return err
+	}
+	if err := nb.validateConcurrency(); err != nil {
+		return err
+	}
+	if nb.engineEndpoint != nil {
+		if err := nb.engineEndpoint.Validate(); err != nil {
+			return newBuildError(ErrInvalidEngineEndpoint, err)
+		}
+	}
+	if nb.initialHeight != nil && *nb.initialHeight < 1 {
+		return newBuildError(ErrInvalidInitialHeight, errors.Newf(
+			"height %d must be positive", *nb.initialHeight,
+		))
+	}
+	if err := nb.validateGenesisFile(); err != nil {
+		return err
+	}
+	if err := nb.validateConsensusParams(); err != nil {
+		return err
+	}
+	if err := nb.validateStoreDirs(); err != nil {
+		return err
+	}
+	if err := nb.validateMinGasPrices(); err != nil {
+		return err
+	}
+	if err := nb.validatePeers(); err != nil {
+		return err
+	}
+	if err := nb.validatePrometheusListenAddr(); err != nil {
+		return err
+	}
+	if nb.telemetry != nil {
+		if err := nb.telemetry.Validate(); err != nil {
+			return newBuildError(ErrInvalidTelemetryConfig, err)
+		}
+	}
+	if err := nb.validateProviders(); err != nil {
+		return err
+	}
+	return nb.validateChainSpec()
+}
+
+// validateName validates the name set on the builder.
+func (nb *NodeBuilder[NodeT]) validateName() error {
+	if nb.name == "" {
+		return newBuildError(
+			ErrInvalidName, errors.New("name must not be empty"),
+		)
+	}
+	if strings.ContainsFunc(nb.name, unicode.IsSpace) {
+		return newBuildError(ErrInvalidName, errors.Newf(
+			"name %q must not contain whitespace", nb.name,
+		))
+	}
+	return nil
+}
+
+// validateLogFormat validates the log format set on the builder, if any.
+func (nb *NodeBuilder[NodeT]) validateLogFormat() error {
+	switch nb.logFormat {
+	case "", sdkflags.OutputFormatJSON, sdkflags.OutputFormatText:
+		return nil
+	default:
+		return newBuildError(ErrInvalidLogFormat, errors.Newf(
+			"format %q must be either %q or %q", nb.logFormat,
+			sdkflags.OutputFormatJSON, sdkflags.OutputFormatText,
+		))
+	}
+}
+
+// validateKeyringBackend validates the keyring backend set on the builder, if
+// any.
+func (nb *NodeBuilder[NodeT]) validateKeyringBackend() error {
+	if nb.keyringBackend == "" {
+		return nil
+	}
+	if err := components.KeyringBackend(
+		nb.keyringBackend,
+	).Validate(); err != nil {
+		return newBuildError(ErrInvalidKeyringBackend, err)
+	}
+	return nil
+}
+
+// validateMinGasPrices validates the minimum gas prices set on the builder, if
+// any.
+func (nb *NodeBuilder[NodeT]) validateMinGasPrices() error {
+	if nb.minGasPrices == nil {
+		return nil
+	}
+	if _, err := sdk.ParseDecCoins(*nb.minGasPrices); err != nil {
+		return newBuildError(ErrInvalidMinGasPrices, err)
+	}
+	return nil
+}
+
+// validatePeers validates the seeds and persistent peers set on the builder.
+func (nb *NodeBuilder[NodeT]) validatePeers() error {
+	for _, addr := range slices.Concat(nb.seeds, nb.persistentPeers) {
+		if err := validatePeerAddress(addr); err != nil {
+			return newBuildError(ErrInvalidPeerAddress, err)
+		}
+	}
+	return nil
+}
+
+// validatePeerAddress validates that the given address is of the form
+// id@host:port, where id is the hex-encoded ID of the node of the peer.
+// Unlike p2p.NewNetAddressString, the host is not resolved.
+func validatePeerAddress(addr string) error {
+	id, hostPort, ok := strings.Cut(addr, "@")
+	if !ok {
+		return errors.Newf(
+			"address %q must be of the form id@host:port", addr,
+		)
+	}
+	if bz, err := hex.DecodeString(id); err != nil ||
+		len(bz) != p2p.IDByteLength {
+		return errors.Newf(
+			"id of address %q must be %d hex-encoded bytes",
+			addr, p2p.IDByteLength,
+		)
+	}
+	host, port, err := net.SplitHostPort(hostPort)
+	if err != nil {
+		return errors.Wrapf(err, "address %q", addr)
+	}
+	if host == "" {
+		return errors.Newf("host of address %q must not be empty", addr)
+	}
+	if _, err = strconv.ParseUint(port, 10, 16); err != nil {
+		return errors.Wrapf(err, "port of address %q", addr)
+	}
+	return nil
+}
+
+// validatePrometheusListenAddr validates that the Prometheus listen address
+// set on the builder, if any, is of the form host:port. The host may be empty
+// to listen on all interfaces.
+func (nb *NodeBuilder[NodeT]) validatePrometheusListenAddr() error {
+	if nb.prometheusListenAddr == "" {
+		return nil
+	}
+	_, port, err := net.SplitHostPort(nb.prometheusListenAddr)
+	if err != nil {
+		return newBuildError(ErrInvalidPrometheusListenAddr, err)
+	}
+	if _, err = strconv.ParseUint(port, 10, 16); err != nil {
+		return newBuildError(ErrInvalidPrometheusListenAddr, errors.Wrapf(
+			err, "port of address %q", nb.prometheusListenAddr,
+		))
+	}
+	return nil
+}
+
+// validateDurations validates the durations set on the builder.
+func (nb *NodeBuilder[NodeT]) validateDurations() error {
+	if nb.daCompactionInterval != nil && *nb.daCompactionInterval < 0 {
+		return newBuildError(ErrInvalidDACompactionInterval, errors.Newf(
+			"interval %s must not be negative", *nb.daCompactionInterval,
+		))
+	}
+	if nb.buildTimeout != nil && *nb.buildTimeout <= 0 {
+		return newBuildError(ErrInvalidBuildTimeout, errors.Newf(
+			"timeout %s must be positive", *nb.buildTimeout,
+		))
+	}
+	if nb.proposerTimeout != nil && *nb.proposerTimeout <= 0 {
+		return newBuildError(ErrInvalidProposerTimeout, errors.Newf(
+			"timeout %s must be positive", *nb.proposerTimeout,
+		))
+	}
+	return nil
+}
+
+// validateConcurrency validates the number of workers and the block import
+// rate limit set on the builder.
+func (nb *NodeBuilder[NodeT]) validateConcurrency() error {
+	if nb.importConcurrency != nil && *nb.importConcurrency < 1 {
+		return newBuildError(ErrInvalidImportConcurrency, errors.Newf(
+			"concurrency %d must be positive", *nb.importConcurrency,
+		))
+	}
+	if nb.importRateLimit != nil && *nb.importRateLimit < 1 {
+		return newBuildError(ErrInvalidImportRateLimit, errors.Newf(
+			"%d blocks per second must be positive", *nb.importRateLimit,
+		))
+	}
+	if nb.blobVerifyWorkers != nil && *nb.blobVerifyWorkers < 1 {
+		return newBuildError(ErrInvalidBlobVerifyWorkers, errors.Newf(
+			"workers %d must be positive", *nb.blobVerifyWorkers,
+		))
+	}
+	return nil
+}
+
+// validateStoreDirs validates that the store directories set on the builder,
+// if any, can be written to.
+func (nb *NodeBuilder[NodeT]) validateStoreDirs() error {
+	if err := validateStoreDir("availability", nb.daStoreDir); err != nil {
+		return err
+	}
+	return validateStoreDir("deposit", nb.depositStoreDir)
+}
+
+// validateStoreDir validates that the given directory of the given store can
+// be written to, if it is set.
+func validateStoreDir(store, dir string) error {
+	if dir == "" {
+		return nil
+	}
+	if err := components.ValidateWritableDir(dir); err != nil {
+		return newBuildError(ErrInvalidStoreDir, errors.Wrapf(
+			err, "%s store directory %q is not writable", store, dir,
+		))
+	}
+	return nil
+}
+
+// validateProviders validates the components and the provider overrides set
+// on the builder.
+func (nb *NodeBuilder[NodeT]) validateProviders() error {
+	if err := nb.validateComponents(); err != nil {
+		return err
+	}
+	return nb.validateProviderOverrides()
+}
+
+// validateComponents validates that none of the components set on the
+// builder is nil, and that no two of them provide the same output type,
+// which depinject would otherwise fail on with a less precise error.
+// Components replaced by a provider override are not checked for duplicate
+// output types, as they are not provided.
+func (nb *NodeBuilder[NodeT]) validateComponents() error {
 	var (
-		autoCliOpts autocli.AppOptions
-		mm          *module.Manager
-		clientCtx   client.Context
-		chainSpec   primitives.ChainSpec
+		problems  []string
+		providers = make(map[reflect.Type]int)
+	)
+	for i, c := range nb.components {
+		if v := reflect.ValueOf(c); !v.IsValid() ||
+			(v.Kind() == reflect.Func && v.IsNil()) {
+			problems = append(problems, fmt.Sprintf(
+				"component %d (%T) is nil", i, c,
+			))
+			continue
+		}
+		if slices.ContainsFunc(nb.providerOverrides, func(o any) bool {
+			return sharesOutput(c, o)
+		}) {
+			continue
+		}
+		for _, out := range providerOutputs(c) {
+			j, found := providers[out]
+			if !found {
+				providers[out] = i
+				continue
+			}
+			problems = append(problems, fmt.Sprintf(
+				"components %d (%s) and %d (%s) both provide %s",
+				j, componentName(nb.components[j]),
+				i, componentName(c), out,
+			))
+		}
+	}
+	if len(problems) > 0 {
+		return newBuildError(
+			ErrInvalidComponents, errors.New(strings.Join(problems, "; ")),
+		)
+	}
+	return nil
+}
+
+// validateProviderOverrides validates the provider overrides set on the
+// builder.
+func (nb *NodeBuilder[NodeT]) validateProviderOverrides() error {
+	for _, provider := range nb.providerOverrides {
+		if len(providerOutputs(provider)) == 0 {
+			return newBuildError(ErrInvalidProviderOverride, errors.Newf(
+				"%s has no outputs", componentName(provider),
+			))
+		}
+	}
+	return nil
+}
+
+// validateChainSpec validates the chain spec set on the builder, if any.
+func (nb *NodeBuilder[NodeT]) validateChainSpec() error {
+	if nb.chainSpec == nil {
+		return nil
+	}
+	if nb.chainSpec.SlotsPerEpoch() == 0 {
+		return newBuildError(ErrChainSpecInvalid, errors.New(
+			"slots per epoch must be non-zero",
+		))
+	}
+	if nb.chainSpec.DepositContractAddress() == (common.ExecutionAddress{}) {
+		return newBuildError(ErrChainSpecInvalid, errors.New(
+			"deposit contract address must be set",
+		))
+	}
+	return nil
+}
+
+// validateGenesisFile validates the genesis file set on the builder, if any,
+// against the chain spec the node is built with.
+func (nb *NodeBuilder[NodeT]) validateGenesisFile() error {
+	if nb.genesisFile == "" {
+		return nil
+	}
+	cs := nb.chainSpec
+	if cs == nil {
+		var err error
+		cs, err = spec.ChainSpecByName(components.ChainSpecName(nil))
+		if err != nil {
+			return newBuildError(ErrChainSpecInvalid, err)
+		}
+	}
+	if err := checkGenesisFile(nb.genesisFile, cs); err != nil {
+		return newBuildError(ErrInvalidGenesisFile, err)
+	}
+	return nil
+}
+
+// validateConsensusParams validates the consensus params set on the builder,
+// if any, against the chain spec the node is built with.
+func (nb *NodeBuilder[NodeT]) validateConsensusParams() error {
+	if nb.consensusParams == nil {
+		return nil
+	}
+	cs := nb.chainSpec
+	if cs == nil {
+		var err error
+		cs, err = spec.ChainSpecByName(components.ChainSpecName(nil))
+		if err != nil {
+			return newBuildError(ErrChainSpecInvalid, err)
+		}
+	}
+	if err := checkConsensusParams(nb.consensusParams, cs); err != nil {
+		return newBuildError(ErrInvalidConsensusParams, err)
+	}
+	return nil
+}
+
+// checkConsensusParams ensures that the consensus params of the given chain
+// spec, once overridden by the given params, are valid, and that their block
+// max bytes can hold the blobs of a beacon block carrying the maximum number
+// of blobs of the chain spec.
+func checkConsensusParams(
+	params *cmtproto.ConsensusParams,
+	cs primitives.ChainSpec,
+) error {
+	cfg := components.WithConsensusParams(cs, params).
+		GetCometBFTConfigForSlot(0)
+	updated, ok := cfg.(*cmttypes.ConsensusParams)
+	if !ok || updated == nil {
+		return errors.New("chain spec does not define consensus params")
+	}
+	// CometBFT only recognizes the BLS key type of the chain specs when built
+	// with BLS support, so the validator key types are not validated.
+	basic := *updated
+	basic.Validator = cmttypes.DefaultValidatorParams()
+	if err := basic.ValidateBasic(); err != nil {
+		return err
+	}
+
+	blobBytes := cs.MaxBlobsPerBlock() * cs.BytesPerBlob()
+	//#nosec:G701 // max bytes is checked to be positive.
+	if maxBytes := updated.Block.MaxBytes; maxBytes > 0 &&
+		uint64(maxBytes) < blobBytes {
+		return errors.Newf(
+			"block max bytes %d cannot hold the %d bytes of the blobs of a "+
+				"block", maxBytes, blobBytes,
+		)
+	}
+	return nil
+}
+
+// checkGenesisFile ensures that the genesis file at the given path can be
+// parsed into a beacon genesis whose fork version is the genesis fork version
+// of the given chain spec.
+func checkGenesisFile(path string, cs primitives.ChainSpec) error {
+	genesisInfo, err := genesiscmd.ReadBeaconGenesis(path)
+	if err != nil {
+		return errors.Wrapf(err, "failed to parse %s", path)
+	}
+	expected := pversion.FromUint32[primitives.Version](
+		cs.ActiveForkVersionForSlot(0),
+	)
+	if genesisInfo.ForkVersion != expected {
+		return errors.Newf(
+			"fork version %s of %s does not match fork version %s of "+
+				"the chain spec", genesisInfo.ForkVersion, path, expected,
+		)
+	}
+	return nil
+}
+
+// buildRootCmd builds the root command for the application.
+func (nb *NodeBuilder[NodeT]) buildRootCmd() (
+	*cobra.Command, *Container, error,
+) {
+	container, err := nb.resolveContainer()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err = nb.registerExtraModules(container); err != nil {
+		return nil, nil, err
+	}
+
+	cmd := &cobra.Command{
+		Use:               nb.name,
+		Short:             nb.description,
+		PersistentPreRunE: nb.persistentPreRunE(container),
+	}
+	if nb.chainSpec == nil {
+		cmd.PersistentFlags().String(
+			flags.ChainSpec, "", fmt.Sprintf(
+				"chain spec of the network to target (%s)",
+				strings.Join(spec.Names(), "|"),
+			),
+		)
+	}
+
+	cmdlib.DefaultRootCommandSetup(
+		cmd,
+		container.ModuleManager,
+		nb.AppCreator,
+		container.ChainSpec,
+		nb.StorageBackendCreator,
+		nb.DepositStoreCreator,
+		nb.AvailabilityStoreCreator,
+		nb.KeyringCreator,
+		nb.GraphDOT,
+		configcmd.Defaults{
+			AppConfig:         nb.appConfig,
+			AppConfigTemplate: nb.appConfigTemplate,
+			CometConfig:       nb.cometConfig,
+		},
 	)
+
+	if err = container.AutoCLIOpts.EnhanceRootCommand(cmd); err != nil {
+		return nil, nil, newBuildError(ErrRuntimeInit, err)
+	}
+
+	if err = nb.setInitHeightDefault(cmd); err != nil {
+		return nil, nil, newBuildError(ErrRuntimeInit, err)
+	}
+
+	return cmd, container, nil
+}
+
+// setInitHeightDefault makes the initial height of the builder, if set, the
+// default initial height of the genesis written by the init command, such
+// that the first block of the chain is at that height.
+func (nb *NodeBuilder[NodeT]) setInitHeightDefault(cmd *cobra.Command) error {
+	if nb.initialHeight == nil {
+		return nil
+	}
+	initCmd, _, err := cmd.Find([]string{"init"})
+	if err != nil {
+		return err
+	}
+	f := initCmd.Flags().Lookup(sdkflags.FlagInitHeight)
+	if f == nil {
+		return errors.Newf("init command has no %s flag", sdkflags.FlagInitHeight)
+	}
+	f.DefValue = strconv.FormatInt(*nb.initialHeight, 10)
+	return f.Value.Set(f.DefValue)
+}
+
+// newLogger returns a logger writing to the given writer in the log format of
+// the NodeBuilder.
+func (nb *NodeBuilder[NodeT]) newLogger(out io.Writer) log.Logger {
+	if nb.logFormat == sdkflags.OutputFormatJSON {
+		return log.NewLogger(out, log.OutputJSONOption())
+	}
+	return log.NewLogger(out)
+}
+
+// resolveContainer resolves the dependencies required to build the root
+// command of the application.
+func (nb *NodeBuilder[NodeT]) resolveContainer() (*Container, error) {
+	logger := nb.logger
+	if logger == nil {
+		logger = nb.newLogger(os.Stdout)
+	}
+	v := nb.viper
+	if v == nil {
+		v = viper.GetViper()
+	}
+
+	container := &Container{}
+	start := time.Now()
+	if err := nb.injectWithTimeout(container, logger, v); err != nil {
+		return nil, err
+	}
+	nb.rootResolution = time.Since(start)
+	logger.Debug(
+		"resolved dependencies of the node", "duration", nb.rootResolution,
+	)
+
+	// The chain spec selected by the chain-spec flag is only known once the
+	// flags of the executed command are parsed, after the commands it is
+	// passed to are built.
+	if nb.chainSpec == nil {
+		container.ChainSpec = &chainSpecRef{ChainSpec: container.ChainSpec}
+	}
+	return container, nil
+}
+
+// injectWithTimeout resolves the dependencies of the container, failing with
+// ErrBuildTimeout if they are not resolved within the build timeout. The
+// resolution is abandoned rather than canceled on timeout, as providers
+// cannot be interrupted, so the container must not be used afterwards.
+func (nb *NodeBuilder[NodeT]) injectWithTimeout(
+	container *Container,
+	logger log.Logger,
+	v *viper.Viper,
+) error {
+	timeout := DefaultBuildTimeout
+	if nb.buildTimeout != nil {
+		timeout = *nb.buildTimeout
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- nb.inject(container, logger, v)
+	}()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case err := <-done:
+		return err
+	case <-timer.C:
+		return newBuildError(ErrBuildTimeout, errors.Newf(
+			"dependencies of the node were not resolved within %s", timeout,
+		))
+	}
+}
+
+// inject resolves the dependencies of the container.
+func (nb *NodeBuilder[NodeT]) inject(
+	container *Container,
+	logger log.Logger,
+	v *viper.Viper,
+) error {
 	if err := depinject.Inject(
 		depinject.Configs(
 			nb.depInjectCfg,
@@ -97,93 +912,454 @@ func (nb *NodeBuilder[NodeT]) buildRootCmd() (*cobra.Command, error) {
 			// the beacon module so that we don't need to define these empty
 			// placeholders to get the depinject framework to not freak out.
 			depinject.Supply(
-				log.NewLogger(os.Stdout),
-				viper.GetViper(),
-				&runtime.BeaconKitRuntime[
-					*dastore.Store[*consensustypes.BeaconBlockBody],
-					*consensustypes.BeaconBlock,
-					*consensustypes.BeaconBlockBody,
-					components.BeaconState,
-					*datypes.BlobSidecars,
-					*depositdb.KVStore[*consensustypes.Deposit],
-					blockchain.StorageBackend[
-						*dastore.Store[*consensustypes.BeaconBlockBody],
-						*consensustypes.BeaconBlockBody,
-						components.BeaconState,
-						*datypes.BlobSidecars,
-						*consensustypes.Deposit,
-						*depositdb.KVStore[*consensustypes.Deposit],
-					],
-				]{},
-			),
-			depinject.Provide(
-				components.ProvideNoopTxConfig,
-				components.ProvideClientContext,
-				components.ProvideKeyring,
-				components.ProvideConfig,
-				components.ProvideChainSpec,
+				logger,
+				v,
+				&components.BeaconKitRuntime{},
 			),
+			depinject.Provide(nb.rootProviders()...),
+			nb.chainSpecConfig(),
+			nb.keyringBackendConfig(),
 		),
-		&autoCliOpts,
-		&mm,
-		&clientCtx,
-		&chainSpec,
+		&container.AutoCLIOpts,
+		&container.ModuleManager,
+		&container.ClientCtx,
+		&container.ChainSpec,
 	); err != nil {
-		return nil, err
+		return wrapInjectError(err)
 	}
+	return nil
+}
 
-	cmd := &cobra.Command{
-		Use:   nb.name,
-		Short: nb.description,
-		PersistentPreRunE: func(cmd *cobra.Command, _ []string) error {
-			// set the default command outputs
-			cmd.SetOut(cmd.OutOrStdout())
-			cmd.SetErr(cmd.ErrOrStderr())
-
-			var err error
-			clientCtx, err = client.ReadPersistentCommandFlags(
-				clientCtx,
-				cmd.Flags(),
-			)
-			if err != nil {
-				return err
-			}
+// rootProviders returns the providers the root command is built with, with
+// the providers replaced by the provider overrides of the builder. Overrides
+// that replace none of them are omitted.
+func (nb *NodeBuilder[NodeT]) rootProviders() []any {
+	providers := []any{
+		components.ProvideNoopTxConfig,
+		components.ProvideClientContext,
+		components.ProvideKeyring,
+		components.ProvideConfig,
+	}
+	overrides := slices.DeleteFunc(
+		slices.Clone(nb.providerOverrides),
+		func(o any) bool {
+			return !slices.ContainsFunc(providers, func(p any) bool {
+				return sharesOutput(p, o)
+			})
+		},
+	)
+	return overrideProviders(providers, overrides)
+}
 
-			customClientTemplate, customClientConfig := components.InitClientConfig()
-			clientCtx, err = config.CreateClientConfig(
-				clientCtx,
-				customClientTemplate,
-				customClientConfig,
-			)
-			if err != nil {
-				return err
-			}
+// registerExtraModules registers the extra modules of the builder with the
+// module manager and the autocli options of the container. The modules are
+// ordered after the modules already registered, in the order they were given.
+func (nb *NodeBuilder[NodeT]) registerExtraModules(container *Container) error {
+	if len(nb.extraModules) == 0 {
+		return nil
+	}
 
-			if err = client.SetCmdClientContextHandler(
-				clientCtx, cmd,
-			); err != nil {
-				return err
-			}
+	mm := container.ModuleManager
+	names := make([]string, 0, len(nb.extraModules))
+	preBlockers := make([]string, 0)
+	for _, mod := range nb.extraModules {
+		name := mod.Name()
+		if _, ok := mm.Modules[name]; ok || slices.Contains(names, name) {
+			return newBuildError(ErrDuplicateModule, errors.Newf(
+				"module %q is already registered", name,
+			))
+		}
+		names = append(names, name)
+		if _, ok := mod.(appmodule.HasPreBlocker); ok {
+			preBlockers = append(preBlockers, name)
+		}
+	}
 
-			return server.InterceptConfigsPreRunHandler(
-				cmd,
-				DefaultAppConfigTemplate(),
-				DefaultAppConfig(),
-				DefaultCometConfig(),
-			)
-		},
+	if container.AutoCLIOpts.Modules == nil {
+		container.AutoCLIOpts.Modules = make(map[string]appmodule.AppModule)
+	}
+	for i, mod := range nb.extraModules {
+		mm.Modules[names[i]] = mod
+		container.AutoCLIOpts.Modules[names[i]] = mod
 	}
 
-	cmdlib.DefaultRootCommandSetup(
-		cmd,
-		mm,
-		nb.AppCreator,
-		chainSpec,
+	// The order slices of a manager may share their backing array, so they
+	// are cloned before being extended.
+	for _, order := range []*[]string{
+		&mm.OrderInitGenesis,
+		&mm.OrderExportGenesis,
+		&mm.OrderBeginBlockers,
+		&mm.OrderEndBlockers,
+		&mm.OrderPrecommiters,
+		&mm.OrderPrepareCheckStaters,
+	} {
+		*order = append(slices.Clone(*order), names...)
+	}
+	mm.OrderPreBlockers = append(
+		slices.Clone(mm.OrderPreBlockers), preBlockers...,
 	)
+	return nil
+}
+
+// persistentPreRunE returns the PersistentPreRunE of the root command, which
+// sets up the client context and the server context of the executed command.
+func (nb *NodeBuilder[NodeT]) persistentPreRunE(
+	container *Container,
+) func(*cobra.Command, []string) error {
+	clientCtx := container.ClientCtx
+	return func(cmd *cobra.Command, _ []string) error {
+		if err := nb.selectChainSpec(cmd, container); err != nil {
+			return err
+		}
+		if err := nb.checkSelectedChainSpec(container.ChainSpec); err != nil {
+			return err
+		}
+
+		// set the default command outputs
+		cmd.SetOut(cmd.OutOrStdout())
+		cmd.SetErr(cmd.ErrOrStderr())
+
+		var err error
+		clientCtx, err = client.ReadPersistentCommandFlags(
+			clientCtx,
+			cmd.Flags(),
+		)
+		if err != nil {
+			return err
+		}
 
-	if err := autoCliOpts.EnhanceRootCommand(cmd); err != nil {
+		customClientTemplate, customClientConfig := components.InitClientConfig()
+		clientCtx, err = config.CreateClientConfig(
+			clientCtx,
+			customClientTemplate,
+			customClientConfig,
+		)
+		if err != nil {
+			return err
+		}
+
+		if err = client.SetCmdClientContextHandler(
+			clientCtx, cmd,
+		); err != nil {
+			return err
+		}
+
+		cometConfig, err := copyCometConfig(nb.cometConfig)
+		if err != nil {
+			return err
+		}
+
+		if err = server.InterceptConfigsPreRunHandler(
+			cmd,
+			nb.appConfigTemplate,
+			nb.appConfig,
+			cometConfig,
+		); err != nil {
+			return err
+		}
+
+		return nb.overrideServerContext(cmd)
+	}
+}
+
+// checkSelectedChainSpec validates the genesis file and consensus params set
+// on the builder, if any, against the chain spec selected for the command.
+func (nb *NodeBuilder[NodeT]) checkSelectedChainSpec(
+	cs primitives.ChainSpec,
+) error {
+	if nb.genesisFile != "" {
+		if err := checkGenesisFile(nb.genesisFile, cs); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidGenesisFile, err)
+		}
+	}
+	if nb.consensusParams != nil {
+		if err := checkConsensusParams(nb.consensusParams, cs); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidConsensusParams, err)
+		}
+	}
+	return nil
+}
+
+// selectChainSpec selects the chain spec of the container and the node info
+// by the chain-spec flag of the root command, if it is set.
+func (nb *NodeBuilder[NodeT]) selectChainSpec(
+	cmd *cobra.Command,
+	container *Container,
+) error {
+	ref, ok := container.ChainSpec.(*chainSpecRef)
+	if !ok {
+		return nil
+	}
+	f := cmd.Root().PersistentFlags().Lookup(flags.ChainSpec)
+	if f == nil || !f.Changed {
+		return nil
+	}
+	cs, err := spec.ChainSpecByName(f.Value.String())
+	if err != nil {
+		return err
+	}
+	ref.ChainSpec = nb.withConsensusParams(cs)
+	nb.setNodeInfo(f.Value.String(), cs)
+	return nil
+}
+
+// chainSpecConfig returns the dependency injection config that makes the
+// chain spec available, supplying the chain spec of the builder if set.
+func (nb *NodeBuilder[NodeT]) chainSpecConfig() depinject.Config {
+	if nb.chainSpec == nil {
+		if nb.consensusParams == nil {
+			return depinject.Provide(components.ProvideChainSpec)
+		}
+		return depinject.Configs(
+			depinject.Provide(components.ProvideChainSpec),
+			depinject.Supply(
+				(*components.ConsensusParams)(nb.consensusParams),
+			),
+		)
+	}
+	return depinject.Supply(nb.withConsensusParams(nb.chainSpec))
+}
+
+// withConsensusParams returns the given chain spec with its consensus params
+// overridden by the consensus params of the builder, if set.
+func (nb *NodeBuilder[NodeT]) withConsensusParams(
+	cs primitives.ChainSpec,
+) primitives.ChainSpec {
+	if nb.consensusParams == nil {
+		return cs
+	}
+	return components.WithConsensusParams(cs, nb.consensusParams)
+}
+
+// keyringBackendConfig returns the depinject config supplying the keyring
+// backend of the node, if one is set.
+func (nb *NodeBuilder[NodeT]) keyringBackendConfig() depinject.Config {
+	if nb.keyringBackend == "" {
+		return depinject.Configs()
+	}
+	return depinject.Supply(components.KeyringBackend(nb.keyringBackend))
+}
+
+// providers returns the components to provide to the application. If a chain
+// spec was set on the builder, components.ProvideChainSpec is omitted. If the
+// stores are kept in memory, the availability store provider is replaced by
+// its in-memory counterpart. If the node is read-only, the proposer
+// components are replaced or omitted as described by WithReadOnly. If blob
+// verification is disabled, the blob proof verifier is replaced by one that
+// accepts any proof. The provider overrides are applied first, as described
+// by WithProviderOverride. The providers are sorted by name, such that the
+// resolution order and error messages of depinject do not depend on the order
+// the components were registered in.
+func (nb *NodeBuilder[NodeT]) providers() []any {
+	providers := overrideProviders(
+		slices.Clone(nb.components), nb.providerOverrides,
+	)
+	if nb.chainSpec != nil {
+		providers = slices.DeleteFunc(providers, func(c any) bool {
+			return isSameComponent(c, components.ProvideChainSpec)
+		})
+	}
+	if nb.inMemoryStores {
+		type body = *consensustypes.BeaconBlockBody
+		for i, c := range providers {
+			if isSameComponent(c, components.ProvideAvailibilityStore[body]) {
+				providers[i] = components.ProvideInMemoryAvailabilityStore[body]
+			}
+		}
+	}
+	if nb.readOnly {
+		providers = readOnlyProviders(providers)
+	}
+	if nb.skipBlobVerification {
+		for i, c := range providers {
+			if isSameComponent(c, components.ProvideBlobProofVerifier) {
+				providers[i] = components.ProvideNoopBlobProofVerifier
+			}
+		}
+	}
+	slices.SortStableFunc(providers, func(a, b any) int {
+		return cmp.Compare(componentName(a), componentName(b))
+	})
+	return providers
+}
+
+// readOnlyProviders returns the given providers with the proposer components
+// replaced by their read-only counterparts, and the validator service
+// omitted.
+func readOnlyProviders(providers []any) []any {
+	providers = slices.DeleteFunc(providers, func(c any) bool {
+		return isSameComponent(c, components.ProvideValidatorService)
+	})
+	for i, c := range providers {
+		switch {
+		case isSameComponent(c, components.ProvideBlsSigner):
+			providers[i] = components.ProvideReadOnlySigner
+		case isSameComponent(c, components.ProvideLocalBuilder):
+			providers[i] = components.ProvideReadOnlyLocalBuilder
+		}
+	}
+	return providers
+}
+
+// supplies returns the values to supply to the application alongside the
+// given ones, which includes the chain spec, health server config, admin
+// server config, availability store config, store directories, telemetry
+// config, Prometheus listen address, tracer provider, blockchain service
+// config and engine endpoint of the builder if set.
+func (nb *NodeBuilder[NodeT]) supplies(values ...any) []any {
+	if nb.chainSpec != nil {
+		values = append(values, nb.withConsensusParams(nb.chainSpec))
+	} else if nb.consensusParams != nil {
+		values = append(
+			values, (*components.ConsensusParams)(nb.consensusParams),
+		)
+	}
+	if nb.healthServerAddr != "" {
+		values = append(values, &health.Config{
+			Addr:       nb.healthServerAddr,
+			MaxSyncLag: health.DefaultMaxSyncLag,
+		})
+	}
+	if nb.adminSocketPath != "" {
+		values = append(values, &admin.Config{
+			SocketPath: nb.adminSocketPath,
+		})
+	}
+	if nb.daCompactionInterval != nil || nb.daReadReplica != nil {
+		cfg := &dastore.Config{
+			CompactionInterval: dastore.DefaultCompactionInterval,
+			ReadReplica:        nb.daReadReplica,
+		}
+		if nb.daCompactionInterval != nil {
+			cfg.CompactionInterval = *nb.daCompactionInterval
+		}
+		values = append(values, cfg)
+	}
+	if nb.daStoreDir != "" || nb.depositStoreDir != "" {
+		values = append(values, &components.StoreDirs{
+			AvailabilityStore: nb.daStoreDir,
+			DepositStore:      nb.depositStoreDir,
+		})
+	}
+	if nb.telemetry != nil {
+		values = append(values, nb.telemetry)
+	}
+	if nb.prometheusListenAddr != "" {
+		addr := components.PrometheusListenAddr(nb.prometheusListenAddr)
+		values = append(values, &addr)
+	}
+	if nb.tracerProvider != nil {
+		values = append(values, nb.tracerProvider)
+	}
+	if nb.importConcurrency != nil || nb.importRateLimit != nil {
+		values = append(values, nb.blockchainConfig())
+	}
+	if nb.blobVerifyWorkers != nil {
+		values = append(values, &dablob.Config{
+			VerifyWorkers: *nb.blobVerifyWorkers,
+		})
+	}
+	if nb.engineEndpoint != nil {
+		values = append(values, nb.engineEndpoint)
+	}
+	if nb.proposerTimeout != nil {
+		timeout := components.ProposerTimeout(*nb.proposerTimeout)
+		values = append(values, &timeout)
+	}
+	return values
+}
+
+// blockchainConfig returns the config of the blockchain service, with the
+// import concurrency and rate limit of the builder in place of the defaults.
+func (nb *NodeBuilder[NodeT]) blockchainConfig() *blockchain.Config {
+	cfg := blockchain.DefaultConfig()
+	if nb.importConcurrency != nil {
+		cfg.ImportConcurrency = *nb.importConcurrency
+	}
+	if nb.importRateLimit != nil {
+		cfg.ImportRateLimit = *nb.importRateLimit
+	}
+	return &cfg
+}
+
+// overrideServerContext applies the logger, viper instance, log format,
+// initial height, genesis file, minimum gas prices, seeds, persistent peers
+// and Prometheus listen address of the NodeBuilder, if any, to the server
+// context set up by the pre-run handler.
+func (nb *NodeBuilder[NodeT]) overrideServerContext(cmd *cobra.Command) error {
+	serverCtx := server.GetServerContextFromCmd(cmd)
+
+	// If a custom logger was provided, the server (and thus CometBFT)
+	// should log to the same sink.
+	if nb.logger != nil {
+		serverCtx.Logger = nb.logger.With(log.ModuleKey, "server")
+	}
+
+	// If a custom viper instance was provided, the configuration read by the
+	// pre-run handler is merged into it, so that both the server and the
+	// application read from the same instance.
+	if nb.viper != nil {
+		if err := nb.viper.MergeConfigMap(
+			serverCtx.Viper.AllSettings(),
+		); err != nil {
+			return err
+		}
+		serverCtx.Viper = nb.viper
+	}
+
+	// Unless a custom logger was provided or the log format flag is set, the
+	// server logs in the log format of the NodeBuilder.
+	if nb.logger == nil && nb.logFormat != "" &&
+		!cmd.Flags().Changed(sdkflags.FlagLogFormat) {
+		serverCtx.Viper.Set(sdkflags.FlagLogFormat, nb.logFormat)
+		logger, err := server.CreateSDKLogger(serverCtx, cmd.OutOrStdout())
+		if err != nil {
+			return err
+		}
+		serverCtx.Logger = logger
+	}
+
+	if nb.initialHeight != nil {
+		serverCtx.Viper.Set(sdkflags.FlagInitHeight, *nb.initialHeight)
+	}
+	if nb.genesisFile != "" {
+		genesisFile, err := filepath.Abs(nb.genesisFile)
+		if err != nil {
+			return err
+		}
+		serverCtx.Config.Genesis = genesisFile
+	}
+	if nb.minGasPrices != nil {
+		serverCtx.Viper.Set(server.FlagMinGasPrices, *nb.minGasPrices)
+	}
+	if len(nb.seeds) > 0 {
+		serverCtx.Config.P2P.Seeds = strings.Join(nb.seeds, ",")
+	}
+	if len(nb.persistentPeers) > 0 {
+		serverCtx.Config.P2P.PersistentPeers = strings.Join(
+			nb.persistentPeers, ",",
+		)
+	}
+	if nb.prometheusListenAddr != "" {
+		serverCtx.Config.Instrumentation.Prometheus = true
+		serverCtx.Config.Instrumentation.PrometheusListenAddr =
+			nb.prometheusListenAddr
+	}
+
+	return server.SetCmdServerContext(cmd, serverCtx)
+}
+
+// copyCometConfig returns a deep copy of the given CometBFT config, such that
+// the original is not mutated by CometBFT at runtime.
+func copyCometConfig(cfg *cmtcfg.Config) (*cmtcfg.Config, error) {
+	bz, err := json.Marshal(cfg)
+	if err != nil {
 		return nil, err
 	}
 
-	return cmd, nil
+	cpy := &cmtcfg.Config{}
+	if err = json.Unmarshal(bz, cpy); err != nil {
+		return nil, err
+	}
+	return cpy, nil
 }
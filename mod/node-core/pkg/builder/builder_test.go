@@ -0,0 +1,1753 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package builder //nolint:testpackage // exercises the unexported root command.
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	clientv2keyring "cosmossdk.io/client/v2/autocli/keyring"
+	"cosmossdk.io/depinject"
+	"cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/beacon/blockchain"
+	"github.com/berachain/beacon-kit/mod/beacon/validator"
+	configcmd "github.com/berachain/beacon-kit/mod/cli/pkg/commands/config"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/genesis"
+	ctypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	dablob "github.com/berachain/beacon-kit/mod/da/pkg/blob"
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg"
+	dastore "github.com/berachain/beacon-kit/mod/da/pkg/store"
+	datypes "github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
+	beacon "github.com/berachain/beacon-kit/mod/node-core/pkg/components/module"
+	blssigner "github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/storage"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/config"
+	beaconflags "github.com/berachain/beacon-kit/mod/node-core/pkg/config/flags"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/config/spec"
+	nodepkg "github.com/berachain/beacon-kit/mod/node-core/pkg/node"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/services/admin"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/services/health"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/service"
+	depositdb "github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/filedb"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/server"
+	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/version"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// newTestBuilder returns a NodeBuilder with the default dependency injection
+// configuration and the given options applied on top.
+func newTestBuilder(opts ...Opt[types.NodeI]) *NodeBuilder[types.NodeI] {
+	return New(append([]Opt[types.NodeI]{
+		WithName[types.NodeI](DefaultAppName),
+		WithDescription[types.NodeI](DefaultDescription),
+		WithDepInjectConfig[types.NodeI](DefaultDepInjectConfig()),
+	}, opts...)...)
+}
+
+// runPreRun builds the root command of the given builder and executes its
+// persistent pre-run against a temporary home directory, returning the
+// server context that was set up by it.
+func runPreRun(t *testing.T, nb *NodeBuilder[types.NodeI]) *server.Context {
+	t.Helper()
+	cmd, _, err := nb.buildRootCmd()
+	require.NoError(t, err)
+
+	var serverCtx *server.Context
+	cmd.PersistentFlags().String(flags.FlagHome, "", "")
+	cmd.AddCommand(&cobra.Command{
+		Use: "probe",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx = server.GetServerContextFromCmd(cmd)
+			return nil
+		},
+	})
+	cmd.SetArgs([]string{"probe", "--" + flags.FlagHome, t.TempDir()})
+	require.NoError(t, cmd.Execute())
+	require.NotNil(t, serverCtx)
+	return serverCtx
+}
+
+func TestBuildWithContainer(t *testing.T) {
+	node, container, err := newTestBuilder().BuildWithContainer()
+	require.NoError(t, err)
+	require.NotNil(t, node)
+	require.NotNil(t, container)
+	require.NotNil(t, container.ChainSpec)
+	require.Contains(t, container.ModuleManager.Modules, beacon.ModuleName)
+}
+
+func TestBuildTwice(t *testing.T) {
+	nb := newTestBuilder()
+	node, container, err := nb.BuildWithContainer()
+	require.NoError(t, err)
+
+	// The dependencies of the node are not resolved again.
+	again, againContainer, err := nb.BuildWithContainer()
+	require.NoError(t, err)
+	require.Same(t, node, again)
+	require.Same(t, container, againContainer)
+
+	again, err = nb.Build()
+	require.NoError(t, err)
+	require.Same(t, node, again)
+}
+
+func TestBuildTwiceFails(t *testing.T) {
+	nb := newTestBuilder(WithLogFormat[types.NodeI]("logfmt"))
+	_, err := nb.Build()
+	require.ErrorIs(t, err, ErrInvalidLogFormat)
+
+	_, container, again := nb.BuildWithContainer()
+	require.Nil(t, container)
+	require.Same(t, err, again)
+}
+
+func TestResetRebuilds(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	nb := newTestBuilder(
+		WithShutdownTimeout[types.NodeI](time.Second),
+		WithRootContext[types.NodeI](ctx),
+	)
+	node, err := nb.Build()
+	require.NoError(t, err)
+	require.Equal(t, DefaultAppName, node.NodeInfo().Name)
+
+	// Options applied after the build only take effect once reset.
+	nb.With(WithName[types.NodeI]("renamed"))
+	again, err := nb.Build()
+	require.NoError(t, err)
+	require.Same(t, node, again)
+
+	nb.Reset()
+	again, err = nb.Build()
+	require.NoError(t, err)
+	require.NotSame(t, node, again)
+	require.Equal(t, "renamed", again.NodeInfo().Name)
+	require.Equal(t, DefaultAppName, node.NodeInfo().Name)
+
+	// The options set on the node itself are applied to the rebuilt node.
+	rebuilt, ok := again.(*nodepkg.Node)
+	require.True(t, ok)
+	require.Equal(t, time.Second, rebuilt.ShutdownTimeout())
+	require.Equal(t, ctx, rebuilt.RootContext())
+}
+
+func TestResetAfterFailedBuild(t *testing.T) {
+	nb := newTestBuilder(WithLogFormat[types.NodeI]("logfmt"))
+	_, err := nb.Build()
+	require.ErrorIs(t, err, ErrInvalidLogFormat)
+
+	nb.Reset()
+	_, err = nb.With(WithLogFormat[types.NodeI]("json")).Build()
+	require.NoError(t, err)
+}
+
+func TestWithLogger(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := log.NewLogger(buf, log.ColorOption(false))
+
+	serverCtx := runPreRun(t, newTestBuilder(WithLogger[types.NodeI](logger)))
+	serverCtx.Logger.Info("hello from the server")
+	require.Contains(t, buf.String(), "hello from the server")
+}
+
+func TestBuildLogsResolutionDuration(t *testing.T) {
+	// The duration is only logged at debug level, such that the output of
+	// the commands that build the node is left untouched.
+	buf := &bytes.Buffer{}
+	logger := log.NewLogger(
+		buf, log.OutputJSONOption(), log.LevelOption(zerolog.InfoLevel),
+	)
+	_, err := newTestBuilder(WithLogger[types.NodeI](logger)).Build()
+	require.NoError(t, err)
+	require.NotContains(t, buf.String(), "resolved dependencies")
+
+	buf.Reset()
+	logger = log.NewLogger(
+		buf, log.OutputJSONOption(), log.LevelOption(zerolog.DebugLevel),
+	)
+	_, err = newTestBuilder(WithLogger[types.NodeI](logger)).Build()
+	require.NoError(t, err)
+
+	var logged bool
+	for _, line := range bytes.Split(buf.Bytes(), []byte("\n")) {
+		var entry map[string]any
+		if json.Unmarshal(line, &entry) != nil ||
+			entry["message"] != "resolved dependencies of the node" {
+			continue
+		}
+		require.Equal(t, "debug", entry["level"])
+		require.IsType(t, float64(0), entry["duration"])
+		require.Positive(t, entry["duration"])
+		logged = true
+	}
+	require.True(t, logged, "resolution duration not logged: %s", buf)
+}
+
+func TestRecordResolution(t *testing.T) {
+	nb := newTestBuilder(WithLogger[types.NodeI](log.NewNopLogger()))
+	_, err := nb.Build()
+	require.NoError(t, err)
+	require.Positive(t, nb.rootResolution)
+
+	telemetry := &recordingTelemetry{durations: make(map[string]time.Duration)}
+	nb.recordResolution(log.NewNopLogger(), telemetry, time.Second)
+	require.Len(t, telemetry.durations, 2)
+	require.GreaterOrEqual(
+		t, telemetry.durations[resolutionMetric+",graph,root"],
+		nb.rootResolution,
+	)
+	require.GreaterOrEqual(
+		t, telemetry.durations[resolutionMetric+",graph,app"], time.Second,
+	)
+}
+
+// recordingTelemetry is a metrics.Telemetry that records the durations
+// measured through it, keyed by the key and labels of their metric.
+type recordingTelemetry struct {
+	durations map[string]time.Duration
+}
+
+func (*recordingTelemetry) IncrementCounter(string, ...string) {}
+
+func (*recordingTelemetry) SetGauge(string, int64, ...string) {}
+
+func (r *recordingTelemetry) MeasureSince(
+	key string, start time.Time, args ...string,
+) {
+	r.durations[strings.Join(append([]string{key}, args...), ",")] =
+		time.Since(start)
+}
+
+func TestGraphDOT(t *testing.T) {
+	nb := newTestBuilder(
+		WithComponents[types.NodeI](
+			components.DefaultComponentsWithStandardTypes()...,
+		),
+	)
+	dot, err := nb.GraphDOT()
+	require.NoError(t, err)
+	require.Regexp(t, `^digraph `, dot)
+	for _, provider := range []string{
+		"components.ProvideChainSpec",
+		"components.ProvideChainService",
+		"components.ProvideStorageBackend",
+	} {
+		require.Contains(t, dot, provider)
+	}
+
+	// The chain spec set on the builder replaces its provider.
+	nb = newTestBuilder(
+		WithComponents[types.NodeI](
+			components.DefaultComponentsWithStandardTypes()...,
+		),
+		WithChainSpec[types.NodeI](spec.DevnetChainSpec()),
+	)
+	dot, err = nb.GraphDOT()
+	require.NoError(t, err)
+	require.NotContains(t, dot, "components.ProvideChainSpec")
+}
+
+func TestStartupBanner(t *testing.T) {
+	nb := newTestBuilder(
+		WithName[types.NodeI]("beacond"),
+		WithPprof[types.NodeI]("localhost:6060"),
+	)
+	_, err := nb.Build()
+	require.NoError(t, err)
+
+	appOpts := viper.New()
+	appOpts.Set(flags.FlagHome, "/var/beacond")
+	buf := &bytes.Buffer{}
+	nb.logStartupBanner(log.NewLogger(buf, log.OutputJSONOption()), appOpts)
+
+	var entry map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+	require.Equal(t, "starting node", entry["message"])
+	info := nb.node.NodeInfo()
+	require.Equal(t, "beacond", entry["name"])
+	require.Equal(t, info.Version, entry["version"])
+	require.InDelta(t, float64(info.ChainID), entry["chain_id"], 0)
+	require.Equal(t, info.ChainSpecName, entry["chain_spec"])
+	require.Equal(t, "/var/beacond/data", entry["data_dir"])
+	require.Equal(t, "localhost:6060", entry["pprof"])
+	require.Equal(t, true, entry["blob_verification"])
+	require.Equal(t, false, entry["read_only"])
+	require.Equal(t, false, entry["telemetry"])
+
+	// Nothing is logged once the banner is disabled.
+	nb.With(WithStartupBanner[types.NodeI](false))
+	buf.Reset()
+	nb.logStartupBanner(log.NewLogger(buf, log.OutputJSONOption()), appOpts)
+	require.Empty(t, buf.String())
+}
+
+func TestWithLogFormat(t *testing.T) {
+	nb := newTestBuilder(WithLogFormat[types.NodeI]("json"))
+	require.NoError(t, nb.validate())
+
+	buf := &bytes.Buffer{}
+	nb.newLogger(buf).Info("hello from the node", "slot", 1)
+	var entry map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+	require.Equal(t, "hello from the node", entry["message"])
+
+	// The server logs in the same format.
+	cmd, _, err := nb.buildRootCmd()
+	require.NoError(t, err)
+	buf.Reset()
+	cmd.SetOut(buf)
+	cmd.PersistentFlags().String(flags.FlagHome, "", "")
+	cmd.AddCommand(&cobra.Command{
+		Use: "probe",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			server.GetServerContextFromCmd(cmd).Logger.Info("hello")
+			return nil
+		},
+	})
+	cmd.SetArgs([]string{"probe", "--" + flags.FlagHome, t.TempDir()})
+	require.NoError(t, cmd.Execute())
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+	require.Equal(t, "hello", entry["message"])
+
+	// Text is the default format.
+	buf.Reset()
+	newTestBuilder().newLogger(buf).Info("hello")
+	require.Error(t, json.Unmarshal(buf.Bytes(), &entry))
+}
+
+func TestNewAppliesDefaultName(t *testing.T) {
+	nb := New(
+		WithDepInjectConfig[types.NodeI](DefaultDepInjectConfig()),
+	)
+	require.Equal(t, DefaultName, nb.name)
+	require.Equal(t, DefaultDescription, nb.description)
+
+	cmd, _, err := nb.buildRootCmd()
+	require.NoError(t, err)
+	require.Equal(t, DefaultName, cmd.Use)
+}
+
+func TestBuildRejectsInvalidName(t *testing.T) {
+	for _, name := range []string{"", "beacon kit"} {
+		_, err := newTestBuilder(WithName[types.NodeI](name)).Build()
+		require.ErrorIs(t, err, ErrInvalidName)
+	}
+}
+
+func TestWithViper(t *testing.T) {
+	v1, v2 := viper.New(), viper.New()
+	serverCtx1 := runPreRun(t, newTestBuilder(WithViper[types.NodeI](v1)))
+	serverCtx2 := runPreRun(t, newTestBuilder(WithViper[types.NodeI](v2)))
+
+	require.Same(t, v1, serverCtx1.Viper)
+	require.Same(t, v2, serverCtx2.Viper)
+	require.NotEmpty(t, v1.GetString(flags.FlagHome))
+	require.NotEqual(
+		t, v1.GetString(flags.FlagHome), v2.GetString(flags.FlagHome),
+	)
+}
+
+func TestWithAppConfig(t *testing.T) {
+	cfg := serverconfig.DefaultConfig()
+	cfg.MinGasPrices = "42stake"
+
+	serverCtx := runPreRun(t, newTestBuilder(WithAppConfig[types.NodeI](
+		cfg, serverconfig.DefaultConfigTemplate,
+	)))
+	require.Equal(t, "42stake", serverCtx.Viper.GetString("minimum-gas-prices"))
+}
+
+func TestWithCometConfig(t *testing.T) {
+	cfg := DefaultCometConfig()
+	cfg.Consensus.TimeoutCommit = 100 * time.Millisecond
+	cfg.P2P.PexReactor = false
+
+	serverCtx := runPreRun(t, newTestBuilder(WithCometConfig[types.NodeI](cfg)))
+	require.Equal(
+		t, 100*time.Millisecond, serverCtx.Config.Consensus.TimeoutCommit,
+	)
+	require.False(t, serverCtx.Config.P2P.PexReactor)
+
+	// The supplied config must not be mutated by CometBFT.
+	require.NotEqual(t, cfg.RootDir, serverCtx.Config.RootDir)
+	require.Equal(t, DefaultCometConfig().RootDir, cfg.RootDir)
+}
+
+func TestWithChainSpec(t *testing.T) {
+	data := spec.BaseSpec()
+	data.DepositEth1ChainID = 1234
+	nb := newTestBuilder(
+		WithChainSpec[types.NodeI](chain.NewChainSpec(data)),
+		WithComponents[types.NodeI](components.ProvideChainSpec),
+	)
+
+	_, container, err := nb.BuildWithContainer()
+	require.NoError(t, err)
+	require.Equal(t, uint64(1234), container.ChainSpec.DepositEth1ChainID())
+	require.Empty(t, nb.providers())
+}
+
+func TestWithChainSpecInvalid(t *testing.T) {
+	noSlots := spec.BaseSpec()
+	noSlots.SlotsPerEpoch = 0
+	noDepositContract := spec.BaseSpec()
+	noDepositContract.DepositContractAddress = common.ExecutionAddress{}
+
+	for _, data := range []chain.SpecData[
+		common.DomainType, math.Epoch, common.ExecutionAddress, math.Slot, any,
+	]{noSlots, noDepositContract} {
+		_, err := newTestBuilder(
+			WithChainSpec[types.NodeI](chain.NewChainSpec(data)),
+		).Build()
+		require.ErrorIs(t, err, ErrChainSpecInvalid)
+	}
+}
+
+func TestChainSpecFlag(t *testing.T) {
+	t.Setenv("CHAIN_SPEC", "")
+	depositContract := common.HexToAddress(
+		"0x4242424242424242424242424242424242424242",
+	)
+	for name, chainID := range map[string]uint64{
+		spec.Devnet:  80087,
+		spec.Testnet: 80084,
+	} {
+		t.Run(name, func(t *testing.T) {
+			cmd, container, err := newTestBuilder().buildRootCmd()
+			require.NoError(t, err)
+
+			var serverCtx *server.Context
+			cmd.PersistentFlags().String(flags.FlagHome, "", "")
+			cmd.AddCommand(&cobra.Command{
+				Use: "probe",
+				RunE: func(cmd *cobra.Command, _ []string) error {
+					serverCtx = server.GetServerContextFromCmd(cmd)
+					return nil
+				},
+			})
+			cmd.SetArgs([]string{
+				"probe", "--" + flags.FlagHome, t.TempDir(),
+				"--" + beaconflags.ChainSpec, name,
+			})
+			require.NoError(t, cmd.Execute())
+
+			// Both the commands and the application use the selected spec.
+			require.Equal(t, chainID, container.ChainSpec.DepositEth1ChainID())
+			require.Equal(
+				t, depositContract,
+				container.ChainSpec.DepositContractAddress(),
+			)
+			cs, err := components.ProvideChainSpec(components.ChainSpecInput{
+				AppOpts: serverCtx.Viper,
+			})
+			require.NoError(t, err)
+			require.Equal(t, chainID, cs.DepositEth1ChainID())
+			require.Equal(t, depositContract, cs.DepositContractAddress())
+		})
+	}
+}
+
+func TestNodeInfo(t *testing.T) {
+	t.Setenv("CHAIN_SPEC", "")
+	node, err := newTestBuilder(
+		WithName[types.NodeI]("testd"),
+		WithChainSpec[types.NodeI](spec.DevnetChainSpec()),
+	).Build()
+	require.NoError(t, err)
+	require.Equal(t, types.NodeInfo{
+		Name:          "testd",
+		Version:       version.Version,
+		ChainID:       80087,
+		ChainSpecName: spec.Devnet,
+	}, node.NodeInfo())
+
+	data := spec.BaseSpec()
+	data.DepositEth1ChainID = 1234
+	node, err = newTestBuilder(
+		WithChainSpec[types.NodeI](chain.NewChainSpec(data)),
+	).Build()
+	require.NoError(t, err)
+	require.Equal(t, uint64(1234), node.NodeInfo().ChainID)
+	require.Equal(t, CustomChainSpecName, node.NodeInfo().ChainSpecName)
+
+	// The info follows the chain spec selected by the chain-spec flag.
+	nb := newTestBuilder()
+	node, err = nb.Build()
+	require.NoError(t, err)
+	require.Equal(t, spec.Testnet, node.NodeInfo().ChainSpecName)
+	cmd, _, err := nb.buildRootCmd()
+	require.NoError(t, err)
+	cmd.PersistentFlags().String(flags.FlagHome, "", "")
+	cmd.AddCommand(&cobra.Command{
+		Use:  "probe",
+		RunE: func(*cobra.Command, []string) error { return nil },
+	})
+	cmd.SetArgs([]string{
+		"probe", "--" + flags.FlagHome, t.TempDir(),
+		"--" + beaconflags.ChainSpec, spec.Devnet,
+	})
+	require.NoError(t, cmd.Execute())
+	require.Equal(t, spec.Devnet, node.NodeInfo().ChainSpecName)
+	require.Equal(t, uint64(80087), node.NodeInfo().ChainID)
+}
+
+func TestChainSpecFlagUnknown(t *testing.T) {
+	for name, expected := range map[string]error{
+		"localnet":   spec.ErrUnknownChainSpec,
+		spec.Mainnet: spec.ErrChainSpecUnavailable,
+	} {
+		cmd, _, err := newTestBuilder().buildRootCmd()
+		require.NoError(t, err)
+		cmd.PersistentFlags().String(flags.FlagHome, "", "")
+		cmd.AddCommand(&cobra.Command{
+			Use:  "probe",
+			RunE: func(*cobra.Command, []string) error { return nil },
+		})
+		cmd.SetArgs([]string{
+			"probe", "--" + flags.FlagHome, t.TempDir(),
+			"--" + beaconflags.ChainSpec, name,
+		})
+		err = cmd.Execute()
+		require.ErrorIs(t, err, expected, "chain spec %q", name)
+		require.ErrorContains(t, err, "one of devnet, testnet")
+	}
+}
+
+func TestWithHealthServer(t *testing.T) {
+	nb := newTestBuilder()
+	require.Empty(t, nb.supplies())
+
+	WithHealthServer[types.NodeI]("127.0.0.1:0")(nb)
+	WithHealthServer[types.NodeI]("127.0.0.1:0")(nb)
+	require.Len(t, nb.providers(), 1)
+	require.True(
+		t, isSameComponent(nb.providers()[0], components.ProvideHealthServer),
+	)
+	require.Equal(t, []any{&health.Config{
+		Addr:       "127.0.0.1:0",
+		MaxSyncLag: health.DefaultMaxSyncLag,
+	}}, nb.supplies())
+}
+
+func TestWithAdminSocket(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "admin.sock")
+	nb := newTestBuilder(WithAdminSocket[types.NodeI](path))
+	require.Len(t, nb.providers(), 1)
+	require.True(
+		t, isSameComponent(nb.providers()[0], components.ProvideAdminServer),
+	)
+	require.Equal(
+		t, []any{&admin.Config{SocketPath: path}}, nb.supplies(),
+	)
+
+	_, _, err := nb.BuildWithContainer()
+	require.NoError(t, err)
+}
+
+func TestWithDAReadReplica(t *testing.T) {
+	replica := filedb.NewRangeDB(filedb.NewInMemoryDB())
+	nb := newTestBuilder(WithDAReadReplica[types.NodeI](replica))
+	require.Equal(t, []any{&dastore.Config{
+		CompactionInterval: dastore.DefaultCompactionInterval,
+		ReadReplica:        replica,
+	}}, nb.supplies())
+}
+
+func TestWithDACompactionInterval(t *testing.T) {
+	nb := newTestBuilder()
+	require.Empty(t, nb.supplies())
+
+	// A zero interval disables compaction rather than using the default.
+	WithDACompactionInterval[types.NodeI](0)(nb)
+	require.Equal(
+		t, []any{&dastore.Config{CompactionInterval: 0}}, nb.supplies(),
+	)
+
+	WithDACompactionInterval[types.NodeI](time.Minute)(nb)
+	require.Equal(
+		t, []any{&dastore.Config{CompactionInterval: time.Minute}},
+		nb.supplies(),
+	)
+}
+
+func TestWithTelemetry(t *testing.T) {
+	nb := newTestBuilder()
+	require.Empty(t, nb.supplies())
+
+	cfg := TelemetryConfig{
+		Type:       metrics.SinkTypeStatsd,
+		Address:    "127.0.0.1:8125",
+		GlobalTags: map[string]string{"chain": "devnet"},
+	}
+	WithTelemetry[types.NodeI](cfg)(nb)
+	require.NoError(t, nb.validate())
+	require.Equal(t, []any{&cfg}, nb.supplies())
+}
+
+func TestWithTracer(t *testing.T) {
+	nb := newTestBuilder()
+	require.Empty(t, nb.supplies())
+
+	tp := noop.NewTracerProvider()
+	WithTracer[types.NodeI](tp)(nb)
+	require.NoError(t, nb.validate())
+	require.Equal(t, []any{tp}, nb.supplies())
+}
+
+func TestWithImportConcurrency(t *testing.T) {
+	nb := newTestBuilder()
+	require.Empty(t, nb.supplies())
+
+	WithImportConcurrency[types.NodeI](4)(nb)
+	require.NoError(t, nb.validate())
+	require.Equal(
+		t, []any{&blockchain.Config{ImportConcurrency: 4}}, nb.supplies(),
+	)
+}
+
+func TestWithImportRateLimit(t *testing.T) {
+	nb := newTestBuilder()
+	WithImportRateLimit[types.NodeI](5)(nb)
+	require.NoError(t, nb.validate())
+
+	cfg := blockchain.DefaultConfig()
+	cfg.ImportRateLimit = 5
+	require.Equal(t, []any{&cfg}, nb.supplies())
+
+	WithImportConcurrency[types.NodeI](4)(nb)
+	require.Equal(t, []any{&blockchain.Config{
+		ImportConcurrency: 4,
+		ImportRateLimit:   5,
+	}}, nb.supplies())
+}
+
+func TestWithBlobVerifyWorkers(t *testing.T) {
+	nb := newTestBuilder()
+	WithBlobVerifyWorkers[types.NodeI](3)(nb)
+	require.NoError(t, nb.validate())
+	require.Equal(
+		t, []any{&dablob.Config{VerifyWorkers: 3}}, nb.supplies(),
+	)
+}
+
+func TestWithEngineEndpoint(t *testing.T) {
+	nb, appOpts := newStandardBuilder(t)
+	WithEngineEndpoint[types.NodeI](
+		"http://localhost:9551", "../../../../testing/files/jwt.hex",
+	)(nb)
+	require.NoError(t, nb.validate())
+	appOpts.Set(beaconflags.RPCDialURL, "http://localhost:8551")
+	appOpts.Set(beaconflags.JWTSecretPath, "missing.hex")
+
+	// The engine endpoint overrides the one set in the app options.
+	var (
+		cfg    *config.Config
+		secret *jwt.Secret
+	)
+	require.NoError(t, depinject.Inject(
+		depinject.Configs(
+			nb.depInjectCfg,
+			depinject.Provide(nb.providers()...),
+			depinject.Supply(nb.supplies(appOpts, log.NewNopLogger())...),
+		),
+		&cfg,
+		&secret,
+	))
+	require.Equal(t, "http://localhost:9551", cfg.Engine.RPCDialURL.String())
+	require.Equal(
+		t, "../../../../testing/files/jwt.hex", cfg.Engine.JWTSecretPath,
+	)
+	require.NotNil(t, secret)
+}
+
+func TestWithEngineEndpointInvalidJWTPath(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "jwt.hex")
+	_, err := newTestBuilder(WithEngineEndpoint[types.NodeI](
+		"http://localhost:8551", path,
+	)).Build()
+	require.ErrorIs(t, err, ErrInvalidEngineEndpoint)
+	require.ErrorIs(t, err, os.ErrNotExist)
+	require.ErrorContains(t, err, "failed to load jwt secret from "+
+		strconv.Quote(path))
+}
+
+func TestWithProposerTimeout(t *testing.T) {
+	nb, appOpts := newStandardBuilder(t,
+		WithProposerTimeout[types.NodeI](250*time.Millisecond),
+	)
+	appOpts.Set("beacon-kit.validator.proposer-timeout", "5s")
+
+	// The proposer timeout overrides the one set in the app options.
+	var cfg *config.Config
+	require.NoError(t, depinject.Inject(
+		depinject.Configs(
+			nb.depInjectCfg,
+			depinject.Provide(nb.providers()...),
+			depinject.Supply(nb.supplies(appOpts, log.NewNopLogger())...),
+		),
+		&cfg,
+	))
+	require.Equal(t, 250*time.Millisecond, cfg.Validator.ProposerTimeout)
+}
+
+func TestWithConsensusParams(t *testing.T) {
+	params := &cmtproto.ConsensusParams{
+		Block: &cmtproto.BlockParams{MaxBytes: 8 << 20, MaxGas: 30_000_000},
+	}
+	nb, appOpts := newStandardBuilder(t, WithConsensusParams[types.NodeI](params))
+	require.NoError(t, nb.validate())
+
+	// The overrides reach the chain spec the node is built with, the params
+	// that are not overridden are those of the chain spec.
+	var cs primitives.ChainSpec
+	require.NoError(t, depinject.Inject(
+		depinject.Configs(
+			nb.depInjectCfg,
+			depinject.Provide(nb.providers()...),
+			depinject.Supply(nb.supplies(appOpts, log.NewNopLogger())...),
+		),
+		&cs,
+	))
+	resolved, ok := cs.GetCometBFTConfigForSlot(0).(*cmttypes.ConsensusParams)
+	require.True(t, ok)
+	require.Equal(t, int64(8<<20), resolved.Block.MaxBytes)
+	require.Equal(t, int64(30_000_000), resolved.Block.MaxGas)
+	specParams, ok := spec.TestnetChainSpec().
+		GetCometBFTConfigForSlot(0).(*cmttypes.ConsensusParams)
+	require.True(t, ok)
+	require.Equal(t, specParams.Evidence, resolved.Evidence)
+
+	// The chain spec set on the builder is overridden as well.
+	_, container, err := newTestBuilder(
+		WithChainSpec[types.NodeI](spec.DevnetChainSpec()),
+		WithConsensusParams[types.NodeI](params),
+	).BuildWithContainer()
+	require.NoError(t, err)
+	resolved, ok = container.ChainSpec.
+		GetCometBFTConfigForSlot(0).(*cmttypes.ConsensusParams)
+	require.True(t, ok)
+	require.Equal(t, int64(8<<20), resolved.Block.MaxBytes)
+
+	// A block max bytes that cannot hold the blobs of a block is rejected,
+	// even if the params are valid to CometBFT.
+	err = newTestBuilder(WithConsensusParams[types.NodeI](
+		&cmtproto.ConsensusParams{
+			Block: &cmtproto.BlockParams{MaxBytes: 1 << 10, MaxGas: -1},
+			Evidence: &cmtproto.EvidenceParams{
+				MaxAgeNumBlocks: specParams.Evidence.MaxAgeNumBlocks,
+				MaxAgeDuration:  specParams.Evidence.MaxAgeDuration,
+			},
+		},
+	)).validate()
+	require.ErrorIs(t, err, ErrInvalidConsensusParams)
+	require.ErrorContains(t, err, "cannot hold")
+}
+
+func TestWithInitialHeight(t *testing.T) {
+	nb := newTestBuilder(WithInitialHeight[types.NodeI](100))
+	require.NoError(t, nb.validate())
+
+	serverCtx := runPreRun(t, nb)
+	require.Equal(t, int64(100), serverCtx.Viper.GetInt64(flags.FlagInitHeight))
+
+	// The genesis written by the init command starts at the initial height.
+	cmd, _, err := nb.buildRootCmd()
+	require.NoError(t, err)
+	initCmd, _, err := cmd.Find([]string{"init"})
+	require.NoError(t, err)
+	height, err := initCmd.Flags().GetInt64(flags.FlagInitHeight)
+	require.NoError(t, err)
+	require.Equal(t, int64(100), height)
+}
+
+func TestWithMinGasPrices(t *testing.T) {
+	for _, prices := range []string{"0.025stake,1uatom", ""} {
+		nb := newTestBuilder(WithMinGasPrices[types.NodeI](prices))
+		require.NoError(t, nb.validate(), "prices %q", prices)
+
+		serverCtx := runPreRun(t, nb)
+		require.Equal(
+			t, prices, serverCtx.Viper.GetString(server.FlagMinGasPrices),
+		)
+	}
+}
+
+func TestWithSeedsAndPersistentPeers(t *testing.T) {
+	const (
+		seed = "0123456789abcdef0123456789abcdef01234567@seed.example.com:26656"
+		peer = "89abcdef0123456789abcdef0123456789abcdef@10.0.0.1:26656"
+	)
+	nb := newTestBuilder(
+		WithSeeds[types.NodeI](seed),
+		WithPersistentPeers[types.NodeI](seed, peer),
+	)
+	require.NoError(t, nb.validate())
+
+	serverCtx := runPreRun(t, nb)
+	require.Equal(t, seed, serverCtx.Config.P2P.Seeds)
+	require.Equal(t, seed+","+peer, serverCtx.Config.P2P.PersistentPeers)
+
+	for _, addr := range []string{
+		"10.0.0.1:26656",
+		"0123@10.0.0.1:26656",
+		"89abcdef0123456789abcdef0123456789abcdef@10.0.0.1",
+		"89abcdef0123456789abcdef0123456789abcdef@:26656",
+		"89abcdef0123456789abcdef0123456789abcdef@10.0.0.1:port",
+	} {
+		err := newTestBuilder(WithPersistentPeers[types.NodeI](addr)).validate()
+		require.ErrorIs(t, err, ErrInvalidPeerAddress, "address %q", addr)
+	}
+}
+
+func TestWithPrometheusListenAddr(t *testing.T) {
+	nb := newTestBuilder(WithPrometheusListenAddr[types.NodeI]("127.0.0.1:0"))
+	require.NoError(t, nb.validate())
+
+	serverCtx := runPreRun(t, nb)
+	instrumentation := serverCtx.Config.Instrumentation
+	require.True(t, instrumentation.Prometheus)
+	require.Equal(t, "127.0.0.1:0", instrumentation.PrometheusListenAddr)
+
+	for _, addr := range []string{"127.0.0.1", "127.0.0.1:port", ":70000"} {
+		err := newTestBuilder(
+			WithPrometheusListenAddr[types.NodeI](addr),
+		).validate()
+		require.ErrorIs(
+			t, err, ErrInvalidPrometheusListenAddr, "address %q", addr,
+		)
+	}
+}
+
+// writeGenesisFile writes a genesis file with the given beacon genesis to a
+// temporary directory and returns its path.
+func writeGenesisFile(t *testing.T, beaconGenesis any) string {
+	t.Helper()
+	bz, err := json.Marshal(beaconGenesis)
+	require.NoError(t, err)
+	appState, err := json.Marshal(map[string]json.RawMessage{"beacon": bz})
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "genesis.json")
+	require.NoError(t, genutiltypes.NewAppGenesisWithVersion(
+		"beacond-test", appState,
+	).SaveAs(path))
+	return path
+}
+
+func TestWithGenesisFile(t *testing.T) {
+	path := writeGenesisFile(t, genesis.DefaultGenesisDeneb())
+	nb := newTestBuilder(
+		WithChainSpec[types.NodeI](spec.DevnetChainSpec()),
+		WithGenesisFile[types.NodeI](path),
+	)
+	require.NoError(t, nb.validate())
+
+	serverCtx := runPreRun(t, nb)
+	require.Equal(t, path, serverCtx.Config.GenesisFile())
+
+	// A genesis file that does not parse is rejected.
+	malformed := filepath.Join(t.TempDir(), "genesis.json")
+	require.NoError(t, os.WriteFile(malformed, []byte(`{"app_state":`), 0o600))
+	err := newTestBuilder(WithGenesisFile[types.NodeI](malformed)).validate()
+	require.ErrorIs(t, err, ErrInvalidGenesisFile)
+	require.ErrorContains(t, err, malformed)
+
+	// A genesis file of another fork is rejected.
+	beaconGenesis := genesis.DefaultGenesisDeneb()
+	beaconGenesis.ForkVersion = primitives.Version{0xff}
+	err = newTestBuilder(WithGenesisFile[types.NodeI](
+		writeGenesisFile(t, beaconGenesis),
+	)).validate()
+	require.ErrorIs(t, err, ErrInvalidGenesisFile)
+	require.ErrorContains(t, err, "does not match")
+}
+
+// closerRecorder is a node recording the closers registered with it.
+type closerRecorder struct {
+	types.NodeI
+	closers map[string]func() error
+}
+
+func (r *closerRecorder) RegisterCloser(name string, fn func() error) {
+	r.closers[name] = fn
+}
+
+func TestWithPprof(t *testing.T) {
+	// Disabled by default.
+	nb := newTestBuilder()
+	recorder := &closerRecorder{
+		NodeI:   nb.node,
+		closers: make(map[string]func() error),
+	}
+	nb.node = recorder
+	require.NoError(t, nb.startPprofServer(log.NewNopLogger()))
+	require.Empty(t, recorder.closers)
+
+	// Reserve a free port for the server.
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	WithPprof[types.NodeI](addr)(nb)
+	require.NoError(t, nb.startPprofServer(log.NewNopLogger()))
+	require.Contains(t, recorder.closers, "pprof server")
+
+	req, err := http.NewRequestWithContext(
+		context.Background(), http.MethodGet,
+		"http://"+addr+"/debug/pprof/", http.NoBody,
+	)
+	require.NoError(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	// Closing the node shuts the server down.
+	require.NoError(t, recorder.closers["pprof server"]())
+	_, err = http.DefaultClient.Do(req)
+	require.Error(t, err)
+}
+
+func TestWithKeyringBackend(t *testing.T) {
+	home := components.DefaultNodeHome
+	components.DefaultNodeHome = t.TempDir()
+	t.Cleanup(func() { components.DefaultNodeHome = home })
+
+	_, container, err := newTestBuilder(
+		WithKeyringBackend[types.NodeI](keyring.BackendTest),
+	).BuildWithContainer()
+	require.NoError(t, err)
+	kr := container.AutoCLIOpts.Keyring
+	require.NotNil(t, kr)
+
+	// The test backend stores keys unencrypted, so keys written to it are
+	// readable without a passphrase.
+	kb, err := keyring.New(
+		sdk.KeyringServiceName(),
+		keyring.BackendTest,
+		container.ClientCtx.KeyringDir,
+		nil,
+		container.ClientCtx.Codec,
+	)
+	require.NoError(t, err)
+	record, _, err := kb.NewMnemonic(
+		"alice",
+		keyring.English,
+		sdk.FullFundraiserPath,
+		keyring.DefaultBIP39Passphrase,
+		hd.Secp256k1,
+	)
+	require.NoError(t, err)
+	want, err := record.GetAddress()
+	require.NoError(t, err)
+
+	got, err := kr.LookupAddressByKeyName("alice")
+	require.NoError(t, err)
+	require.Equal(t, want.Bytes(), got)
+
+	_, err = newTestBuilder(
+		WithKeyringBackend[types.NodeI]("kwallet"),
+	).Build()
+	require.ErrorIs(t, err, ErrInvalidKeyringBackend)
+	require.ErrorIs(t, err, components.ErrUnsupportedKeyringBackend)
+}
+
+// testKeyring is the keyring provided by ProvideTestKeyring.
+type testKeyring struct {
+	clientv2keyring.Keyring
+}
+
+func ProvideTestKeyring() (clientv2keyring.Keyring, error) {
+	return &testKeyring{}, nil
+}
+
+func TestWithProviderOverride(t *testing.T) {
+	nb := newTestBuilder(
+		WithProviderOverride[types.NodeI](ProvideTestKeyring),
+		WithComponents[types.NodeI](
+			components.DefaultComponentsWithStandardTypes()...,
+		),
+	)
+	_, container, err := nb.BuildWithContainer()
+	require.NoError(t, err)
+	require.IsType(t, &testKeyring{}, container.AutoCLIOpts.Keyring)
+	require.False(t, containsComponent(
+		nb.rootProviders(), components.ProvideKeyring,
+	))
+
+	// The override does not prevent the application from resolving.
+	appOpts := runPreRun(t, nb).Viper
+	appOpts.Set(
+		beaconflags.KZGTrustedSetupPath,
+		"../../../../testing/files/kzg-trusted-setup.json",
+	)
+	appOpts.Set(beaconflags.JWTSecretPath, "../../../../testing/files/jwt.hex")
+	_, err = nb.DepositStoreCreator(log.NewNopLogger(), appOpts)
+	require.NoError(t, err)
+
+	_, err = newTestBuilder(
+		WithProviderOverride[types.NodeI](func() error { return nil }),
+	).Build()
+	require.ErrorIs(t, err, ErrInvalidProviderOverride)
+}
+
+// releaseSlowKeyring is closed to let ProvideSlowTestKeyring return.
+var releaseSlowKeyring = make(chan struct{})
+
+// ProvideSlowTestKeyring provides a test keyring once releaseSlowKeyring is
+// closed.
+func ProvideSlowTestKeyring() (clientv2keyring.Keyring, error) {
+	<-releaseSlowKeyring
+	return &testKeyring{}, nil
+}
+
+func TestWithBuildTimeout(t *testing.T) {
+	// Resolving the dependencies of the node hangs until the test is over.
+	defer close(releaseSlowKeyring)
+
+	_, err := newTestBuilder(
+		WithProviderOverride[types.NodeI](ProvideSlowTestKeyring),
+		WithBuildTimeout[types.NodeI](50*time.Millisecond),
+	).Build()
+	require.ErrorIs(t, err, ErrBuildTimeout)
+	require.ErrorContains(t, err, "not resolved within 50ms")
+}
+
+// fakeDepositStore is a deposit store kept in memory, standing in for a store
+// backed by a database other than the default one.
+type fakeDepositStore struct {
+	components.DepositStore
+	deposits map[uint64]*ctypes.Deposit
+}
+
+func (s *fakeDepositStore) GetDepositsByIndex(
+	startIndex, numView uint64,
+) ([]*ctypes.Deposit, error) {
+	deposits := []*ctypes.Deposit{}
+	for i := startIndex; i < startIndex+numView; i++ {
+		d, ok := s.deposits[i]
+		if !ok {
+			break
+		}
+		deposits = append(deposits, d)
+	}
+	return deposits, nil
+}
+
+func (s *fakeDepositStore) Count() (uint64, error) {
+	return uint64(len(s.deposits)), nil
+}
+
+// testDepositStore is the deposit store provided by ProvideFakeDepositStore.
+var testDepositStore = &fakeDepositStore{
+	deposits: map[uint64]*ctypes.Deposit{0: {Index: 0}, 1: {Index: 1}},
+}
+
+func ProvideFakeDepositStore() components.DepositStore {
+	return testDepositStore
+}
+
+func TestDepositStoreOverride(t *testing.T) {
+	nb, appOpts := newStandardBuilder(t,
+		WithProviderOverride[types.NodeI](ProvideFakeDepositStore),
+	)
+	cfg := depinject.Configs(
+		nb.depInjectCfg,
+		depinject.Provide(nb.providers()...),
+		depinject.Supply(nb.supplies(appOpts, log.NewNopLogger())...),
+	)
+
+	// The runtime and the services it drives are wired to the fake store.
+	var (
+		beaconRuntime  *components.BeaconKitRuntime
+		storageBackend components.StorageBackend
+		healthChecker  *health.Checker
+		blockStore     *components.BlockStore
+	)
+	require.NoError(t, depinject.Inject(
+		cfg, &beaconRuntime, &storageBackend, &healthChecker, &blockStore,
+	))
+	require.NotNil(t, beaconRuntime)
+	require.Same(
+		t, testDepositStore,
+		storageBackend.DepositStore(context.Background()),
+	)
+	// The block store is opened again by the deposit store creator.
+	require.NoError(t, blockStore.Close())
+
+	// Commands reading the deposits of the node read them from the fake
+	// store as well.
+	depositStore, err := nb.DepositStoreCreator(log.NewNopLogger(), appOpts)
+	require.NoError(t, err)
+	deposits, err := depositStore.GetDepositsByIndex(0, 3)
+	require.NoError(t, err)
+	require.Len(t, deposits, 2)
+}
+
+func TestComponentValidation(t *testing.T) {
+	// A nil provider is rejected before depinject panics on it.
+	_, err := newTestBuilder(WithComponents[types.NodeI](
+		components.ProvideChainSpec, (func() int)(nil),
+	)).Build()
+	require.ErrorIs(t, err, ErrInvalidComponents)
+	require.ErrorContains(t, err, "component 1 (func() int) is nil")
+
+	// Two providers of the same output type are rejected, naming both.
+	_, err = newTestBuilder(WithComponents[types.NodeI](
+		components.ProvideChainSpec,
+		components.ProvideDepositStore[*ctypes.Deposit],
+		ProvideFakeDepositStore,
+	)).Build()
+	require.ErrorIs(t, err, ErrInvalidComponents)
+	require.ErrorContains(t, err, fmt.Sprintf(
+		"components 1 (%s) and 2 (%s) both provide %s",
+		componentName(components.ProvideDepositStore[*ctypes.Deposit]),
+		componentName(ProvideFakeDepositStore),
+		reflect.TypeOf((*components.DepositStore)(nil)).Elem(),
+	))
+
+	// Providers replaced by an override are not provided, so they may share
+	// output types.
+	require.NoError(t, newTestBuilder(
+		WithComponents[types.NodeI](
+			components.ProvideDepositStore[*ctypes.Deposit],
+			ProvideFakeDepositStore,
+		),
+		WithProviderOverride[types.NodeI](ProvideFakeDepositStore),
+	).validateComponents())
+}
+
+// newTestSidecar returns the sidecar of a blob included at slot 1, with an
+// inclusion proof that is valid under the given chain spec for the body root
+// of its block header.
+func newTestSidecar(
+	t *testing.T,
+	cs primitives.ChainSpec,
+) *datypes.BlobSidecar {
+	t.Helper()
+	sidecar := &datypes.BlobSidecar{
+		BeaconBlockHeader: &ctypes.BeaconBlockHeader{
+			BeaconBlockHeaderBase: ctypes.BeaconBlockHeaderBase{Slot: 1},
+		},
+		KzgCommitment:  eip4844.KZGCommitment{1},
+		InclusionProof: make([][32]byte, 8),
+	}
+	leaf, err := sidecar.KzgCommitment.HashTreeRoot()
+	require.NoError(t, err)
+	sidecar.BeaconBlockHeader.BodyRoot = merkle.RootFromBranch(
+		leaf, sidecar.InclusionProof, 8, ctypes.BlockBodyKZGOffset(1, cs),
+	)
+	return sidecar
+}
+
+func TestWithInMemoryStores(t *testing.T) {
+	nb := newTestBuilder(
+		WithComponents[types.NodeI](
+			components.ProvideAvailibilityStore[*ctypes.BeaconBlockBody],
+		),
+		WithInMemoryStores[types.NodeI](),
+	)
+
+	var availabilityStore *dastore.Store[*ctypes.BeaconBlockBody]
+	require.NoError(t, depinject.Inject(
+		depinject.Configs(
+			depinject.Provide(nb.providers()...),
+			depinject.Supply(
+				log.NewNopLogger(), chain.NewChainSpec(spec.BaseSpec()),
+			),
+		),
+		&availabilityStore,
+	))
+
+	sidecar := newTestSidecar(t, chain.NewChainSpec(spec.BaseSpec()))
+	require.NoError(t, availabilityStore.Persist(1, &datypes.BlobSidecars{
+		Sidecars: []*datypes.BlobSidecar{sidecar},
+	}))
+
+	sidecars, err := availabilityStore.GetSidecarsRange(
+		context.Background(), 1, 1,
+	)
+	require.NoError(t, err)
+	require.Len(t, sidecars, 1)
+	require.Equal(t, []*datypes.BlobSidecar{sidecar}, sidecars[1].Sidecars)
+}
+
+func TestWithBlobVerification(t *testing.T) {
+	for _, enabled := range []bool{true, false} {
+		nb, appOpts := newStandardBuilder(t)
+		WithBlobVerification[types.NodeI](enabled)(nb)
+
+		buf := &bytes.Buffer{}
+		var verifier kzg.BlobProofVerifier
+		require.NoError(t, depinject.Inject(
+			depinject.Configs(
+				nb.depInjectCfg,
+				depinject.Provide(nb.providers()...),
+				depinject.Supply(nb.supplies(
+					appOpts, log.NewLogger(buf, log.ColorOption(false)),
+				)...),
+			),
+			&verifier,
+		))
+
+		// An all-zero proof and commitment are not valid curve points.
+		err := verifier.VerifyBlobProof(
+			&eip4844.Blob{}, eip4844.KZGProof{}, eip4844.KZGCommitment{},
+		)
+		if enabled {
+			require.Error(t, err)
+			require.NotContains(t, buf.String(), "DISABLED")
+		} else {
+			require.NoError(t, err)
+			require.Contains(t, buf.String(), "DISABLED")
+		}
+	}
+}
+
+// testModule is an application module providing a custom query command.
+type testModule struct {
+	name string
+}
+
+func (m testModule) Name() string { return m.name }
+
+func (testModule) IsAppModule() {}
+
+func (testModule) IsOnePerModuleType() {}
+
+func (m testModule) GetQueryCmd() *cobra.Command {
+	return &cobra.Command{Use: m.name}
+}
+
+func TestWithExtraModules(t *testing.T) {
+	nb := newTestBuilder(WithExtraModules[types.NodeI](
+		testModule{name: "foo"}, testModule{name: "bar"},
+	))
+	cmd, container, err := nb.buildRootCmd()
+	require.NoError(t, err)
+
+	mm := container.ModuleManager
+	require.Contains(t, mm.Modules, "foo")
+	require.Contains(t, mm.Modules, "bar")
+	require.Contains(t, container.AutoCLIOpts.Modules, "foo")
+	for _, order := range [][]string{mm.OrderInitGenesis, mm.OrderEndBlockers} {
+		require.Equal(t, []string{"foo", "bar"}, order[len(order)-2:])
+	}
+
+	queryCmd, _, err := cmd.Find([]string{"query", "foo"})
+	require.NoError(t, err)
+	require.Equal(t, "foo", queryCmd.Name())
+}
+
+func TestWithExtraModulesDuplicate(t *testing.T) {
+	for _, mods := range [][]module.AppModule{
+		{testModule{name: "foo"}, testModule{name: "foo"}},
+		{testModule{name: beacon.ModuleName}},
+	} {
+		_, err := newTestBuilder(
+			WithExtraModules[types.NodeI](mods...),
+		).Build()
+		require.ErrorIs(t, err, ErrDuplicateModule)
+	}
+}
+
+// newStandardBuilder returns a NodeBuilder with the standard components and
+// the app options of a node using the default configuration.
+func newStandardBuilder(
+	t *testing.T,
+	opts ...Opt[types.NodeI],
+) (*NodeBuilder[types.NodeI], *viper.Viper) {
+	t.Helper()
+	nb := newTestBuilder(append([]Opt[types.NodeI]{
+		WithComponents[types.NodeI](
+			components.DefaultComponentsWithStandardTypes()...,
+		),
+	}, opts...)...)
+	appOpts := runPreRun(t, nb).Viper
+	appOpts.Set(
+		beaconflags.KZGTrustedSetupPath,
+		"../../../../testing/files/kzg-trusted-setup.json",
+	)
+	appOpts.Set(beaconflags.JWTSecretPath, "../../../../testing/files/jwt.hex")
+	return nb, appOpts
+}
+
+func TestStorageBackendCreator(t *testing.T) {
+	nb, appOpts := newStandardBuilder(t)
+	backend, err := nb.StorageBackendCreator(
+		log.NewNopLogger(), dbm.NewMemDB(), appOpts,
+	)
+	require.NoError(t, err)
+
+	// Nothing has been committed to the database yet.
+	_, err = backend.StateAtSlot(context.Background(), 1)
+	require.ErrorIs(t, err, storage.ErrSlotOutOfRange)
+}
+
+func TestDepositStoreCreator(t *testing.T) {
+	nb, appOpts := newStandardBuilder(t)
+	store, err := nb.DepositStoreCreator(log.NewNopLogger(), appOpts)
+	require.NoError(t, err)
+
+	deposits, err := store.GetDepositsByIndex(0, 1)
+	require.NoError(t, err)
+	require.Empty(t, deposits)
+}
+
+func TestAvailabilityStoreCreator(t *testing.T) {
+	nb, appOpts := newStandardBuilder(t)
+	store, err := nb.AvailabilityStoreCreator(log.NewNopLogger(), appOpts)
+	require.NoError(t, err)
+
+	sidecars, err := store.GetSidecarsRange(context.Background(), 1, 1)
+	require.NoError(t, err)
+	require.Empty(t, sidecars)
+}
+
+func TestWithStoreDirs(t *testing.T) {
+	daDir := filepath.Join(t.TempDir(), "blobs")
+	depositDir := filepath.Join(t.TempDir(), "deposits")
+	nb, appOpts := newStandardBuilder(t,
+		WithDAStoreDir[types.NodeI](daDir),
+		WithDepositStoreDir[types.NodeI](depositDir),
+	)
+	home := appOpts.GetString(flags.FlagHome)
+
+	depositStore, err := nb.DepositStoreCreator(log.NewNopLogger(), appOpts)
+	require.NoError(t, err)
+	deposits, err := depositStore.GetDepositsByIndex(0, 1)
+	require.NoError(t, err)
+	require.Empty(t, deposits)
+	require.DirExists(t, filepath.Join(depositDir, "deposits.db"))
+	require.NoDirExists(t, filepath.Join(home, "data", "deposits.db"))
+	// The deposit store is opened again alongside the availability store.
+	kvStore, ok := depositStore.(*depositdb.KVStore[*ctypes.Deposit])
+	require.True(t, ok)
+	require.NoError(t, kvStore.Close())
+
+	store, err := nb.AvailabilityStoreCreator(log.NewNopLogger(), appOpts)
+	require.NoError(t, err)
+	availabilityStore, ok := store.(*dastore.Store[*ctypes.BeaconBlockBody])
+	require.True(t, ok)
+	require.NoError(t, availabilityStore.Persist(1, &datypes.BlobSidecars{
+		Sidecars: []*datypes.BlobSidecar{
+			newTestSidecar(t, spec.TestnetChainSpec()),
+		},
+	}))
+	entries, err := os.ReadDir(daDir)
+	require.NoError(t, err)
+	require.NotEmpty(t, entries)
+	require.NoDirExists(t, filepath.Join(home, "data", "blobs"))
+}
+
+func TestConfigExport(t *testing.T) {
+	cmd, _, err := newTestBuilder().buildRootCmd()
+	require.NoError(t, err)
+	cmd.PersistentFlags().String(flags.FlagHome, "", "")
+
+	out := filepath.Join(t.TempDir(), "config")
+	cmd.SetArgs([]string{
+		"config", "export", "--out", out, "--" + flags.FlagHome, t.TempDir(),
+	})
+	require.NoError(t, cmd.Execute())
+
+	// The exported app.toml round-trips into the default app config.
+	v := viper.New()
+	v.SetConfigFile(filepath.Join(out, configcmd.AppConfigFileName))
+	require.NoError(t, v.ReadInConfig())
+
+	serverCfg, err := serverconfig.ParseConfig(v)
+	require.NoError(t, err)
+	beaconCfg, err := config.ReadConfigFromAppOpts(v)
+	require.NoError(t, err)
+
+	defaultCfg := reflect.ValueOf(DefaultAppConfig())
+	require.Equal(t, defaultCfg.Field(0).Interface(), *serverCfg)
+	require.Equal(t, defaultCfg.Field(1).Interface(), beaconCfg)
+
+	// The exported config.toml matches the default CometBFT config, except
+	// for the mempool type which is fixed by the CometBFT template.
+	v = viper.New()
+	v.SetConfigFile(filepath.Join(out, configcmd.CometConfigFileName))
+	require.NoError(t, v.ReadInConfig())
+	cometCfg := cmtcfg.DefaultConfig()
+	require.NoError(t, v.Unmarshal(cometCfg))
+	require.Equal(t, DefaultCometConfig().Consensus, cometCfg.Consensus)
+	require.Equal(t, DefaultCometConfig().P2P, cometCfg.P2P)
+	require.Equal(t, DefaultCometConfig().Storage, cometCfg.Storage)
+
+	// Exporting again does not overwrite the files.
+	cmd.SetArgs([]string{
+		"config", "export", "--out", out, "--" + flags.FlagHome, t.TempDir(),
+	})
+	require.ErrorIs(t, cmd.Execute(), configcmd.ErrConfigFileExists)
+}
+
+func TestWithReadOnly(t *testing.T) {
+	nb, appOpts := newStandardBuilder(t)
+	WithReadOnly[types.NodeI]()(nb)
+	cfg := depinject.Configs(
+		nb.depInjectCfg,
+		depinject.Provide(nb.providers()...),
+		depinject.Supply(nb.supplies(appOpts, log.NewNopLogger())...),
+	)
+
+	var (
+		beaconRuntime *components.BeaconKitRuntime
+		registry      *service.Registry
+		signer        crypto.BLSSigner
+	)
+	require.NoError(t, depinject.Inject(
+		cfg, &beaconRuntime, &registry, &signer,
+	))
+
+	// The validator service is not wired, so proposals are refused.
+	var validatorService *validator.Service[
+		*ctypes.BeaconBlock,
+		*ctypes.BeaconBlockBody,
+		components.BeaconState,
+		*datypes.BlobSidecars,
+		components.DepositStore,
+		*ctypes.ForkData,
+	]
+	require.Error(t, depinject.Inject(cfg, &validatorService))
+	require.NotContains(t, registry.Statuses(), "validator")
+	_, err := beaconRuntime.ABCIValidatorMiddleware().PrepareProposalHandler(
+		sdk.Context{}.WithLogger(log.NewNopLogger()),
+		&cmtabci.PrepareProposalRequest{Height: 1},
+	)
+	require.ErrorIs(t, err, components.ErrReadOnly)
+
+	// The signer holds no key.
+	_, err = signer.Sign([]byte("message"))
+	require.ErrorIs(t, err, blssigner.ErrSigningDisabled)
+}
+
+func TestWithReadOnlyRejectsSigningKey(t *testing.T) {
+	nb, appOpts := newStandardBuilder(t)
+	WithReadOnly[types.NodeI]()(nb)
+
+	var signer crypto.BLSSigner
+	err := depinject.Inject(
+		depinject.Configs(
+			nb.depInjectCfg,
+			depinject.Provide(nb.providers()...),
+			depinject.Supply(nb.supplies(
+				appOpts, log.NewNopLogger(), components.LegacyKey{1},
+			)...),
+		),
+		&signer,
+	)
+	require.ErrorIs(t, err, components.ErrSigningKeyInReadOnlyMode)
+}
+
+// errInit is returned by FailingInvoker.
+var errInit = errors.New("init failed")
+
+// FailingInvoker is a depinject invoker that always fails, it must be
+// exported to be registered.
+func FailingInvoker() error { return errInit }
+
+func TestBuildErrors(t *testing.T) {
+	shortSecretPath := filepath.Join(t.TempDir(), "jwt.hex")
+	require.NoError(t, os.WriteFile(shortSecretPath, []byte("0xabcd"), 0o600))
+	// A directory cannot be created beneath a regular file.
+	notDirPath := filepath.Join(shortSecretPath, "blobs")
+
+	for _, tc := range []struct {
+		name  string
+		opts  []Opt[types.NodeI]
+		kind  error
+		cause error
+	}{
+		{
+			name: "missing provider",
+			opts: []Opt[types.NodeI]{
+				WithDepInjectConfig[types.NodeI](depinject.Configs()),
+			},
+			kind: ErrMissingProvider,
+		},
+		{
+			name: "invalid log format",
+			opts: []Opt[types.NodeI]{
+				WithLogFormat[types.NodeI]("logfmt"),
+			},
+			kind: ErrInvalidLogFormat,
+		},
+		{
+			name: "invalid chain spec",
+			opts: []Opt[types.NodeI]{
+				WithChainSpec[types.NodeI](chain.NewChainSpec(
+					chain.SpecData[
+						common.DomainType, math.Epoch,
+						common.ExecutionAddress, math.Slot, any,
+					]{},
+				)),
+			},
+			kind: ErrChainSpecInvalid,
+		},
+		{
+			name: "invalid da compaction interval",
+			opts: []Opt[types.NodeI]{
+				WithDACompactionInterval[types.NodeI](-time.Second),
+			},
+			kind: ErrInvalidDACompactionInterval,
+		},
+		{
+			name: "invalid import concurrency",
+			opts: []Opt[types.NodeI]{
+				WithImportConcurrency[types.NodeI](0),
+			},
+			kind: ErrInvalidImportConcurrency,
+		},
+		{
+			name: "invalid import rate limit",
+			opts: []Opt[types.NodeI]{
+				WithImportRateLimit[types.NodeI](-1),
+			},
+			kind: ErrInvalidImportRateLimit,
+		},
+		{
+			name: "invalid build timeout",
+			opts: []Opt[types.NodeI]{
+				WithBuildTimeout[types.NodeI](0),
+			},
+			kind: ErrInvalidBuildTimeout,
+		},
+		{
+			name: "invalid proposer timeout",
+			opts: []Opt[types.NodeI]{
+				WithProposerTimeout[types.NodeI](-time.Second),
+			},
+			kind: ErrInvalidProposerTimeout,
+		},
+		{
+			name: "invalid consensus params",
+			opts: []Opt[types.NodeI]{
+				WithConsensusParams[types.NodeI](&cmtproto.ConsensusParams{
+					Block: &cmtproto.BlockParams{MaxBytes: 0},
+				}),
+			},
+			kind: ErrInvalidConsensusParams,
+		},
+		{
+			name: "invalid blob verify workers",
+			opts: []Opt[types.NodeI]{
+				WithBlobVerifyWorkers[types.NodeI](0),
+			},
+			kind: ErrInvalidBlobVerifyWorkers,
+		},
+		{
+			name: "engine endpoint with unsupported scheme",
+			opts: []Opt[types.NodeI]{
+				WithEngineEndpoint[types.NodeI](
+					"ws://localhost:8546", "../../../../testing/files/jwt.hex",
+				),
+			},
+			kind: ErrInvalidEngineEndpoint,
+		},
+		{
+			name: "engine endpoint with short jwt secret",
+			opts: []Opt[types.NodeI]{
+				WithEngineEndpoint[types.NodeI](
+					"http://localhost:8551", shortSecretPath,
+				),
+			},
+			kind:  ErrInvalidEngineEndpoint,
+			cause: jwt.ErrLengthMismatch,
+		},
+		{
+			name: "invalid initial height",
+			opts: []Opt[types.NodeI]{
+				WithInitialHeight[types.NodeI](0),
+			},
+			kind: ErrInvalidInitialHeight,
+		},
+		{
+			name: "unwritable da store dir",
+			opts: []Opt[types.NodeI]{
+				WithDAStoreDir[types.NodeI](notDirPath),
+			},
+			kind: ErrInvalidStoreDir,
+		},
+		{
+			name: "unwritable deposit store dir",
+			opts: []Opt[types.NodeI]{
+				WithDepositStoreDir[types.NodeI](notDirPath),
+			},
+			kind: ErrInvalidStoreDir,
+		},
+		{
+			name: "malformed min gas prices",
+			opts: []Opt[types.NodeI]{
+				WithMinGasPrices[types.NodeI]("stake0.025"),
+			},
+			kind: ErrInvalidMinGasPrices,
+		},
+		{
+			name: "invalid peer address",
+			opts: []Opt[types.NodeI]{
+				WithSeeds[types.NodeI]("seed.example.com:26656"),
+			},
+			kind: ErrInvalidPeerAddress,
+		},
+		{
+			name: "invalid telemetry config",
+			opts: []Opt[types.NodeI]{
+				WithTelemetry[types.NodeI](TelemetryConfig{
+					Type:    "datadog",
+					Address: "127.0.0.1:8125",
+				}),
+			},
+			kind:  ErrInvalidTelemetryConfig,
+			cause: metrics.ErrUnsupportedSinkType,
+		},
+		{
+			name: "invalid prometheus listen address",
+			opts: []Opt[types.NodeI]{
+				WithPrometheusListenAddr[types.NodeI]("localhost"),
+			},
+			kind: ErrInvalidPrometheusListenAddr,
+		},
+		{
+			name: "runtime init",
+			opts: []Opt[types.NodeI]{
+				WithDepInjectConfig[types.NodeI](depinject.Configs(
+					DefaultDepInjectConfig(),
+					depinject.Invoke(FailingInvoker),
+				)),
+			},
+			kind:  ErrRuntimeInit,
+			cause: errInit,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := newTestBuilder(tc.opts...).Build()
+			require.ErrorIs(t, err, tc.kind)
+
+			var buildErr *BuildError
+			require.ErrorAs(t, err, &buildErr)
+			require.Equal(t, tc.kind, buildErr.Kind)
+			require.Error(t, buildErr.Cause)
+			if tc.cause != nil {
+				require.ErrorIs(t, err, tc.cause)
+			}
+		})
+	}
+}
+
+func TestMissingProviderHint(t *testing.T) {
+	_, err := newTestBuilder(
+		WithDepInjectConfig[types.NodeI](depinject.Configs()),
+	).Build()
+	require.ErrorIs(t, err, ErrMissingProvider)
+	require.ErrorContains(
+		t, err, "did you forget WithDepInjectConfig(DefaultDepInjectConfig())?",
+	)
+	// The error of depinject is kept for debugging.
+	require.ErrorContains(t, err, "can't resolve type")
+
+	for _, tc := range []struct {
+		provider any
+		hint     string
+	}{
+		{ProvideFromChainSpec, "did you forget WithChainSpec?"},
+		{ProvideFromKeyring, "did you forget WithKeyringBackend?"},
+		{
+			ProvideFromStorageBackend,
+			"did you forget " +
+				"WithComponents(components.ProvideStorageBackend)?",
+		},
+	} {
+		var out int
+		err = wrapInjectError(depinject.Inject(
+			depinject.Provide(tc.provider), &out,
+		))
+		var buildErr *BuildError
+		require.ErrorAs(t, err, &buildErr)
+		require.Equal(t, ErrMissingProvider, buildErr.Kind)
+		require.Equal(t, tc.hint, buildErr.Hint)
+	}
+
+	// Errors of types without a known provider carry no hint.
+	var out int
+	err = wrapInjectError(depinject.Inject(
+		depinject.Provide(ProvideFromString), &out,
+	))
+	var buildErr *BuildError
+	require.ErrorAs(t, err, &buildErr)
+	require.Empty(t, buildErr.Hint)
+}
+
+// ProvideFromChainSpec requires a chain spec.
+func ProvideFromChainSpec(primitives.ChainSpec) int { return 0 }
+
+// ProvideFromKeyring requires a keyring.
+func ProvideFromKeyring(keyring.Keyring) int { return 0 }
+
+// ProvideFromStorageBackend requires a storage backend.
+func ProvideFromStorageBackend(components.StorageBackend) int { return 0 }
+
+// ProvideFromString requires a string.
+func ProvideFromString(string) int { return 0 }
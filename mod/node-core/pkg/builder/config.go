@@ -28,6 +28,7 @@ import (
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/depinject/appconfig"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
 	beacon "github.com/berachain/beacon-kit/mod/node-core/pkg/components/module"
 	beaconv1alpha1 "github.com/berachain/beacon-kit/mod/node-core/pkg/components/module/api/module/v1alpha1"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/config"
@@ -37,6 +38,10 @@ import (
 	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
 )
 
+// TelemetryConfig is the configuration of the sink the node pushes its
+// metrics to, as set by WithTelemetry.
+type TelemetryConfig = metrics.TelemetryConfig
+
 // DefaultAppConfig returns the default configuration for the application.
 func DefaultAppConfig() any {
 	// Define a struct for the custom app configuration.
@@ -20,9 +20,19 @@
 
 package builder
 
+import "time"
+
 const (
+	// DefaultName is the default name of the root command of the node.
+	DefaultName = "beacond"
 	// DefaultAppName is the default name of the application.
 	DefaultAppName = "BeaconKit"
 	// DefaultDescription is the default description of the application.
 	DefaultDescription = "A basic beacon node, usable most standard networks."
+	// CustomChainSpecName is the name reported for a chain spec set with
+	// WithChainSpec that is not one of the known chain specs.
+	CustomChainSpecName = "custom"
+	// DefaultBuildTimeout is the default time the dependencies of the node
+	// are given to be resolved when it is built.
+	DefaultBuildTimeout = 60 * time.Second
 )
@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package builder
+
+import (
+	"cosmossdk.io/client/v2/autocli"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+// Container holds the dependencies that were resolved by the dependency
+// injection framework while building the root command. It allows callers to
+// inspect the wiring of the node without running the injector a second time.
+type Container struct {
+	// AutoCLIOpts are the options used to enhance the root command.
+	AutoCLIOpts autocli.AppOptions
+	// ModuleManager is the module manager of the application.
+	ModuleManager *module.Manager
+	// ClientCtx is the client context the root command was built with.
+	ClientCtx client.Context
+	// ChainSpec is the chain spec the node was built with.
+	ChainSpec primitives.ChainSpec
+}
+
+// chainSpecRef is a chain spec that forwards to the chain spec selected by
+// the chain-spec flag of the root command, such that commands built before
+// the flag is parsed still use the selected chain spec.
+type chainSpecRef struct {
+	primitives.ChainSpec
+}
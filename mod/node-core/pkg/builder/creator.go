@@ -21,20 +21,44 @@
 package builder
 
 import (
+	"context"
 	"io"
+	"path/filepath"
+	"time"
 
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
+	dacmd "github.com/berachain/beacon-kit/mod/cli/pkg/commands/da"
+	depositscmd "github.com/berachain/beacon-kit/mod/cli/pkg/commands/deposits"
+	statecmd "github.com/berachain/beacon-kit/mod/cli/pkg/commands/state"
+	consensustypes "github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	dastore "github.com/berachain/beacon-kit/mod/da/pkg/store"
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/app"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/storage"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/config/flags"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/services/health"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/services/pprof"
 	"github.com/berachain/beacon-kit/mod/primitives"
 	"github.com/berachain/beacon-kit/mod/runtime/pkg/comet"
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdkflags "github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/server"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/viper"
 )
 
+// resolutionMetric is the metric the time spent resolving the dependencies of
+// the node is recorded in.
+const resolutionMetric = "beacon_kit.node.dependency_resolution_duration"
+
 // AppCreator is a function that creates an application.
 // It is necessary to adhere to the types.AppCreator[T] interface.
 func (nb *NodeBuilder[NodeT]) AppCreator(
@@ -48,34 +72,350 @@ func (nb *NodeBuilder[NodeT]) AppCreator(
 		panic("goleveldb is not supported")
 	}
 
-	var chainSpec primitives.ChainSpec
+	// In dry-run mode the application is only resolved, it is neither built
+	// nor are its services started.
+	if cast.ToBool(appOpts.Get(flags.DryRun)) {
+		nb.dryRun(logger, appOpts)
+		return nb.node
+	}
+
+	var (
+		chainSpec      primitives.ChainSpec
+		storageBackend components.StorageBackend
+		blockStore     *components.BlockStore
+		telemetry      metrics.Telemetry
+		healthChecker  *health.Checker
+	)
 	appBuilder := &runtime.AppBuilder{}
+	start := time.Now()
 	if err := depinject.Inject(
 		depinject.Configs(
 			nb.depInjectCfg,
 			depinject.Provide(
-				nb.components...,
+				nb.providers()...,
 			),
 			depinject.Supply(
-				appOpts,
-				logger,
+				nb.supplies(appOpts, logger)...,
 			),
 		),
 		&appBuilder,
 		&chainSpec,
+		&storageBackend,
+		&blockStore,
+		&telemetry,
+		&healthChecker,
 	); err != nil {
-		panic(err)
+		panic(wrapInjectError(err))
 	}
+	nb.recordResolution(logger, telemetry, time.Since(start))
 
-	nb.node.SetApplication(
-		app.NewBeaconKitApp(
-			db, traceStore, true, appBuilder,
-			append(
-				server.DefaultBaseappOptions(appOpts),
-				func(bApp *baseapp.BaseApp) {
-					bApp.SetParamStore(
-						comet.NewConsensusParamsStore(chainSpec))
-				})...,
+	baseappOptions := append(
+		server.DefaultBaseappOptions(appOpts),
+		func(bApp *baseapp.BaseApp) {
+			bApp.SetParamStore(
+				comet.NewConsensusParamsStore(chainSpec))
+		})
+	// The state retention of the builder overrides the pruning of the config.
+	if nb.stateRetention != nil {
+		baseappOptions = append(baseappOptions, baseapp.SetPruning(
+			storage.PruningOptions(*nb.stateRetention),
 		))
+	}
+	beaconApp := app.NewBeaconKitApp(
+		db, traceStore, true, appBuilder, baseappOptions...,
+	)
+
+	// Serve historical states from the committed multi store of the app.
+	if b, ok := storageBackend.(multiStoreSetter); ok {
+		b.SetMultiStore(beaconApp.CommitMultiStore())
+	}
+
+	nb.node.SetHealthReporter(healthChecker)
+
+	// Close the deposit database once the node shuts down.
+	nb.node.RegisterCloser(
+		"deposit store",
+		storageBackend.DepositStore(context.Background()).Close,
+	)
+	// Close the block database once the node shuts down.
+	nb.node.RegisterCloser("block store", blockStore.Close)
+
+	// Shut the telemetry sink down once the node shuts down, such that the
+	// metrics it buffers are flushed.
+	if c, ok := telemetry.(io.Closer); ok && nb.telemetry != nil {
+		nb.node.RegisterCloser("telemetry sink", c.Close)
+	}
+
+	if err := nb.startPprofServer(logger); err != nil {
+		panic(err)
+	}
+
+	nb.node.SetApplication(beaconApp)
+	nb.logStartupBanner(logger, appOpts)
 	return nb.node
 }
+
+// recordResolution logs the time spent resolving the dependencies of the
+// application, and records it alongside the time spent resolving those of the
+// root command through the telemetry of the node. depinject resolves each
+// graph in a single pass, so the time is not broken down by type.
+func (nb *NodeBuilder[NodeT]) recordResolution(
+	logger log.Logger,
+	telemetry metrics.Telemetry,
+	appResolution time.Duration,
+) {
+	logger.Debug(
+		"resolved dependencies of the application",
+		"duration", appResolution,
+	)
+	now := time.Now()
+	if nb.rootResolution > 0 {
+		telemetry.MeasureSince(
+			resolutionMetric, now.Add(-nb.rootResolution), "graph", "root",
+		)
+	}
+	telemetry.MeasureSince(
+		resolutionMetric, now.Add(-appResolution), "graph", "app",
+	)
+}
+
+// logStartupBanner logs a single structured line summarizing the node and the
+// subsystems it runs with, unless the banner is disabled.
+func (nb *NodeBuilder[NodeT]) logStartupBanner(
+	logger log.Logger,
+	appOpts servertypes.AppOptions,
+) {
+	if nb.skipStartupBanner {
+		return
+	}
+	info := nb.node.NodeInfo()
+	home := cast.ToString(appOpts.Get(sdkflags.FlagHome))
+	logger.Info(
+		"starting node",
+		"name", info.Name,
+		"version", info.Version,
+		"chain_id", info.ChainID,
+		"chain_spec", info.ChainSpecName,
+		"data_dir", filepath.Join(home, "data"),
+		"read_only", nb.readOnly,
+		"in_memory_stores", nb.inMemoryStores,
+		"blob_verification", !nb.skipBlobVerification,
+		"health_server", nb.healthServerAddr,
+		"admin_socket", nb.adminSocketPath,
+		"pprof", nb.pprofAddr,
+		"telemetry", nb.telemetry != nil,
+		"tracing", nb.tracerProvider != nil,
+	)
+}
+
+// startPprofServer starts the pprof server of the node if it is enabled, and
+// registers it to be closed once the node shuts down.
+func (nb *NodeBuilder[NodeT]) startPprofServer(logger log.Logger) error {
+	if nb.pprofAddr == "" {
+		return nil
+	}
+
+	srv := pprof.NewServer(logger.With("service", "pprof"), nb.pprofAddr)
+	if err := srv.Start(); err != nil {
+		return errors.Wrap(err, "failed to start pprof server")
+	}
+	nb.node.RegisterCloser("pprof server", srv.Close)
+	return nil
+}
+
+// StorageBackendCreator creates the storage backend of the node on top of the
+// given application database, serving the states committed to it. The
+// services of the node are neither built nor started, so it can be used to
+// read the states of a node that is not running.
+func (nb *NodeBuilder[NodeT]) StorageBackendCreator(
+	logger log.Logger,
+	db dbm.DB,
+	appOpts servertypes.AppOptions,
+) (statecmd.StorageBackend[components.BeaconState], error) {
+	var (
+		appBuilder     *runtime.AppBuilder
+		storageBackend components.StorageBackend
+	)
+	if err := depinject.Inject(
+		depinject.Configs(
+			nb.depInjectCfg,
+			depinject.Provide(
+				nb.providers()...,
+			),
+			depinject.Supply(
+				nb.supplies(appOpts, logger)...,
+			),
+		),
+		&appBuilder,
+		&storageBackend,
+	); err != nil {
+		return nil, wrapInjectError(err)
+	}
+
+	runtimeApp := appBuilder.Build(db, nil)
+	if err := runtimeApp.Load(true); err != nil {
+		return nil, err
+	}
+
+	b, ok := storageBackend.(multiStoreSetter)
+	if !ok {
+		return nil, newBuildError(ErrRuntimeInit, errors.New(
+			"storage backend cannot serve historical states",
+		))
+	}
+	b.SetMultiStore(runtimeApp.CommitMultiStore())
+	return storageBackend, nil
+}
+
+// DepositStoreCreator creates the deposit store of the node, without building
+// or starting any of the services of the node. It is used by commands that
+// read the deposits of a node that is not running.
+func (nb *NodeBuilder[NodeT]) DepositStoreCreator(
+	logger log.Logger,
+	appOpts servertypes.AppOptions,
+) (depositscmd.DepositStore, error) {
+	var (
+		depositStore components.DepositStore
+		blockStore   *components.BlockStore
+	)
+	if err := depinject.Inject(
+		depinject.Configs(
+			nb.depInjectCfg,
+			depinject.Provide(
+				nb.providers()...,
+			),
+			depinject.Supply(
+				nb.supplies(appOpts, logger)...,
+			),
+		),
+		&depositStore,
+		&blockStore,
+	); err != nil {
+		return nil, wrapInjectError(err)
+	}
+	// The block store is resolved along with the deposit store but is not
+	// used by the commands reading it, so its database is released.
+	if err := blockStore.Close(); err != nil {
+		return nil, err
+	}
+	return depositStore, nil
+}
+
+// AvailabilityStoreCreator creates the availability store of the node,
+// without building or starting any of the services of the node. It is used by
+// commands that read the blob sidecars stored by the node.
+func (nb *NodeBuilder[NodeT]) AvailabilityStoreCreator(
+	logger log.Logger,
+	appOpts servertypes.AppOptions,
+) (dacmd.AvailabilityStore, error) {
+	var (
+		availabilityStore *dastore.Store[*consensustypes.BeaconBlockBody]
+		blockStore        *components.BlockStore
+	)
+	if err := depinject.Inject(
+		depinject.Configs(
+			nb.depInjectCfg,
+			depinject.Provide(
+				nb.providers()...,
+			),
+			depinject.Supply(
+				nb.supplies(appOpts, logger)...,
+			),
+		),
+		&availabilityStore,
+		&blockStore,
+	); err != nil {
+		return nil, wrapInjectError(err)
+	}
+	// The block store is resolved along with the availability store but is not
+	// used by the commands reading it, so its database is released.
+	if err := blockStore.Close(); err != nil {
+		return nil, err
+	}
+	return availabilityStore, nil
+}
+
+// KeyringCreator opens the keyring of the node for the given client context,
+// with the backend set on the builder, if any, in place of the backend of the
+// client context. It is used by commands that verify the keys of the node.
+func (nb *NodeBuilder[NodeT]) KeyringCreator(
+	clientCtx client.Context,
+) (keyring.Keyring, error) {
+	return components.NewKeyring(
+		clientCtx, components.KeyringBackend(nb.keyringBackend),
+	)
+}
+
+// multiStoreSetter is implemented by storage backends that serve historical
+// states from the committed multi store of the application.
+type multiStoreSetter interface {
+	SetMultiStore(ms storage.VersionedMultiStore)
+}
+
+// dryRun resolves all the components required by the application, including
+// the runtime and storage backend, and logs a summary of the result. It panics
+// if any of the components fails to resolve.
+func (nb *NodeBuilder[NodeT]) dryRun(
+	logger log.Logger,
+	appOpts servertypes.AppOptions,
+) {
+	var (
+		appBuilder     *runtime.AppBuilder
+		chainSpec      primitives.ChainSpec
+		beaconRuntime  *components.BeaconKitRuntime
+		storageBackend components.StorageBackend
+	)
+	if err := depinject.Inject(
+		depinject.Configs(
+			nb.depInjectCfg,
+			depinject.Provide(
+				nb.providers()...,
+			),
+			depinject.Supply(
+				nb.supplies(appOpts, logger)...,
+			),
+		),
+		&appBuilder,
+		&chainSpec,
+		&beaconRuntime,
+		&storageBackend,
+	); err != nil {
+		panic(wrapInjectError(err))
+	}
+
+	logger.Info(
+		"dry run resolved runtime and storage backend successfully",
+		"components", len(nb.providers()),
+		"deposit_contract", chainSpec.DepositContractAddress(),
+		"eth1_chain_id", chainSpec.DepositEth1ChainID(),
+	)
+}
+
+// errGraphOnly aborts the resolution of the dependencies of the application
+// once all of their providers are registered.
+var errGraphOnly = errors.New("graph only")
+
+// GraphDOT returns the providers of the application, along with the types
+// they depend on and provide, as a Graphviz DOT graph. The providers are only
+// registered, none of them is called, so the graph is rendered without a home
+// directory or the config of the node.
+func (nb *NodeBuilder[NodeT]) GraphDOT() (string, error) {
+	var dot string
+	err := depinject.InjectDebug(
+		depinject.Visualizer(func(graph string) { dot = graph }),
+		depinject.Configs(
+			nb.depInjectCfg,
+			depinject.Provide(
+				nb.providers()...,
+			),
+			depinject.Supply(
+				nb.supplies(viper.New(), log.NewNopLogger())...,
+			),
+			depinject.Error(errGraphOnly),
+		),
+	)
+	if !errors.Is(err, errGraphOnly) {
+		return "", wrapInjectError(err)
+	}
+	return dot, nil
+}
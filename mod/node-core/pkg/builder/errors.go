@@ -0,0 +1,213 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package builder
+
+import (
+	"strings"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+)
+
+var (
+	// ErrInvalidName is returned when the name of the node cannot be used as
+	// the name of the root command.
+	ErrInvalidName = errors.New("invalid node name")
+
+	// ErrChainSpecInvalid is returned when the chain spec set on the builder
+	// is invalid.
+	ErrChainSpecInvalid = errors.New("invalid chain spec")
+
+	// ErrInvalidLogFormat is returned when the log format set on the builder
+	// is neither json nor text.
+	ErrInvalidLogFormat = errors.New("invalid log format")
+
+	// ErrInvalidKeyringBackend is returned when the keyring backend set on
+	// the builder is not supported.
+	ErrInvalidKeyringBackend = errors.New("invalid keyring backend")
+
+	// ErrInvalidDACompactionInterval is returned when the compaction interval
+	// of the availability store set on the builder is negative.
+	ErrInvalidDACompactionInterval = errors.New(
+		"invalid availability store compaction interval",
+	)
+
+	// ErrInvalidBuildTimeout is returned when the build timeout set on the
+	// builder is not positive.
+	ErrInvalidBuildTimeout = errors.New("invalid build timeout")
+
+	// ErrInvalidProposerTimeout is returned when the proposer timeout set on
+	// the builder is not positive.
+	ErrInvalidProposerTimeout = errors.New("invalid proposer timeout")
+
+	// ErrInvalidEngineEndpoint is returned when the engine endpoint set on
+	// the builder has an unsupported URL or an invalid JWT secret file.
+	ErrInvalidEngineEndpoint = errors.New("invalid engine endpoint")
+
+	// ErrInvalidImportConcurrency is returned when the block import
+	// concurrency set on the builder is not positive.
+	ErrInvalidImportConcurrency = errors.New("invalid import concurrency")
+
+	// ErrInvalidImportRateLimit is returned when the block import rate limit
+	// set on the builder is not positive.
+	ErrInvalidImportRateLimit = errors.New("invalid import rate limit")
+
+	// ErrInvalidBlobVerifyWorkers is returned when the number of blob
+	// verification workers set on the builder is not positive.
+	ErrInvalidBlobVerifyWorkers = errors.New("invalid blob verify workers")
+
+	// ErrInvalidInitialHeight is returned when the initial height set on the
+	// builder is not positive.
+	ErrInvalidInitialHeight = errors.New("invalid initial height")
+
+	// ErrInvalidGenesisFile is returned when the genesis file set on the
+	// builder cannot be parsed or does not match the chain spec of the node.
+	ErrInvalidGenesisFile = errors.New("invalid genesis file")
+
+	// ErrInvalidConsensusParams is returned when the consensus params set on
+	// the builder are invalid or inconsistent with the chain spec of the node.
+	ErrInvalidConsensusParams = errors.New("invalid consensus params")
+
+	// ErrInvalidMinGasPrices is returned when the minimum gas prices set on
+	// the builder are not a valid list of decimal coins.
+	ErrInvalidMinGasPrices = errors.New("invalid minimum gas prices")
+
+	// ErrInvalidPeerAddress is returned when a seed or persistent peer set
+	// on the builder is not of the form id@host:port.
+	ErrInvalidPeerAddress = errors.New("invalid peer address")
+
+	// ErrInvalidStoreDir is returned when a store directory set on the
+	// builder cannot be written to.
+	ErrInvalidStoreDir = errors.New("invalid store directory")
+
+	// ErrInvalidTelemetryConfig is returned when the telemetry config set on
+	// the builder describes an unsupported sink.
+	ErrInvalidTelemetryConfig = errors.New("invalid telemetry config")
+
+	// ErrInvalidPrometheusListenAddr is returned when the Prometheus listen
+	// address set on the builder is not of the form host:port.
+	ErrInvalidPrometheusListenAddr = errors.New(
+		"invalid prometheus listen address",
+	)
+
+	// ErrDuplicateModule is returned when an extra module has the same name
+	// as another module of the node.
+	ErrDuplicateModule = errors.New("duplicate module")
+
+	// ErrInvalidComponents is returned when a component set on the builder
+	// is nil, or provides the same output type as another component.
+	ErrInvalidComponents = errors.New("invalid components")
+
+	// ErrInvalidProviderOverride is returned when a provider override set on
+	// the builder has no outputs to override providers by.
+	ErrInvalidProviderOverride = errors.New("invalid provider override")
+
+	// ErrMissingProvider is returned when a dependency of the node cannot be
+	// resolved because no component provides it.
+	ErrMissingProvider = errors.New("missing provider")
+
+	// ErrBuildTimeout is returned when the dependencies of the node are not
+	// resolved within the build timeout, e.g. because a provider hangs.
+	ErrBuildTimeout = errors.New("dependency resolution exceeded deadline")
+
+	// ErrRuntimeInit is returned when the components of the node fail to
+	// initialize.
+	ErrRuntimeInit = errors.New("failed to initialize runtime")
+)
+
+// BuildError is returned when the NodeBuilder fails to build the node. It
+// matches both the sentinel error describing the kind of failure and the
+// underlying cause with errors.Is and errors.As.
+type BuildError struct {
+	// Kind is the sentinel error describing the kind of failure.
+	Kind error
+	// Cause is the underlying error.
+	Cause error
+	// Hint suggests how to fix the failure, it is empty if there is no
+	// suggestion.
+	Hint string
+}
+
+// newBuildError returns a new BuildError of the given kind.
+func newBuildError(kind, cause error) *BuildError {
+	return &BuildError{Kind: kind, Cause: cause}
+}
+
+// Error implements the error interface.
+func (e *BuildError) Error() string {
+	if e.Hint != "" {
+		return e.Kind.Error() + " (" + e.Hint + "): " + e.Cause.Error()
+	}
+	return e.Kind.Error() + ": " + e.Cause.Error()
+}
+
+// Unwrap returns the kind and the cause of the error.
+func (e *BuildError) Unwrap() []error {
+	return []error{e.Kind, e.Cause}
+}
+
+// missingProviderHints suggest the option that provides a type depinject
+// cannot resolve, keyed by the package-qualified name of the type.
+//
+//nolint:gochecknoglobals // read-only lookup table.
+var missingProviderHints = map[string]string{
+	"chain.Spec": "did you forget WithChainSpec?",
+	"blockchain.StorageBackend": "did you forget " +
+		"WithComponents(components.ProvideStorageBackend)?",
+	"keyring.Keyring": "did you forget WithKeyringBackend?",
+	"address.Codec": "did you forget " +
+		"WithDepInjectConfig(DefaultDepInjectConfig())?",
+}
+
+// wrapInjectError wraps an error returned by depinject into a BuildError.
+// depinject does not expose a typed error for unresolvable dependencies, so
+// they are recognized by their message, which also names the types the hint
+// of the error is looked up for.
+func wrapInjectError(err error) error {
+	const unresolved = "can't resolve type "
+	msg := err.Error()
+	if !strings.Contains(msg, unresolved) {
+		return newBuildError(ErrRuntimeInit, err)
+	}
+
+	buildErr := newBuildError(ErrMissingProvider, err)
+	// The unresolved type is named first, followed by the types that were
+	// being resolved when it was required, starting with the closest one.
+	for _, line := range strings.Split(msg, "\n") {
+		_, typ, found := strings.Cut(line, unresolved)
+		if !found {
+			typ = strings.TrimSpace(line)
+		}
+		if hint, ok := missingProviderHints[qualifiedTypeName(typ)]; ok {
+			buildErr.Hint = hint
+			break
+		}
+	}
+	return buildErr
+}
+
+// qualifiedTypeName returns the package-qualified name of the type at the
+// start of the given depinject message, without its type arguments, such as
+// blockchain.StorageBackend.
+func qualifiedTypeName(msg string) string {
+	typ, _, _ := strings.Cut(msg, " ")
+	typ, _, _ = strings.Cut(strings.TrimPrefix(typ, "*"), "[")
+	return typ[strings.LastIndex(typ, "/")+1:]
+}
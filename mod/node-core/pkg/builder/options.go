@@ -21,8 +21,24 @@
 package builder
 
 import (
+	"context"
+	"fmt"
+	"reflect"
+	"runtime"
+	"slices"
+	"time"
+
 	"cosmossdk.io/depinject"
+	"cosmossdk.io/log"
+	dastore "github.com/berachain/beacon-kit/mod/da/pkg/store"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/spf13/viper"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // Opt is a type that defines a function that modifies NodeBuilder.
@@ -31,7 +47,6 @@ type Opt[NodeT types.NodeI] func(*NodeBuilder[NodeT])
 // WithName is a function that sets the name for the NodeBuilder.
 func WithName[NodeT types.NodeI](name string) Opt[NodeT] {
 	return func(nb *NodeBuilder[NodeT]) {
-		nb.node.SetAppName(name)
 		nb.name = name
 	}
 }
@@ -39,7 +54,6 @@ func WithName[NodeT types.NodeI](name string) Opt[NodeT] {
 // WithDescription is a function that sets the description for the NodeBuilder.
 func WithDescription[NodeT types.NodeI](description string) Opt[NodeT] {
 	return func(nb *NodeBuilder[NodeT]) {
-		nb.node.SetAppDescription(description)
 		nb.description = description
 	}
 }
@@ -52,9 +66,557 @@ func WithDepInjectConfig[NodeT types.NodeI](cfg depinject.Config) Opt[NodeT] {
 	}
 }
 
-// WithComponents is a function that sets the components for the NodeBuilder.
-func WithComponents[NodeT types.NodeI](components []any) Opt[NodeT] {
+// WithLogger is a function that sets the logger for the NodeBuilder. The
+// logger is supplied to the dependency injection framework and is used by
+// the server, so that all logs are routed to the same sink.
+func WithLogger[NodeT types.NodeI](logger log.Logger) Opt[NodeT] {
+	return func(nb *NodeBuilder[NodeT]) {
+		nb.logger = logger
+	}
+}
+
+// WithLogFormat is a function that sets the format of the logs of the node,
+// either "json" or "text", defaulting to text. It sets the format of the
+// logger supplied to the dependency injection framework and, unless the log
+// format flag is set, of the logger of the server. It has no effect if a
+// logger is set with WithLogger. Building the node fails with
+// ErrInvalidLogFormat if the format is neither json nor text.
+func WithLogFormat[NodeT types.NodeI](format string) Opt[NodeT] {
+	return func(nb *NodeBuilder[NodeT]) {
+		nb.logFormat = format
+	}
+}
+
+// WithViper is a function that sets the viper instance for the NodeBuilder.
+// This allows multiple nodes to run in the same process without sharing the
+// global viper instance.
+func WithViper[NodeT types.NodeI](v *viper.Viper) Opt[NodeT] {
+	return func(nb *NodeBuilder[NodeT]) {
+		nb.viper = v
+	}
+}
+
+// WithAppConfig is a function that sets the app config and its template for
+// the NodeBuilder. They are applied when the app.toml is intercepted by the
+// root command, such that values set by flags still take precedence.
+func WithAppConfig[NodeT types.NodeI](
+	cfg any,
+	template string,
+) Opt[NodeT] {
+	return func(nb *NodeBuilder[NodeT]) {
+		nb.appConfig = cfg
+		nb.appConfigTemplate = template
+	}
+}
+
+// WithCometConfig is a function that sets the CometBFT config for the
+// NodeBuilder. The config is deep-copied before it is handed to CometBFT, so
+// the same config may safely be shared between multiple builders.
+func WithCometConfig[NodeT types.NodeI](cfg *cmtcfg.Config) Opt[NodeT] {
+	return func(nb *NodeBuilder[NodeT]) {
+		nb.cometConfig = cfg
+	}
+}
+
+// WithChainSpec is a function that sets the chain spec for the NodeBuilder.
+// When set, the chain spec is supplied directly to the dependency injection
+// framework instead of being provided by components.ProvideChainSpec.
+func WithChainSpec[NodeT types.NodeI](
+	spec primitives.ChainSpec,
+) Opt[NodeT] {
+	return func(nb *NodeBuilder[NodeT]) {
+		nb.chainSpec = spec
+	}
+}
+
+// WithHealthServer is a function that enables the health server of the node,
+// which serves its liveness and readiness probes on the given address. The
+// node is reported ready once it lags at most health.DefaultMaxSyncLag slots
+// behind the head of the chain.
+func WithHealthServer[NodeT types.NodeI](addr string) Opt[NodeT] {
+	return func(nb *NodeBuilder[NodeT]) {
+		nb.healthServerAddr = addr
+		WithComponents[NodeT](components.ProvideHealthServer)(nb)
+	}
+}
+
+// WithAdminSocket is a function that enables the admin server of the node,
+// which serves the maintenance controls of the node, such as pausing and
+// resuming block processing, on a unix socket at the given path. A socket
+// left behind at the path by a previous run of the node is replaced.
+func WithAdminSocket[NodeT types.NodeI](path string) Opt[NodeT] {
+	return func(nb *NodeBuilder[NodeT]) {
+		nb.adminSocketPath = path
+		WithComponents[NodeT](components.ProvideAdminServer)(nb)
+	}
+}
+
+// WithPprof is a function that enables the pprof server of the node, which
+// serves the runtime profiling data of the node under /debug/pprof on the
+// given address. The server is started with the node, and is shut down once
+// the node shuts down.
+func WithPprof[NodeT types.NodeI](addr string) Opt[NodeT] {
+	return func(nb *NodeBuilder[NodeT]) {
+		nb.pprofAddr = addr
+	}
+}
+
+// WithTelemetry is a function that configures the node to push the metrics
+// emitted by its services to the sink described by the given config, rather
+// than through the global telemetry of the SDK. The sink is shut down once
+// the node shuts down.
+func WithTelemetry[NodeT types.NodeI](cfg TelemetryConfig) Opt[NodeT] {
+	return func(nb *NodeBuilder[NodeT]) {
+		nb.telemetry = &cfg
+	}
+}
+
+// WithPrometheusListenAddr is a function that sets the address CometBFT
+// serves its Prometheus metrics on, overriding the instrumentation listen
+// address set in the config of the node and enabling the instrumentation. The
+// metrics emitted by the services of the node are served on it as well.
+// Building the node fails with ErrInvalidPrometheusListenAddr if the address
+// is not of the form host:port.
+func WithPrometheusListenAddr[NodeT types.NodeI](addr string) Opt[NodeT] {
+	return func(nb *NodeBuilder[NodeT]) {
+		nb.prometheusListenAddr = addr
+	}
+}
+
+// WithTracer is a function that traces the processing of blocks by the node
+// with the given tracer provider, covering the verification, state transition
+// and storage of blocks. If unset, a no-op tracer is used.
+func WithTracer[NodeT types.NodeI](tp trace.TracerProvider) Opt[NodeT] {
+	return func(nb *NodeBuilder[NodeT]) {
+		nb.tracerProvider = tp
+	}
+}
+
+// WithEngineEndpoint is a function that sets the endpoint of the engine API of
+// the execution client the node drives, overriding the rpc-dial-url and
+// jwt-secret-path of its config. The URL must be an HTTP, HTTPS or IPC URL and
+// the JWT secret file must hold a hex encoded 32 byte secret, otherwise
+// building the node fails with ErrInvalidEngineEndpoint.
+func WithEngineEndpoint[NodeT types.NodeI](
+	url string,
+	jwtSecretPath string,
+) Opt[NodeT] {
+	return func(nb *NodeBuilder[NodeT]) {
+		nb.engineEndpoint = &components.EngineEndpoint{
+			URL:           url,
+			JWTSecretPath: jwtSecretPath,
+		}
+	}
+}
+
+// WithBuildTimeout is a function that sets the time the dependencies of the
+// node are given to be resolved when it is built, such that a hanging
+// provider fails the build with ErrBuildTimeout instead of blocking it
+// forever. The timeout defaults to DefaultBuildTimeout, and building the node
+// fails with ErrInvalidBuildTimeout if it is not positive.
+func WithBuildTimeout[NodeT types.NodeI](d time.Duration) Opt[NodeT] {
+	return func(nb *NodeBuilder[NodeT]) {
+		nb.buildTimeout = &d
+	}
+}
+
+// WithProposerTimeout is a function that sets the time the node waits for the
+// execution payload of the blocks it proposes. Once the timeout expires, the
+// node proposes an empty block for the slot rather than missing it. The
+// timeout overrides the one set in the config of the node, and building the
+// node fails with ErrInvalidProposerTimeout if it is not positive.
+func WithProposerTimeout[NodeT types.NodeI](d time.Duration) Opt[NodeT] {
+	return func(nb *NodeBuilder[NodeT]) {
+		nb.proposerTimeout = &d
+	}
+}
+
+// WithStateRetention is a function that sets the number of slots below the
+// latest one whose beacon state is retained by the node. The older states are
+// pruned by the multi store every few slots as new states are committed,
+// while the latest state, which is the finalized one, is always retained. All
+// states are retained if slots is 0. The retention overrides the pruning set
+// in the config of the node.
+func WithStateRetention[NodeT types.NodeI](slots uint64) Opt[NodeT] {
+	return func(nb *NodeBuilder[NodeT]) {
+		nb.stateRetention = &slots
+	}
+}
+
+// WithConsensusParams is a function that overrides the CometBFT consensus
+// params of the chain spec of the node, such as the block max bytes and gas
+// and the evidence params, with the non-nil fields of the given params.
+// Building the node, or running one of its commands, fails with
+// ErrInvalidConsensusParams if the resulting params are invalid or their
+// block max bytes cannot hold a beacon block carrying the maximum number of
+// blobs.
+func WithConsensusParams[NodeT types.NodeI](
+	params *cmtproto.ConsensusParams,
+) Opt[NodeT] {
+	return func(nb *NodeBuilder[NodeT]) {
+		nb.consensusParams = params
+	}
+}
+
+// WithImportConcurrency is a function that sets the number of workers the blob
+// sidecars of the blocks imported by the node are verified across. The blocks
+// themselves are still processed serially and in order. The concurrency
+// defaults to GOMAXPROCS, and building the node fails with
+// ErrInvalidImportConcurrency if it is not positive.
+func WithImportConcurrency[NodeT types.NodeI](n int) Opt[NodeT] {
+	return func(nb *NodeBuilder[NodeT]) {
+		nb.importConcurrency = &n
+	}
+}
+
+// WithImportRateLimit is a function that sets the number of blocks received
+// from the network per second that are admitted to verification by the node,
+// with a small burst allowance. The verification of a proposal above the
+// limit is deferred to its finalization, and is logged at debug level, while
+// the finalization of blocks is never delayed. Blocks are admitted without
+// limit by default, and building the node fails with
+// ErrInvalidImportRateLimit if the limit is not positive.
+func WithImportRateLimit[NodeT types.NodeI](blocksPerSec int) Opt[NodeT] {
+	return func(nb *NodeBuilder[NodeT]) {
+		nb.importRateLimit = &blocksPerSec
+	}
+}
+
+// WithBlobVerifyWorkers is a function that sets the number of workers the KZG
+// proofs of the blob sidecars received by the node are verified across. The
+// sidecars of a block are split across the workers, while blocks are still
+// verified one at a time. The number of workers defaults to half of
+// GOMAXPROCS, and building the node fails with ErrInvalidBlobVerifyWorkers
+// if it is not positive.
+func WithBlobVerifyWorkers[NodeT types.NodeI](n int) Opt[NodeT] {
+	return func(nb *NodeBuilder[NodeT]) {
+		nb.blobVerifyWorkers = &n
+	}
+}
+
+// WithInitialHeight is a function that sets the height of the first block of
+// the chain, for chains that do not start at height 1. It becomes the default
+// initial height of the genesis written by the init command, which CometBFT
+// and the application start the chain from, and is set as the initial height
+// of the config of the node. Building the node fails with
+// ErrInvalidInitialHeight if the height is not positive.
+func WithInitialHeight[NodeT types.NodeI](height int64) Opt[NodeT] {
+	return func(nb *NodeBuilder[NodeT]) {
+		nb.initialHeight = &height
+	}
+}
+
+// WithGenesisFile is a function that sets the genesis file of the node,
+// overriding the genesis file in the config directory of its home. Building
+// the node fails with ErrInvalidGenesisFile if the file cannot be parsed into
+// a beacon genesis, or if its fork version does not match the chain spec of
+// the node, which is checked again once the chain spec is selected by its
+// flag.
+func WithGenesisFile[NodeT types.NodeI](path string) Opt[NodeT] {
+	return func(nb *NodeBuilder[NodeT]) {
+		nb.genesisFile = path
+	}
+}
+
+// WithMinGasPrices is a function that sets the minimum gas prices the node
+// accepts transactions at, such as "0.025stake", overriding the minimum gas
+// prices of the app config. An empty string sets no minimum, i.e. zero.
+// Building the node fails with ErrInvalidMinGasPrices if the prices are not a
+// valid list of decimal coins.
+func WithMinGasPrices[NodeT types.NodeI](prices string) Opt[NodeT] {
+	return func(nb *NodeBuilder[NodeT]) {
+		nb.minGasPrices = &prices
+	}
+}
+
+// WithSeeds is a function that sets the seed nodes the node crawls for peers,
+// each of the form id@host:port, overriding the seeds of the config.toml of
+// the node. Building the node fails with ErrInvalidPeerAddress if a seed is
+// not of that form.
+func WithSeeds[NodeT types.NodeI](seeds ...string) Opt[NodeT] {
+	return func(nb *NodeBuilder[NodeT]) {
+		nb.seeds = seeds
+	}
+}
+
+// WithPersistentPeers is a function that sets the peers the node maintains
+// a connection to, each of the form id@host:port, overriding the persistent
+// peers of the config.toml of the node. Building the node fails with
+// ErrInvalidPeerAddress if a peer is not of that form.
+func WithPersistentPeers[NodeT types.NodeI](peers ...string) Opt[NodeT] {
+	return func(nb *NodeBuilder[NodeT]) {
+		nb.persistentPeers = peers
+	}
+}
+
+// WithKeyringBackend is a function that sets the backend of the keyring of
+// the node. The backend must be one of os, file or test, otherwise building
+// the node fails with ErrInvalidKeyringBackend.
+func WithKeyringBackend[NodeT types.NodeI](backend string) Opt[NodeT] {
+	return func(nb *NodeBuilder[NodeT]) {
+		nb.keyringBackend = backend
+	}
+}
+
+// WithShutdownTimeout is a function that sets the time the node waits for
+// its closers, such as the deposit store, to finish once it shuts down. The
+// timeout defaults to node.DefaultShutdownTimeout, and the closers are waited
+// for indefinitely if it is not positive.
+func WithShutdownTimeout[NodeT types.NodeI](d time.Duration) Opt[NodeT] {
+	return func(nb *NodeBuilder[NodeT]) {
+		nb.shutdownTimeout = &d
+	}
+}
+
+// WithRootContext is a function that sets the parent context the node is run
+// with, such that cancelling it shuts the node down gracefully. If unset, the
+// node is only shut down on OS signals.
+func WithRootContext[NodeT types.NodeI](ctx context.Context) Opt[NodeT] {
+	return func(nb *NodeBuilder[NodeT]) {
+		nb.rootCtx = ctx
+	}
+}
+
+// WithInMemoryStores is a function that configures the NodeBuilder to keep
+// the availability store of the node in memory instead of on disk, such that
+// tests can wire the node identically to production without any backing
+// storage.
+func WithInMemoryStores[NodeT types.NodeI]() Opt[NodeT] {
+	return func(nb *NodeBuilder[NodeT]) {
+		nb.inMemoryStores = true
+	}
+}
+
+// WithReadOnly is a function that configures the node to only sync the chain
+// and serve queries, such as for its states and deposits, without ever
+// proposing blocks. The following components are affected:
+//
+//   - the validator service is not wired, so the node cannot build blocks and
+//     rejects requests to prepare a proposal with components.ErrReadOnly;
+//   - the BLS signer is replaced by one that only verifies signatures, and no
+//     validator signing key is loaded. Supplying a key makes the node fail to
+//     start with components.ErrSigningKeyInReadOnlyMode;
+//   - the local payload builder is disabled, so no payloads are requested
+//     from the execution client.
+func WithReadOnly[NodeT types.NodeI]() Opt[NodeT] {
+	return func(nb *NodeBuilder[NodeT]) {
+		nb.readOnly = true
+	}
+}
+
+// WithStartupBanner is a function that sets whether a banner summarizing the
+// node, such as its name, version, chain and the subsystems it runs with, is
+// logged as a single structured line when the node starts. The banner is
+// logged by default.
+func WithStartupBanner[NodeT types.NodeI](enabled bool) Opt[NodeT] {
+	return func(nb *NodeBuilder[NodeT]) {
+		nb.skipStartupBanner = !enabled
+	}
+}
+
+// WithBlobVerification is a function that sets whether the KZG proofs of blob
+// sidecars are verified before the sidecars are stored, which is the default.
+// Disabling the verification speeds up syncing, but must only be done on
+// trusted networks, and a warning is logged when the node starts without it.
+func WithBlobVerification[NodeT types.NodeI](enabled bool) Opt[NodeT] {
+	return func(nb *NodeBuilder[NodeT]) {
+		nb.skipBlobVerification = !enabled
+	}
+}
+
+// WithDACompactionInterval is a function that sets the interval at which the
+// availability store compacts its underlying database in the background.
+// Compaction is skipped while the store is being pruned, and is disabled if
+// the interval is zero. The interval defaults to
+// dastore.DefaultCompactionInterval, and building the node fails with
+// ErrInvalidDACompactionInterval if it is negative.
+func WithDACompactionInterval[NodeT types.NodeI](
+	d time.Duration,
+) Opt[NodeT] {
+	return func(nb *NodeBuilder[NodeT]) {
+		nb.daCompactionInterval = &d
+	}
+}
+
+// WithDAReadReplica is a function that sets a replica of the database of the
+// availability store that sidecars are read from in preference to the
+// database itself. Reads fall back to the database when the replica does not
+// have the requested sidecars or fails to be read, while sidecars are only
+// ever written to the database. Keeping the replica in sync is left to the
+// caller.
+func WithDAReadReplica[NodeT types.NodeI](
+	store dastore.RangeIndexDB,
+) Opt[NodeT] {
+	return func(nb *NodeBuilder[NodeT]) {
+		nb.daReadReplica = store
+	}
+}
+
+// WithDAStoreDir is a function that sets the directory the availability
+// store of the node is kept in, which defaults to data/blobs under the home
+// of the node. The directory is created if it does not exist, and building
+// the node fails with ErrInvalidStoreDir if it cannot be written to.
+func WithDAStoreDir[NodeT types.NodeI](path string) Opt[NodeT] {
+	return func(nb *NodeBuilder[NodeT]) {
+		nb.daStoreDir = path
+	}
+}
+
+// WithDepositStoreDir is a function that sets the directory the deposit store
+// of the node is kept in, which defaults to data under the home of the node.
+// The directory is created if it does not exist, and building the node fails
+// with ErrInvalidStoreDir if it cannot be written to.
+func WithDepositStoreDir[NodeT types.NodeI](path string) Opt[NodeT] {
+	return func(nb *NodeBuilder[NodeT]) {
+		nb.depositStoreDir = path
+	}
+}
+
+// WithExtraModules is a function that registers the given application
+// modules with the module manager the root command of the node is built
+// with, alongside the modules wired by the dependency injection framework.
+// The modules are registered before the root command is enhanced by autocli,
+// such that their commands are enhanced as well. Building the node fails
+// with ErrDuplicateModule if the name of a module is already taken.
+func WithExtraModules[NodeT types.NodeI](
+	mods ...module.AppModule,
+) Opt[NodeT] {
+	return func(nb *NodeBuilder[NodeT]) {
+		nb.extraModules = append(nb.extraModules, mods...)
+	}
+}
+
+// WithComponents is a function that appends the given components to the
+// components of the NodeBuilder. Providers that have already been registered
+// are skipped, so that composing several sets of components does not cause
+// depinject to fail on duplicate registration. Building the node fails with
+// ErrInvalidComponents if a component is nil, or if two distinct providers
+// provide the same output type and are not replaced by a provider override.
+func WithComponents[NodeT types.NodeI](components ...any) Opt[NodeT] {
+	return func(nb *NodeBuilder[NodeT]) {
+		for _, component := range components {
+			if containsComponent(nb.components, component) {
+				continue
+			}
+			nb.components = append(nb.components, component)
+		}
+	}
+}
+
+// WithProviderOverride is a function that replaces the providers of the
+// NodeBuilder by the given provider, such that a single component can be
+// swapped without redefining all components. The provider must be a function
+// with at least one output. A provider is replaced if it shares any output
+// type with the override, where the output types of a function are its
+// results other than error, with the fields of results embedding depinject.Out
+// counted individually. Output types are matched exactly, so a provider of an
+// interface is not replaced by one of its implementations.
+//
+// The override replaces the components of the NodeBuilder regardless of the
+// order the options are given in, and is appended to them even if it replaces
+// none. The providers the root command is built with, such as
+// components.ProvideKeyring, are replaced as well, while the providers of the
+// dependency injection configuration cannot be overridden. Building the node
+// fails with ErrInvalidProviderOverride if the override has no outputs.
+func WithProviderOverride[NodeT types.NodeI](provider any) Opt[NodeT] {
 	return func(nb *NodeBuilder[NodeT]) {
-		nb.components = components
+		nb.providerOverrides = append(nb.providerOverrides, provider)
+	}
+}
+
+// overrideProviders removes the providers that share an output type with any
+// of the overrides, and appends the overrides.
+func overrideProviders(providers, overrides []any) []any {
+	if len(overrides) == 0 {
+		return providers
+	}
+	providers = slices.DeleteFunc(slices.Clone(providers), func(p any) bool {
+		return slices.ContainsFunc(overrides, func(o any) bool {
+			return sharesOutput(p, o)
+		})
+	})
+	return append(providers, overrides...)
+}
+
+// sharesOutput reports whether the two providers share any output type.
+func sharesOutput(a, b any) bool {
+	outputs := providerOutputs(b)
+	return slices.ContainsFunc(providerOutputs(a), func(t reflect.Type) bool {
+		return slices.Contains(outputs, t)
+	})
+}
+
+// providerOutputs returns the output types of the given provider, or nil if
+// it is not a function.
+func providerOutputs(provider any) []reflect.Type {
+	t := reflect.TypeOf(provider)
+	if t == nil || t.Kind() != reflect.Func {
+		return nil
+	}
+
+	var (
+		outputs []reflect.Type
+		errType = reflect.TypeOf((*error)(nil)).Elem()
+		outType = reflect.TypeOf(depinject.Out{})
+	)
+	for i := range t.NumOut() {
+		out := t.Out(i)
+		switch {
+		case out == errType:
+		case out.Kind() == reflect.Struct && embedsOut(out, outType):
+			for j := range out.NumField() {
+				if f := out.Field(j); f.IsExported() && f.Type != outType {
+					outputs = append(outputs, f.Type)
+				}
+			}
+		default:
+			outputs = append(outputs, out)
+		}
+	}
+	return outputs
+}
+
+// embedsOut reports whether the struct type t embeds outType.
+func embedsOut(t, outType reflect.Type) bool {
+	for i := range t.NumField() {
+		if f := t.Field(i); f.Anonymous && f.Type == outType {
+			return true
+		}
+	}
+	return false
+}
+
+// containsComponent reports whether the given component is already present
+// in the list of components.
+func containsComponent(components []any, component any) bool {
+	return slices.ContainsFunc(components, func(c any) bool {
+		return isSameComponent(c, component)
+	})
+}
+
+// isSameComponent reports whether the two components are identical. Function
+// providers are compared by their code pointer, all other components by
+// equality.
+func isSameComponent(a, b any) bool {
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+	switch {
+	case !va.IsValid() || !vb.IsValid() || va.Type() != vb.Type():
+		return false
+	case va.Kind() == reflect.Func:
+		return va.Pointer() == vb.Pointer()
+	default:
+		return va.Type().Comparable() && a == b
+	}
+}
+
+// componentName returns the fully qualified name of a function provider, or
+// the type name of any other component.
+func componentName(c any) string {
+	v := reflect.ValueOf(c)
+	if v.Kind() == reflect.Func {
+		if fn := runtime.FuncForPC(v.Pointer()); fn != nil {
+			return fn.Name()
+		}
 	}
+	return fmt.Sprintf("%T", c)
 }
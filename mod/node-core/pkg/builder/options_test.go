@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package builder //nolint:testpackage // inspects the unexported components.
+
+import (
+	"reflect"
+	"testing"
+
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	TestFoo struct{}
+	TestBar struct{}
+	TestBaz struct {
+		Foo *TestFoo
+		Bar *TestBar
+	}
+)
+
+func ProvideTestFoo() *TestFoo { return &TestFoo{} }
+
+func ProvideTestBar() *TestBar { return &TestBar{} }
+
+func ProvideTestBaz(foo *TestFoo, bar *TestBar) *TestBaz {
+	return &TestBaz{Foo: foo, Bar: bar}
+}
+
+func TestWithComponentsMerges(t *testing.T) {
+	nb := New(
+		WithComponents[types.NodeI](ProvideTestFoo),
+		WithComponents[types.NodeI](ProvideTestFoo, ProvideTestBar),
+	)
+	require.Len(t, nb.components, 2)
+
+	var (
+		foo *TestFoo
+		bar *TestBar
+	)
+	require.NoError(t, depinject.Inject(
+		depinject.Provide(nb.components...), &foo, &bar,
+	))
+	require.NotNil(t, foo)
+	require.NotNil(t, bar)
+}
+
+func TestProvidersDeterministicOrder(t *testing.T) {
+	resolve := func(components ...any) ([]string, *TestBaz) {
+		nb := New(WithComponents[types.NodeI](components...))
+		providers := nb.providers()
+		names := make([]string, len(providers))
+		for i, p := range providers {
+			names[i] = componentName(p)
+		}
+
+		var baz *TestBaz
+		require.NoError(t, depinject.Inject(
+			depinject.Provide(providers...), &baz,
+		))
+		return names, baz
+	}
+
+	names1, baz1 := resolve(ProvideTestFoo, ProvideTestBar, ProvideTestBaz)
+	names2, baz2 := resolve(ProvideTestBaz, ProvideTestBar, ProvideTestFoo)
+	require.Equal(t, names1, names2)
+	require.Equal(t, baz1, baz2)
+	require.IsIncreasing(t, names1)
+}
+
+// TestFooOut provides a TestFoo and a TestBar.
+type TestFooOut struct {
+	depinject.Out
+
+	Foo *TestFoo
+	Bar *TestBar
+}
+
+func ProvideTestFooOut() TestFooOut {
+	return TestFooOut{Foo: &TestFoo{}, Bar: &TestBar{}}
+}
+
+func TestOverrideProviders(t *testing.T) {
+	require.ElementsMatch(t,
+		[]reflect.Type{reflect.TypeOf(&TestFoo{}), reflect.TypeOf(&TestBar{})},
+		providerOutputs(ProvideTestFooOut),
+	)
+
+	providers := overrideProviders(
+		[]any{ProvideTestFoo, ProvideTestBar, ProvideTestBaz},
+		[]any{ProvideTestFooOut},
+	)
+	require.Len(t, providers, 2)
+	require.True(t, isSameComponent(ProvideTestBaz, providers[0]))
+	require.True(t, isSameComponent(ProvideTestFooOut, providers[1]))
+}
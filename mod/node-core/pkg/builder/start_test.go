@@ -0,0 +1,279 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+//go:build bls12381
+
+package builder //nolint:testpackage // builds the unexported root command.
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	beaconflags "github.com/berachain/beacon-kit/mod/node-core/pkg/config/flags"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/config/spec"
+	nodepkg "github.com/berachain/beacon-kit/mod/node-core/pkg/node"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/types"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+// The tests in this file start a single validator devnet node, which signs
+// with BLS keys and thus requires the bls12381 build tag.
+
+func TestStartStopsOnContextCancel(t *testing.T) {
+	n := startTestNode(t)
+	require.NoError(t, n.stop())
+}
+
+func TestStartServesPrometheusMetrics(t *testing.T) {
+	addr := freeAddr(t)
+	n := startTestNode(t, WithPrometheusListenAddr[types.NodeI](addr))
+
+	// Both the metrics of CometBFT and of the node are served on the
+	// address once the node has started.
+	var body string
+	require.Eventually(t, func() bool {
+		resp, err := http.Get("http://" + addr + "/metrics")
+		if err != nil {
+			return false
+		}
+		defer resp.Body.Close()
+		bz, err := io.ReadAll(resp.Body)
+		body = string(bz)
+		return err == nil && resp.StatusCode == http.StatusOK
+	}, 30*time.Second, 100*time.Millisecond)
+	require.Contains(t, body, "cometbft_consensus_height")
+	require.Contains(t, body, "beacon_kit_node_dependency_resolution_duration")
+	require.NoError(t, n.stop())
+}
+
+func TestStartStopsWhilePaused(t *testing.T) {
+	dir, err := os.MkdirTemp("", "bk-admin")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+	socket := filepath.Join(dir, "admin.sock")
+	n := startTestNode(t, WithAdminSocket[types.NodeI](socket))
+
+	// Pause block processing once the admin server serves, and wait for
+	// consensus to be held at the next proposal of the node.
+	require.Eventually(t, func() bool {
+		_, err = adminRequest(socket, http.MethodPost, "/pause")
+		return err == nil
+	}, 30*time.Second, 100*time.Millisecond)
+	require.Eventually(t, func() bool {
+		status, statusErr := adminRequest(socket, http.MethodGet, "/status")
+		return statusErr == nil && status.Waiting
+	}, 30*time.Second, 100*time.Millisecond)
+
+	// The node shuts down without being resumed.
+	require.NoError(t, n.stop())
+}
+
+// ============================== HELPERS ===================================
+
+// testNode is a node started by startTestNode.
+type testNode struct {
+	*nodepkg.Node
+	cancel   context.CancelFunc
+	errCh    chan error
+	stopOnce sync.Once
+	stopErr  error
+}
+
+// stop cancels the context the node was started with and returns the error
+// the node returned, failing if it does not return in time.
+func (n *testNode) stop() error {
+	n.stopOnce.Do(func() {
+		n.cancel()
+		select {
+		case n.stopErr = <-n.errCh:
+		case <-time.After(30 * time.Second):
+			n.stopErr = errors.New("timed out waiting for the node to stop")
+		}
+	})
+	return n.stopErr
+}
+
+// startTestNode initializes the home of a single validator devnet node,
+// builds a node with the given options against it and starts it, alongside
+// a fake execution client. The node is stopped when the test finishes, if it
+// was not stopped before.
+func startTestNode(t *testing.T, opts ...Opt[types.NodeI]) *testNode {
+	t.Helper()
+	home := t.TempDir()
+	defaultHome := components.DefaultNodeHome
+	components.DefaultNodeHome = home
+	t.Cleanup(func() { components.DefaultNodeHome = defaultHome })
+
+	cometCfg := DefaultCometConfig()
+	// The pebbledb backend requires the pebbledb build tag.
+	cometCfg.DBBackend = "goleveldb"
+	// CometBFT registers its metrics with the default Prometheus registry,
+	// which only one node of the process may do.
+	cometCfg.Instrumentation.Prometheus = false
+	v := viper.New()
+	v.Set("app-db-backend", "memdb")
+	v.Set("api.enable", false)
+	v.Set("grpc.enable", false)
+	v.Set(
+		beaconflags.KZGTrustedSetupPath,
+		"../../../../testing/files/kzg-trusted-setup.json",
+	)
+	newNodeBuilder := func(opts ...Opt[types.NodeI]) *NodeBuilder[types.NodeI] {
+		return newTestBuilder(append([]Opt[types.NodeI]{
+			WithComponents[types.NodeI](
+				components.DefaultComponentsWithStandardTypes()...,
+			),
+			WithChainSpec[types.NodeI](spec.DevnetChainSpec()),
+			WithCometConfig[types.NodeI](cometCfg),
+			WithViper[types.NodeI](v),
+		}, opts...)...)
+	}
+
+	// The genesis deposit is signed with the key read through the global
+	// viper instance.
+	viper.Set(flags.FlagHome, home)
+	t.Cleanup(viper.Reset)
+	for _, args := range [][]string{
+		{
+			"init", "test", "--chain-id", "test", "--beacon-kit.accept-tos",
+			"--consensus-key-algo", "bls12_381",
+		},
+		{"genesis", "add-premined-deposit"},
+		{"genesis", "collect-premined-deposits"},
+		{
+			"genesis", "execution-payload",
+			"../../../../testing/files/eth-genesis.json",
+		},
+	} {
+		cmd, _, err := newNodeBuilder().buildRootCmd()
+		require.NoError(t, err)
+		cmd.PersistentFlags().String(flags.FlagHome, "", "")
+		cmd.SetArgs(append(args, "--"+flags.FlagHome, home))
+		require.NoError(t, cmd.Execute())
+	}
+
+	// Listen on free ports, such that the node does not collide with any
+	// other process.
+	cometCfg.SetRoot(home)
+	cometCfg.RPC.ListenAddress = "tcp://127.0.0.1:0"
+	cometCfg.P2P.ListenAddress = "tcp://127.0.0.1:0"
+	cmtcfg.WriteConfigFile(
+		filepath.Join(home, "config", "config.toml"), cometCfg,
+	)
+
+	el := newFakeExecutionClient(spec.DevnetChainSpec().DepositEth1ChainID())
+	t.Cleanup(el.Close)
+	node, err := newNodeBuilder(append([]Opt[types.NodeI]{
+		WithEngineEndpoint[types.NodeI](
+			el.URL, "../../../../testing/files/jwt.hex",
+		),
+	}, opts...)...).Build()
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	n := &testNode{
+		Node:   node.(*nodepkg.Node),
+		cancel: cancel,
+		errCh:  make(chan error, 1),
+	}
+	go func() { n.errCh <- n.Start(ctx) }()
+	t.Cleanup(func() { _ = n.stop() })
+	return n
+}
+
+// newFakeExecutionClient returns a server that serves the JSON-RPC methods
+// an execution client is checked with by the node when it starts, and fails
+// any other method.
+func newFakeExecutionClient(chainID uint64) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			var req struct {
+				ID     json.RawMessage `json:"id"`
+				Method string          `json:"method"`
+			}
+			resp := map[string]any{"jsonrpc": "2.0"}
+			if err := json.NewDecoder(r.Body).Decode(&req); err == nil {
+				resp["id"] = req.ID
+			}
+			switch req.Method {
+			case "eth_chainId":
+				resp["result"] = fmt.Sprintf("%#x", chainID)
+			case "engine_exchangeCapabilities":
+				resp["result"] = []string{}
+			default:
+				resp["error"] = map[string]any{
+					"code": -32601, "message": "method not found",
+				}
+			}
+			_ = json.NewEncoder(w).Encode(resp)
+		},
+	))
+}
+
+// freeAddr returns a local address whose port is free to listen on.
+func freeAddr(t *testing.T) string {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer ln.Close()
+	return ln.Addr().String()
+}
+
+// adminRequest performs a request with the given method against the given
+// path of the admin server listening on the given socket.
+func adminRequest(socket, method, path string) (types.PauseStatus, error) {
+	var status types.PauseStatus
+	req, err := http.NewRequest(method, "http://admin"+path, http.NoBody)
+	if err != nil {
+		return status, err
+	}
+	client := &http.Client{
+		Timeout: time.Second,
+		Transport: &http.Transport{
+			DialContext: func(
+				ctx context.Context, _, _ string,
+			) (net.Conn, error) {
+				var d net.Dialer
+				return d.DialContext(ctx, "unix", socket)
+			},
+		},
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return status, err
+	}
+	defer resp.Body.Close()
+	return status, json.NewDecoder(resp.Body).Decode(&status)
+}
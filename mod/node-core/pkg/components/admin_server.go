@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"cosmossdk.io/depinject"
+	"cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/services/admin"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/runtime/middleware"
+)
+
+// ProvidePauser is the depinject provider for the pauser, which pauses and
+// resumes the processing of blocks by the middlewares.
+func ProvidePauser() *middleware.Pauser {
+	return middleware.NewPauser(middleware.DefaultPauseBufferSize)
+}
+
+// AdminServerInput is the input for the admin server provider.
+type AdminServerInput struct {
+	depinject.In
+	Config *admin.Config
+	Logger log.Logger
+	Pauser *middleware.Pauser
+}
+
+// ProvideAdminServer is the depinject provider for the admin server.
+func ProvideAdminServer(in AdminServerInput) *admin.Server {
+	return admin.NewServer(
+		in.Logger.With("service", "admin"),
+		in.Config.SocketPath,
+		in.Pauser,
+	)
+}
@@ -44,6 +44,8 @@ type AvailabilityStoreInput struct {
 	depinject.In
 	AppOpts   servertypes.AppOptions
 	ChainSpec primitives.ChainSpec
+	Config    *dastore.Config `optional:"true"`
+	Dirs      *StoreDirs      `optional:"true"`
 	Logger    log.Logger
 }
 
@@ -57,9 +59,9 @@ func ProvideAvailibilityStore[
 		filedb.NewRangeDB(
 			filedb.NewDB(
 				filedb.WithRootDirectory(
-					cast.ToString(
-						in.AppOpts.Get(flags.FlagHome),
-					)+"/data/blobs",
+					in.Dirs.availabilityStoreDir(
+						cast.ToString(in.AppOpts.Get(flags.FlagHome)),
+					),
 				),
 				filedb.WithFileExtension("ssz"),
 				filedb.WithDirectoryPermissions(os.ModePerm),
@@ -68,9 +70,66 @@ func ProvideAvailibilityStore[
 		),
 		in.Logger.With("service", "beacon-kit.da.store"),
 		in.ChainSpec,
+		availabilityStoreOptions[BeaconBlockBodyT](in.Config)...,
 	), nil
 }
 
+// InMemoryAvailabilityStoreInput is the input for the
+// ProvideInMemoryAvailabilityStore function for the depinject framework.
+type InMemoryAvailabilityStoreInput struct {
+	depinject.In
+	ChainSpec primitives.ChainSpec
+	Config    *dastore.Config `optional:"true"`
+	Logger    log.Logger
+}
+
+// ProvideInMemoryAvailabilityStore provides an availability store that is
+// backed by memory rather than by disk.
+// NOTE: Should only be used for testing.
+func ProvideInMemoryAvailabilityStore[
+	BeaconBlockBodyT types.RawBeaconBlockBody,
+](
+	in InMemoryAvailabilityStoreInput,
+) (*dastore.Store[BeaconBlockBodyT], error) {
+	return dastore.New[BeaconBlockBodyT](
+		filedb.NewRangeDB(
+			filedb.NewInMemoryDB(
+				filedb.WithFileExtension("ssz"),
+				filedb.WithDirectoryPermissions(os.ModePerm),
+				filedb.WithLogger(in.Logger),
+			),
+		),
+		in.Logger.With("service", "beacon-kit.da.store"),
+		in.ChainSpec,
+		availabilityStoreOptions[BeaconBlockBodyT](in.Config)...,
+	), nil
+}
+
+// availabilityStoreOptions returns the options of the availability store
+// for the given config, which is optional.
+func availabilityStoreOptions[
+	BeaconBlockBodyT types.RawBeaconBlockBody,
+](cfg *dastore.Config) []dastore.Option[BeaconBlockBodyT] {
+	if cfg == nil {
+		return nil
+	}
+	opts := []dastore.Option[BeaconBlockBodyT]{
+		dastore.WithCompactionInterval[BeaconBlockBodyT](
+			cfg.CompactionInterval,
+		),
+	}
+	if cfg.ReadReplica != nil {
+		opts = append(opts, dastore.WithReadReplica[BeaconBlockBodyT](
+			cfg.ReadReplica,
+		))
+	}
+	return opts
+}
+
+// AvailabilityPrunable is the prunable the availability pruner prunes the
+// availability store through.
+type AvailabilityPrunable = *dastore.Prunable[*types.BeaconBlockBody]
+
 // AvailabilityPrunerInput is the input for the ProviderAvailabilityPruner
 // function for the depinject framework.
 type AvailabilityPrunerInput struct {
@@ -85,17 +144,15 @@ type AvailabilityPrunerInput struct {
 // framework.
 func ProvideAvailabilityPruner(
 	in AvailabilityPrunerInput,
-) pruner.Pruner[*filedb.RangeDB] {
-	rangeDB, _ := in.AvailabilityStore.IndexDB.(*filedb.RangeDB)
-	// build the availability pruner if IndexDB is available.
+) pruner.Pruner[AvailabilityPrunable] {
 	return pruner.NewPruner[
 		*types.BeaconBlock,
 		*feed.Event[*types.BeaconBlock],
-		*filedb.RangeDB,
+		AvailabilityPrunable,
 		event.Subscription,
 	](
 		in.Logger.With("service", manager.AvailabilityPrunerName),
-		rangeDB,
+		dastore.NewPrunable(in.AvailabilityStore),
 		manager.AvailabilityPrunerName,
 		in.BlockFeed,
 		dastore.BuildPruneRangeFn[
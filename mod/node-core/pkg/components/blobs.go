@@ -26,6 +26,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	dablob "github.com/berachain/beacon-kit/mod/da/pkg/blob"
 	"github.com/berachain/beacon-kit/mod/da/pkg/kzg"
+	"github.com/berachain/beacon-kit/mod/da/pkg/kzg/noop"
 	dastore "github.com/berachain/beacon-kit/mod/da/pkg/store"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/config/flags"
@@ -54,14 +55,35 @@ func ProvideBlobProofVerifier(
 	)
 }
 
+// NoopBlobProofVerifierInput is the input for the dep inject framework.
+type NoopBlobProofVerifierInput struct {
+	depinject.In
+	Logger log.Logger
+}
+
+// ProvideNoopBlobProofVerifier is a function that provides a blob proof
+// verifier that accepts any KZG proof to the application. It must only be
+// used on trusted networks.
+func ProvideNoopBlobProofVerifier(
+	in NoopBlobProofVerifierInput,
+) kzg.BlobProofVerifier {
+	in.Logger.Warn(
+		"⚠️ KZG proof verification of blob sidecars is DISABLED ⚠️ " +
+			"blobs are stored without verifying their commitments, " +
+			"only use this on trusted networks",
+	)
+	return noop.NewVerifier()
+}
+
 // BlobProcessorIn is the input for the BlobProcessor.
 type BlobProcessorIn struct {
 	depinject.In
 
 	BlobProofVerifier kzg.BlobProofVerifier
 	ChainSpec         primitives.ChainSpec
+	Config            *dablob.Config `optional:"true"`
 	Logger            log.Logger
-	TelemetrySink     *metrics.TelemetrySink
+	TelemetrySink     metrics.Telemetry
 }
 
 // ProvideBlobProcessor is a function that provides the BlobProcessor to the
@@ -78,8 +100,19 @@ func ProvideBlobProcessor[
 	](
 		in.Logger.With("service", "blob-processor"),
 		in.ChainSpec,
-		dablob.NewVerifier(in.BlobProofVerifier, in.TelemetrySink),
+		dablob.NewVerifier(
+			in.BlobProofVerifier, in.TelemetrySink, blobVerifierConfig(in.Config),
+		),
 		types.BlockBodyKZGOffset,
 		in.TelemetrySink,
 	)
 }
+
+// blobVerifierConfig returns the given config of the blob verifier, which is
+// optional, or the default config if it is unset.
+func blobVerifierConfig(cfg *dablob.Config) dablob.Config {
+	if cfg == nil {
+		return dablob.DefaultConfig()
+	}
+	return *cfg
+}
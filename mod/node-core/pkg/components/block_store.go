@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"cosmossdk.io/depinject"
+	"cosmossdk.io/log"
+	storev2 "cosmossdk.io/store/v2/db"
+	"github.com/berachain/beacon-kit/mod/async/pkg/event"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	beaconflags "github.com/berachain/beacon-kit/mod/node-core/pkg/config/flags"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/feed"
+	blockstore "github.com/berachain/beacon-kit/mod/storage/pkg/block"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+)
+
+// BlockStore is the store the finalized blocks of the node are kept in.
+type BlockStore = blockstore.KVStore[*types.BeaconBlock]
+
+// BlockStoreInput is the input for the dep inject framework.
+type BlockStoreInput struct {
+	depinject.In
+	AppOpts   servertypes.AppOptions
+	ChainSpec primitives.ChainSpec
+	Dirs      *StoreDirs `optional:"true"`
+}
+
+// ProvideBlockStore is the depinject provider that returns the block store of
+// the node.
+func ProvideBlockStore(in BlockStoreInput) (*BlockStore, error) {
+	// In dry-run mode the block database must not be opened on disk.
+	if cast.ToBool(in.AppOpts.Get(beaconflags.DryRun)) {
+		return blockstore.NewStore[*types.BeaconBlock](
+			&blockstore.KVStoreProvider{
+				KVStoreWithBatch: storev2.NewMemDB(),
+			}, in.ChainSpec,
+		), nil
+	}
+
+	name := "blocks"
+	dir := in.Dirs.blockStoreDir(
+		cast.ToString(in.AppOpts.Get(flags.FlagHome)),
+	)
+	kvp, err := storev2.NewDB(storev2.DBTypePebbleDB, name, dir, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return blockstore.NewStore[*types.BeaconBlock](
+		&blockstore.KVStoreProvider{
+			KVStoreWithBatch: kvp,
+		}, in.ChainSpec,
+	), nil
+}
+
+// BlockStoreServiceInput is the input for the block store service.
+type BlockStoreServiceInput struct {
+	depinject.In
+	BlockFeed  *event.FeedOf[feed.EventID, *feed.Event[*types.BeaconBlock]]
+	BlockStore *BlockStore
+	Logger     log.Logger
+}
+
+// ProvideBlockStoreService is the depinject provider for the service
+// persisting the finalized blocks to the block store.
+func ProvideBlockStoreService(
+	in BlockStoreServiceInput,
+) *blockstore.Service[
+	*types.BeaconBlock, *feed.Event[*types.BeaconBlock], event.Subscription,
+] {
+	return blockstore.NewService[
+		*types.BeaconBlock,
+		*feed.Event[*types.BeaconBlock],
+		event.Subscription,
+	](
+		in.Logger.With("service", blockstore.ServiceName),
+		in.BlockStore,
+		in.BlockFeed,
+	)
+}
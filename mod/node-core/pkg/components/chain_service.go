@@ -38,7 +38,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/feed"
-	depositdb "github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // ChainServiceInput is the input for the chain service provider.
@@ -51,6 +51,7 @@ type ChainServiceInput struct {
 	BlockFeed      *event.FeedOf[feed.EventID, *feed.Event[*types.BeaconBlock]]
 	ChainSpec      primitives.ChainSpec
 	Cfg            *config.Config
+	Config         *blockchain.Config `optional:"true"`
 	DepositService *deposit.Service[
 		*types.BeaconBlock,
 		*types.BeaconBlockBody,
@@ -69,7 +70,8 @@ type ChainServiceInput struct {
 	Signer         crypto.BLSSigner
 	StateProcessor StateProcessor
 	StorageBackend StorageBackend
-	TelemetrySink  *metrics.TelemetrySink
+	TelemetrySink  metrics.Telemetry
+	TracerProvider trace.TracerProvider `optional:"true"`
 }
 
 // ProvideChainService is a depinject provider for the blockchain service.
@@ -82,7 +84,7 @@ func ProvideChainService(
 	BeaconState,
 	*datypes.BlobSidecars,
 	*types.Deposit,
-	*depositdb.KVStore[*types.Deposit],
+	DepositStore,
 ] {
 	return blockchain.NewService[
 		*dastore.Store[*types.BeaconBlockBody],
@@ -90,7 +92,7 @@ func ProvideChainService(
 		*types.BeaconBlockBody,
 		BeaconState,
 		*datypes.BlobSidecars,
-		*depositdb.KVStore[*types.Deposit],
+		DepositStore,
 	](
 		in.StorageBackend,
 		in.Logger.With("service", "blockchain"),
@@ -100,8 +102,19 @@ func ProvideChainService(
 		in.BlobProcessor,
 		in.StateProcessor,
 		in.TelemetrySink,
+		in.TracerProvider,
 		in.BlockFeed,
+		chainServiceConfig(in.Config),
 		// If optimistic is enabled, we want to skip post finalization FCUs.
 		in.Cfg.Validator.EnableOptimisticPayloadBuilds,
 	)
 }
+
+// chainServiceConfig returns the given config of the chain service, which is
+// optional, or the default config if it is unset.
+func chainServiceConfig(cfg *blockchain.Config) blockchain.Config {
+	if cfg == nil {
+		return blockchain.DefaultConfig()
+	}
+	return *cfg
+}
@@ -23,19 +23,84 @@ package components
 import (
 	"os"
 
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/config/flags"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/config/spec"
 	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
+	cmttypes "github.com/cometbft/cometbft/types"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
 )
 
-// ProvideChainSpec provides the chain spec based on the environment variable.
-func ProvideChainSpec() primitives.ChainSpec {
-	// TODO: This is hood as fuck needs to be improved
-	// but for now we ball to get CI unblocked.
-	specType := os.Getenv("CHAIN_SPEC")
-	chainSpec := spec.TestnetChainSpec()
-	if specType == "devnet" {
-		chainSpec = spec.DevnetChainSpec()
+// ConsensusParams are CometBFT consensus params overriding the consensus
+// params of the chain spec.
+type ConsensusParams cmtproto.ConsensusParams
+
+// ChainSpecInput is the input for the dependency injection framework.
+type ChainSpecInput struct {
+	depinject.In
+	AppOpts         servertypes.AppOptions `optional:"true"`
+	ConsensusParams *ConsensusParams       `optional:"true"`
+}
+
+// ProvideChainSpec provides the chain spec selected by ChainSpecName, with its
+// consensus params overridden by the ConsensusParams, if any.
+func ProvideChainSpec(in ChainSpecInput) (primitives.ChainSpec, error) {
+	cs, err := spec.ChainSpecByName(ChainSpecName(in.AppOpts))
+	if err != nil || in.ConsensusParams == nil {
+		return cs, err
+	}
+	return WithConsensusParams(
+		cs, (*cmtproto.ConsensusParams)(in.ConsensusParams),
+	), nil
+}
+
+// WithConsensusParams returns the given chain spec with the CometBFT consensus
+// params it defines updated by the non-nil fields of the given params, as
+// CometBFT updates them.
+func WithConsensusParams(
+	cs primitives.ChainSpec,
+	params *cmtproto.ConsensusParams,
+) primitives.ChainSpec {
+	return &consensusParamsChainSpec{ChainSpec: cs, params: params}
+}
+
+// consensusParamsChainSpec is a chain spec whose consensus params are
+// overridden.
+type consensusParamsChainSpec struct {
+	primitives.ChainSpec
+	params *cmtproto.ConsensusParams
+}
+
+// GetCometBFTConfigForSlot returns the consensus params of the chain spec for
+// the given slot, updated by the overriding params.
+func (s *consensusParamsChainSpec) GetCometBFTConfigForSlot(
+	slot math.Slot,
+) any {
+	cfg := s.ChainSpec.GetCometBFTConfigForSlot(slot)
+	params, ok := cfg.(*cmttypes.ConsensusParams)
+	if !ok || params == nil {
+		return cfg
 	}
+	updated := params.Update(s.params)
+	return &updated
+}
 
-	return chainSpec
+// ChainSpecName returns the name of the chain spec selected by the chain-spec
+// flag. If the flag is not set, the spec is selected by the CHAIN_SPEC
+// environment variable, and defaults to the testnet spec.
+func ChainSpecName(appOpts servertypes.AppOptions) string {
+	var name string
+	if appOpts != nil {
+		name = cast.ToString(appOpts.Get(flags.ChainSpec))
+	}
+	if name == "" {
+		name = os.Getenv("CHAIN_SPEC")
+	}
+	if name == "" {
+		name = spec.Testnet
+	}
+	return name
 }
@@ -21,6 +21,8 @@
 package components
 
 import (
+	"time"
+
 	"cosmossdk.io/depinject"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/config"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
@@ -29,11 +31,32 @@ import (
 // ConfigInput is the input for the dependency injection framework.
 type ConfigInput struct {
 	depinject.In
-	AppOpts servertypes.AppOptions
+	AppOpts         servertypes.AppOptions
+	EngineEndpoint  *EngineEndpoint  `optional:"true"`
+	ProposerTimeout *ProposerTimeout `optional:"true"`
 }
 
+// ProposerTimeout is the time the node waits for the execution payload of the
+// blocks it proposes. When supplied, it overrides the timeout set in the
+// config of the node.
+type ProposerTimeout time.Duration
+
 // ProvideConfig is a function that provides the BeaconConfig to the
-// application.
+// application. The engine endpoint and the proposer timeout, if supplied,
+// override the ones read from the app options.
 func ProvideConfig(in ConfigInput) (*config.Config, error) {
-	return config.ReadConfigFromAppOpts(in.AppOpts)
+	cfg, err := config.ReadConfigFromAppOpts(in.AppOpts)
+	if err != nil {
+		return nil, err
+	}
+	if in.EngineEndpoint != nil {
+		if cfg.Engine.RPCDialURL, err = in.EngineEndpoint.dialURL(); err != nil {
+			return nil, err
+		}
+		cfg.Engine.JWTSecretPath = in.EngineEndpoint.JWTSecretPath
+	}
+	if in.ProposerTimeout != nil {
+		cfg.Validator.ProposerTimeout = time.Duration(*in.ProposerTimeout)
+	}
+	return cfg, nil
 }
@@ -21,13 +21,14 @@
 package components
 
 import (
+	"context"
+
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
 	"github.com/berachain/beacon-kit/mod/async/pkg/event"
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	dastore "github.com/berachain/beacon-kit/mod/da/pkg/store"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/feed"
-	dastore "github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
-	"github.com/berachain/beacon-kit/mod/storage/pkg/filedb"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/manager"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
 )
@@ -35,12 +36,14 @@ import (
 // DBManagerInput is the input for the dep inject framework.
 type DBManagerInput struct {
 	depinject.In
-	AvailabilityPruner pruner.Pruner[*filedb.RangeDB]
-	DepositPruner      pruner.Pruner[*dastore.KVStore[*types.Deposit]]
+	AvailabilityPruner pruner.Pruner[AvailabilityPrunable]
+	AvailabilityStore  *dastore.Store[*types.BeaconBlockBody]
+	DepositPruner      pruner.Pruner[DepositStore]
 	Logger             log.Logger
 }
 
-// ProvideDBManager provides a DBManager for the depinject framework.
+// ProvideDBManager provides a DBManager for the depinject framework, which
+// starts the pruners and the compaction of the availability store.
 func ProvideDBManager(
 	in DBManagerInput,
 ) (*manager.DBManager[*types.BeaconBlock,
@@ -55,5 +58,22 @@ func ProvideDBManager(
 		in.Logger.With("service", "db-manager"),
 		in.DepositPruner,
 		in.AvailabilityPruner,
+		availabilityStoreCompactor{store: in.AvailabilityStore},
 	)
 }
+
+// availabilityStoreCompactor starts the compaction of the availability store
+// alongside the pruners of the DBManager.
+type availabilityStoreCompactor struct {
+	store *dastore.Store[*types.BeaconBlockBody]
+}
+
+// Name returns the name of the compactor.
+func (c availabilityStoreCompactor) Name() string {
+	return manager.AvailabilityCompactorName
+}
+
+// Start starts the compaction of the availability store.
+func (c availabilityStoreCompactor) Start(ctx context.Context) {
+	c.store.StartCompaction(ctx)
+}
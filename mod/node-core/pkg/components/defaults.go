@@ -31,6 +31,8 @@ func DefaultComponentsWithStandardTypes() []any {
 		ProvideAvailibilityStore[*types.BeaconBlockBody],
 		ProvideBlsSigner,
 		ProvideBlockFeed[*types.BeaconBlock],
+		ProvideBlockStore,
+		ProvideBlockStoreService,
 		ProvideBlobProcessor[*types.BeaconBlockBody],
 		ProvideBlobProofVerifier,
 		ProvideChainService,
@@ -49,13 +51,15 @@ func DefaultComponentsWithStandardTypes() []any {
 		ProvideEngineClient[*types.ExecutionPayload],
 		ProvideExecutionEngine[*types.ExecutionPayload],
 		ProvideFinalizeBlockMiddleware,
+		ProvideHealthChecker,
 		ProvideJWTSecret,
 		ProvideLocalBuilder,
+		ProvidePauser,
 		ProvideRuntime,
 		ProvideServiceRegistry,
 		ProvideStateProcessor,
 		ProvideStorageBackend,
-		ProvideTelemetrySink,
+		ProvideTelemetry,
 		ProvideTrustedSetup,
 		ProvideValidatorMiddleware,
 		ProvideValidatorService,
@@ -31,7 +31,6 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/feed"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
-	depositdb "github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
 )
 
 // DepositServiceIn is the input for the deposit service.
@@ -42,10 +41,10 @@ type DepositServiceIn struct {
 	]
 	BlockFeed     *event.FeedOf[feed.EventID, *feed.Event[*types.BeaconBlock]]
 	ChainSpec     primitives.ChainSpec
-	DepositStore  *depositdb.KVStore[*types.Deposit]
+	DepositStore  DepositStore
 	EngineClient  *engineclient.EngineClient[*types.ExecutionPayload]
 	Logger        log.Logger
-	TelemetrySink *metrics.TelemetrySink
+	TelemetrySink metrics.Telemetry
 }
 
 // ProvideDepositService provides the deposit service to the depinject
@@ -64,7 +63,7 @@ func ProvideDepositService(in DepositServiceIn) *deposit.Service[
 		*types.BeaconBlockBody,
 		*types.BeaconBlock,
 		*feed.Event[*types.BeaconBlock],
-		*depositdb.KVStore[*types.Deposit],
+		DepositStore,
 		*types.ExecutionPayload,
 		event.Subscription,
 	](
@@ -28,7 +28,10 @@ import (
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	"github.com/berachain/beacon-kit/mod/execution/pkg/deposit"
 	"github.com/berachain/beacon-kit/mod/interfaces"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
+	beaconflags "github.com/berachain/beacon-kit/mod/node-core/pkg/config/flags"
 	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/feed"
 	depositstore "github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/manager"
@@ -38,10 +41,18 @@ import (
 	"github.com/spf13/cast"
 )
 
+// DepositStore is the store the deposits of the node are kept in. The
+// components of the node depend on it rather than on a concrete store, such
+// that the KVStore provided by ProvideDepositStore can be replaced by a
+// provider of another implementation.
+type DepositStore = depositstore.Store[*types.Deposit]
+
 // DepositStoreInput is the input for the dep inject framework.
 type DepositStoreInput struct {
 	depinject.In
-	AppOpts servertypes.AppOptions
+	AppOpts       servertypes.AppOptions
+	Dirs          *StoreDirs `optional:"true"`
+	TelemetrySink metrics.Telemetry
 }
 
 // ProvideDepositStore is a function that provides the module to the
@@ -51,12 +62,22 @@ func ProvideDepositStore[
 		interfaces.SSZMarshallable
 		GetIndex() uint64
 		HashTreeRoot() ([32]byte, error)
+		DepositDataRoot() (common.Root, error)
 	},
 ](
 	in DepositStoreInput,
-) (*depositstore.KVStore[DepositT], error) {
+) (depositstore.Store[DepositT], error) {
+	// In dry-run mode the deposit database must not be opened on disk.
+	if cast.ToBool(in.AppOpts.Get(beaconflags.DryRun)) {
+		return depositstore.NewStore[DepositT](&depositstore.KVStoreProvider{
+			KVStoreWithBatch: storev2.NewMemDB(),
+		}, in.TelemetrySink), nil
+	}
+
 	name := "deposits"
-	dir := cast.ToString(in.AppOpts.Get(flags.FlagHome)) + "/data"
+	dir := in.Dirs.depositStoreDir(
+		cast.ToString(in.AppOpts.Get(flags.FlagHome)),
+	)
 	kvp, err := storev2.NewDB(storev2.DBTypePebbleDB, name, dir, nil)
 	if err != nil {
 		return nil, err
@@ -64,7 +85,7 @@ func ProvideDepositStore[
 
 	return depositstore.NewStore[DepositT](&depositstore.KVStoreProvider{
 		KVStoreWithBatch: kvp,
-	}), nil
+	}, in.TelemetrySink), nil
 }
 
 // DepositPrunerInput is the input for the deposit pruner.
@@ -72,18 +93,18 @@ type DepositPrunerInput struct {
 	depinject.In
 	BlockFeed    *event.FeedOf[feed.EventID, *feed.Event[*types.BeaconBlock]]
 	ChainSpec    primitives.ChainSpec
-	DepositStore *depositstore.KVStore[*types.Deposit]
+	DepositStore DepositStore
 	Logger       log.Logger
 }
 
 // ProvideDepositPruner provides a deposit pruner for the depinject framework.
 func ProvideDepositPruner(
 	in DepositPrunerInput,
-) pruner.Pruner[*depositstore.KVStore[*types.Deposit]] {
+) pruner.Pruner[DepositStore] {
 	return pruner.NewPruner[
 		*types.BeaconBlock,
 		*feed.Event[*types.BeaconBlock],
-		*depositstore.KVStore[*types.Deposit],
+		DepositStore,
 		event.Subscription,
 	](
 		in.Logger.With("service", manager.DepositPrunerName),
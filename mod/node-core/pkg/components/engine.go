@@ -26,6 +26,7 @@ import (
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
 	engineclient "github.com/berachain/beacon-kit/mod/execution/pkg/client"
 	execution "github.com/berachain/beacon-kit/mod/execution/pkg/engine"
 	"github.com/berachain/beacon-kit/mod/interfaces"
@@ -35,8 +36,47 @@ import (
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/jwt"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/net/url"
 )
 
+// EngineEndpoint is the endpoint of the engine API of the execution client.
+// When supplied, it overrides the endpoint set in the config of the node.
+type EngineEndpoint struct {
+	// URL is the URL the engine API is dialed at.
+	URL string
+	// JWTSecretPath is the path to the file holding the JWT secret the
+	// requests to the engine API are authenticated with.
+	JWTSecretPath string
+}
+
+// Validate returns an error if the URL of the endpoint is not an HTTP, HTTPS
+// or IPC URL, or if its JWT secret file does not hold a 32 byte secret.
+func (e EngineEndpoint) Validate() error {
+	if _, err := e.dialURL(); err != nil {
+		return err
+	}
+	if _, err := LoadJWTFromFile(e.JWTSecretPath); err != nil {
+		return errors.Wrapf(
+			err, "failed to load jwt secret from %q", e.JWTSecretPath,
+		)
+	}
+	return nil
+}
+
+// dialURL parses the URL of the endpoint.
+func (e EngineEndpoint) dialURL() (*url.ConnectionURL, error) {
+	u, err := url.NewFromRaw(e.URL)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid url %q", e.URL)
+	}
+	if !u.IsHTTP() && !u.IsHTTPS() && !u.IsIPC() {
+		return nil, errors.Newf(
+			"url %q must have an http, https or ipc scheme", e.URL,
+		)
+	}
+	return u, nil
+}
+
 // EngineClientInputs is the input for the EngineClient.
 type EngineClientInputs struct {
 	depinject.In
@@ -44,7 +84,7 @@ type EngineClientInputs struct {
 	Config        *config.Config
 	JWTSecret     *jwt.Secret `optional:"true"`
 	Logger        log.Logger
-	TelemetrySink *metrics.TelemetrySink
+	TelemetrySink metrics.Telemetry
 }
 
 // ProvideEngineClient creates a new EngineClient.
@@ -73,7 +113,7 @@ type ExecutionEngineInput struct {
 	depinject.In
 	EngineClient  *engineclient.EngineClient[*types.ExecutionPayload]
 	Logger        log.Logger
-	TelemetrySink *metrics.TelemetrySink
+	TelemetrySink metrics.Telemetry
 }
 
 // ProvideExecutionEngine provides the execution engine to the depinject
@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"cosmossdk.io/depinject"
+	"cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/beacon/blockchain"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	dastore "github.com/berachain/beacon-kit/mod/da/pkg/store"
+	datypes "github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/services/health"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+)
+
+// rpcListenAddressKey is the key of the address the RPC server of CometBFT
+// listens on in the config of the node.
+const rpcListenAddressKey = "rpc.laddr"
+
+// HealthCheckerInput is the input for the health checker provider.
+type HealthCheckerInput struct {
+	depinject.In
+	AppOpts           servertypes.AppOptions
+	AvailabilityStore *dastore.Store[*types.BeaconBlockBody]
+	ChainService      *blockchain.Service[
+		*dastore.Store[*types.BeaconBlockBody],
+		*types.BeaconBlock,
+		*types.BeaconBlockBody,
+		BeaconState,
+		*datypes.BlobSidecars,
+		*types.Deposit,
+		DepositStore,
+	]
+	Config       *health.Config `optional:"true"`
+	DepositStore DepositStore
+}
+
+// ProvideHealthChecker is the depinject provider for the health checker. The
+// peers of the node are counted through the RPC server of CometBFT.
+func ProvideHealthChecker(in HealthCheckerInput) *health.Checker {
+	maxSyncLag := health.DefaultMaxSyncLag
+	if in.Config != nil {
+		maxSyncLag = in.Config.MaxSyncLag
+	}
+	rpcAddr := cast.ToString(in.AppOpts.Get(rpcListenAddressKey))
+	if rpcAddr == "" {
+		rpcAddr = cmtcfg.DefaultRPCConfig().ListenAddress
+	}
+	return health.NewChecker(
+		maxSyncLag,
+		in.ChainService,
+		in.AvailabilityStore,
+		in.DepositStore,
+		health.NewCometPeerCounter(rpcAddr),
+	)
+}
+
+// HealthServerInput is the input for the health server provider.
+type HealthServerInput struct {
+	depinject.In
+	Checker *health.Checker
+	Config  *health.Config
+	Logger  log.Logger
+}
+
+// ProvideHealthServer is the depinject provider for the health server.
+func ProvideHealthServer(in HealthServerInput) *health.Server {
+	return health.NewServer(
+		in.Logger.With("service", "health"),
+		in.Config.Addr,
+		in.Checker,
+	)
+}
@@ -23,22 +23,68 @@ package components
 import (
 	clientv2keyring "cosmossdk.io/client/v2/autocli/keyring"
 	"cosmossdk.io/core/address"
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 )
 
-// ProvideKeyring provides a keyring for the client.
-func ProvideKeyring(
-	clientCtx client.Context,
-	_ address.Codec,
-) (clientv2keyring.Keyring, error) {
-	kb, err := client.NewKeyringFromBackend(
-		clientCtx,
-		clientCtx.Keyring.Backend(),
-	)
+// ErrUnsupportedKeyringBackend is returned when the keyring backend is not
+// one of the backends supported by the node.
+var ErrUnsupportedKeyringBackend = errors.New("unsupported keyring backend")
+
+// KeyringBackend is the backend of the keyring provided by ProvideKeyring.
+type KeyringBackend string
+
+// Validate returns an error if the backend is not one of the supported
+// keyring backends, namely os, file and test.
+func (b KeyringBackend) Validate() error {
+	switch b {
+	case keyring.BackendOS, keyring.BackendFile, keyring.BackendTest:
+		return nil
+	default:
+		return errors.Wrapf(ErrUnsupportedKeyringBackend, "%q", string(b))
+	}
+}
+
+// KeyringInput is the input for the keyring provider.
+type KeyringInput struct {
+	depinject.In
+	AddressCodec address.Codec
+	// Backend overrides the keyring backend of the client context.
+	Backend   KeyringBackend `optional:"true"`
+	ClientCtx client.Context
+}
+
+// ProvideKeyring provides a keyring for the client. The keyring uses the
+// backend of the client context unless a supported backend is supplied.
+func ProvideKeyring(in KeyringInput) (clientv2keyring.Keyring, error) {
+	kb, err := NewKeyring(in.ClientCtx, in.Backend)
 	if err != nil {
 		return nil, err
 	}
 
 	return keyring.NewAutoCLIKeyring(kb)
 }
+
+// NewKeyring opens the keyring of the node the way ProvideKeyring does,
+// using the backend of the client context unless backend is set.
+func NewKeyring(
+	clientCtx client.Context,
+	backend KeyringBackend,
+) (keyring.Keyring, error) {
+	if backend == "" {
+		if clientCtx.Keyring == nil {
+			return nil, errors.Wrap(
+				ErrUnsupportedKeyringBackend, "no keyring backend configured",
+			)
+		}
+		return client.NewKeyringFromBackend(
+			clientCtx, clientCtx.Keyring.Backend(),
+		)
+	}
+	if err := backend.Validate(); err != nil {
+		return nil, err
+	}
+	return client.NewKeyringFromBackend(clientCtx, string(backend))
+}
@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package metrics
+
+import (
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/hashicorp/go-metrics"
+	"github.com/hashicorp/go-metrics/prometheus"
+)
+
+const (
+	// SinkTypeStatsd is the type of the sink that pushes metrics to a StatsD
+	// server.
+	SinkTypeStatsd = "statsd"
+	// SinkTypePrometheus is the type of the sink that pushes metrics to a
+	// Prometheus push gateway.
+	SinkTypePrometheus = "prometheus"
+
+	// PrometheusPushInterval is the interval at which metrics are pushed to
+	// the Prometheus push gateway.
+	PrometheusPushInterval = 10 * time.Second
+	// PrometheusJobName is the name of the job metrics are pushed to the
+	// Prometheus push gateway under.
+	PrometheusJobName = "beacon-kit"
+)
+
+// TelemetryConfig is the configuration of the sink the node pushes its
+// metrics to.
+type TelemetryConfig struct {
+	// Type is the type of the sink, either SinkTypeStatsd or
+	// SinkTypePrometheus.
+	Type string
+	// Address is the address of the StatsD server or the Prometheus push
+	// gateway.
+	Address string
+	// GlobalTags are added as labels to every metric pushed to the sink.
+	GlobalTags map[string]string
+}
+
+// Validate returns an error if the sink of the config is not supported or
+// has no address.
+func (c TelemetryConfig) Validate() error {
+	switch c.Type {
+	case SinkTypeStatsd, SinkTypePrometheus:
+	default:
+		return errors.Wrapf(ErrUnsupportedSinkType, "type %q", c.Type)
+	}
+	if c.Address == "" {
+		return ErrEmptySinkAddress
+	}
+	return nil
+}
+
+// NewTelemetrySinkFromConfig creates a new TelemetrySink that pushes metrics
+// to the sink described by the given config.
+func NewTelemetrySinkFromConfig(cfg TelemetryConfig) (TelemetrySink, error) {
+	sink, err := newSinkFromConfig(cfg)
+	if err != nil {
+		return TelemetrySink{}, err
+	}
+	return NewTelemetrySinkWithSink(sink, cfg.GlobalTags)
+}
+
+// NewPrometheusTelemetrySink creates a new TelemetrySink that exposes metrics
+// on the default Prometheus registry and, if a config is given, also pushes
+// them to the sink it describes.
+func NewPrometheusTelemetrySink(cfg *TelemetryConfig) (TelemetrySink, error) {
+	sink, err := NewPrometheusSink()
+	if err != nil {
+		return TelemetrySink{}, err
+	}
+	if cfg == nil {
+		return NewTelemetrySinkWithSink(sink, nil)
+	}
+
+	push, err := newSinkFromConfig(*cfg)
+	if err != nil {
+		return TelemetrySink{}, err
+	}
+	return NewTelemetrySinkWithSink(
+		metrics.FanoutSink{sink, push}, cfg.GlobalTags,
+	)
+}
+
+// newSinkFromConfig creates the sink described by the given config.
+func newSinkFromConfig(cfg TelemetryConfig) (metrics.MetricSink, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	switch cfg.Type {
+	case SinkTypeStatsd:
+		return metrics.NewStatsdSink(cfg.Address)
+	default:
+		return prometheus.NewPrometheusPushSink(
+			cfg.Address, PrometheusPushInterval, PrometheusJobName,
+		)
+	}
+}
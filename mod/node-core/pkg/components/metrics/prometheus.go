@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package metrics
+
+import (
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/hashicorp/go-metrics"
+	"github.com/hashicorp/go-metrics/prometheus"
+	prom "github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// PrometheusSinkName is the name the sink exposing the metrics of the
+	// node is registered under on the default Prometheus registry.
+	PrometheusSinkName = "beacon_kit_prometheus_sink"
+	// PrometheusExpiration is the duration after which a metric that is no
+	// longer emitted is dropped from the default Prometheus registry.
+	PrometheusExpiration = time.Minute
+)
+
+// NewPrometheusSink returns a sink that exposes metrics on the default
+// Prometheus registry, which CometBFT serves on its instrumentation listen
+// address. If the sink is already registered, e.g. by another node in the
+// same process, the registered sink is returned instead.
+func NewPrometheusSink() (metrics.MetricSink, error) {
+	sink, err := prometheus.NewPrometheusSinkFrom(prometheus.PrometheusOpts{
+		Expiration: PrometheusExpiration,
+		Name:       PrometheusSinkName,
+	})
+	if err == nil {
+		return sink, nil
+	}
+
+	var registered prom.AlreadyRegisteredError
+	if !errors.As(err, &registered) {
+		return nil, err
+	}
+	existing, ok := registered.ExistingCollector.(*prometheus.PrometheusSink)
+	if !ok {
+		return nil, err
+	}
+	return existing, nil
+}
@@ -21,38 +21,114 @@
 package metrics
 
 import (
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	"github.com/hashicorp/go-metrics"
 )
 
-type TelemetrySink struct{}
+// Telemetry is the interface through which the services of the node emit
+// metrics.
+type Telemetry interface {
+	// IncrementCounter increments a counter metric identified by the provided
+	// keys.
+	IncrementCounter(key string, args ...string)
+	// SetGauge sets a gauge metric to the specified value, identified by the
+	// provided keys.
+	SetGauge(key string, value int64, args ...string)
+	// MeasureSince measures the time since the provided start time and
+	// records the duration in a metric identified by the provided key.
+	MeasureSince(key string, start time.Time, args ...string)
+}
+
+// TelemetrySink emits metrics either through the global telemetry of the
+// SDK, which is a no-op unless telemetry is enabled in the app.toml, or
+// through a sink of its own.
+type TelemetrySink struct {
+	// metrics emits the metrics to the sink of the TelemetrySink, the global
+	// telemetry is used if it is nil.
+	metrics *metrics.Metrics
+	// labels are added to every metric emitted to the sink.
+	labels []metrics.Label
+}
 
 // NewTelemetrySink creates a new TelemetrySink.
 func NewTelemetrySink() TelemetrySink {
 	return TelemetrySink{}
 }
 
+// NewTelemetrySinkWithSink creates a new TelemetrySink that emits metrics to
+// the given sink, labelled with the given global tags.
+func NewTelemetrySinkWithSink(
+	sink metrics.MetricSink,
+	globalTags map[string]string,
+) (TelemetrySink, error) {
+	cfg := metrics.DefaultConfig("")
+	cfg.EnableHostname = false
+	cfg.EnableRuntimeMetrics = false
+	m, err := metrics.New(cfg, sink)
+	if err != nil {
+		return TelemetrySink{}, err
+	}
+
+	labels := make([]metrics.Label, 0, len(globalTags))
+	for name, value := range globalTags {
+		labels = append(labels, metrics.Label{Name: name, Value: value})
+	}
+	slices.SortFunc(labels, func(a, b metrics.Label) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return TelemetrySink{metrics: m, labels: labels}, nil
+}
+
 // IncrementCounter increments a counter metric identified by the provided
 // keys.
-func (TelemetrySink) IncrementCounter(key string, args ...string) {
-	telemetry.IncrCounterWithLabels([]string{key}, 1, argsToLabels(args...))
+func (s TelemetrySink) IncrementCounter(key string, args ...string) {
+	if s.metrics == nil {
+		telemetry.IncrCounterWithLabels(
+			[]string{key}, 1, argsToLabels(args...),
+		)
+		return
+	}
+	s.metrics.IncrCounterWithLabels([]string{key}, 1, s.argsToLabels(args...))
 }
 
 // SetGauge sets a gauge metric to the specified value, identified by the
 // provided keys.
-func (TelemetrySink) SetGauge(key string, value int64, args ...string) {
-	telemetry.SetGaugeWithLabels(
+func (s TelemetrySink) SetGauge(key string, value int64, args ...string) {
+	if s.metrics == nil {
+		telemetry.SetGaugeWithLabels(
+			[]string{key},
+			float32(value),
+			argsToLabels(args...),
+		)
+		return
+	}
+	s.metrics.SetGaugeWithLabels(
 		[]string{key},
 		float32(value),
-		argsToLabels(args...),
+		s.argsToLabels(args...),
 	)
 }
 
 // MeasureSince measures the time since the provided start time and records
 // the duration in a metric identified by the provided key.
-func (TelemetrySink) MeasureSince(key string, start time.Time, args ...string) {
+func (s TelemetrySink) MeasureSince(
+	key string,
+	start time.Time,
+	args ...string,
+) {
+	if s.metrics != nil {
+		s.metrics.MeasureSinceWithLabels(
+			[]string{key},
+			start.UTC(),
+			s.argsToLabels(args...),
+		)
+		return
+	}
+
 	if !telemetry.IsTelemetryEnabled() {
 		return
 	}
@@ -65,6 +141,20 @@ func (TelemetrySink) MeasureSince(key string, start time.Time, args ...string) {
 	)
 }
 
+// Close shuts down the sink of the TelemetrySink, if it has one.
+func (s TelemetrySink) Close() error {
+	if s.metrics != nil {
+		s.metrics.Shutdown()
+	}
+	return nil
+}
+
+// argsToLabels converts a list of key-value pairs to a list of metrics labels
+// following the global labels of the TelemetrySink.
+func (s TelemetrySink) argsToLabels(args ...string) []metrics.Label {
+	return append(slices.Clone(s.labels), argsToLabels(args...)...)
+}
+
 // argsToLabels converts a list of key-value pairs to a list of metrics labels.
 //
 //nolint:mnd // its okay.
@@ -0,0 +1,139 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package metrics_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
+	gometrics "github.com/hashicorp/go-metrics"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSink is a go-metrics sink recording the metrics emitted to it.
+type fakeSink struct {
+	gometrics.BlackholeSink
+	counters map[string][]gometrics.Label
+	gauges   map[string]float32
+	samples  map[string]int
+	shutdown bool
+}
+
+func newFakeSink() *fakeSink {
+	return &fakeSink{
+		counters: make(map[string][]gometrics.Label),
+		gauges:   make(map[string]float32),
+		samples:  make(map[string]int),
+	}
+}
+
+func (s *fakeSink) IncrCounterWithLabels(
+	key []string, _ float32, labels []gometrics.Label,
+) {
+	s.counters[key[0]] = labels
+}
+
+func (s *fakeSink) SetGaugeWithLabels(
+	key []string, val float32, _ []gometrics.Label,
+) {
+	s.gauges[key[0]] = val
+}
+
+func (s *fakeSink) AddSampleWithLabels(
+	key []string, _ float32, _ []gometrics.Label,
+) {
+	s.samples[key[0]]++
+}
+
+func (s *fakeSink) Shutdown() {
+	s.shutdown = true
+}
+
+func TestTelemetrySinkWithSink(t *testing.T) {
+	sink := newFakeSink()
+	telemetry, err := metrics.NewTelemetrySinkWithSink(
+		sink, map[string]string{"node": "a", "chain": "devnet"},
+	)
+	require.NoError(t, err)
+
+	telemetry.IncrementCounter("beacon_kit.blocks", "fork", "deneb")
+	telemetry.SetGauge("beacon_kit.slot", 42)
+	telemetry.MeasureSince("beacon_kit.duration", time.Now())
+
+	// The global tags are sorted by name and precede the labels of the
+	// metric.
+	require.Equal(t, []gometrics.Label{
+		{Name: "chain", Value: "devnet"},
+		{Name: "node", Value: "a"},
+		{Name: "fork", Value: "deneb"},
+	}, sink.counters["beacon_kit.blocks"])
+	require.InDelta(t, 42, sink.gauges["beacon_kit.slot"], 0)
+	require.Equal(t, 1, sink.samples["beacon_kit.duration"])
+
+	require.NoError(t, telemetry.Close())
+	require.True(t, sink.shutdown)
+}
+
+func TestTelemetryConfigValidate(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		cfg         metrics.TelemetryConfig
+		expectedErr error
+	}{
+		{
+			name: "statsd",
+			cfg: metrics.TelemetryConfig{
+				Type:    metrics.SinkTypeStatsd,
+				Address: "127.0.0.1:8125",
+			},
+		},
+		{
+			name: "prometheus",
+			cfg: metrics.TelemetryConfig{
+				Type:    metrics.SinkTypePrometheus,
+				Address: "http://127.0.0.1:9091",
+			},
+		},
+		{
+			name: "unsupported type",
+			cfg: metrics.TelemetryConfig{
+				Type:    "datadog",
+				Address: "127.0.0.1:8125",
+			},
+			expectedErr: metrics.ErrUnsupportedSinkType,
+		},
+		{
+			name:        "empty address",
+			cfg:         metrics.TelemetryConfig{Type: metrics.SinkTypeStatsd},
+			expectedErr: metrics.ErrEmptySinkAddress,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.cfg.Validate()
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
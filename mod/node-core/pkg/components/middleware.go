@@ -30,7 +30,6 @@ import (
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
 	"github.com/berachain/beacon-kit/mod/primitives"
 	"github.com/berachain/beacon-kit/mod/runtime/pkg/runtime/middleware"
-	depositdb "github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
 )
 
 // ValidatorMiddlewareInput is the input for the validator middleware provider.
@@ -43,23 +42,25 @@ type ValidatorMiddlewareInput struct {
 		BeaconState,
 		*datypes.BlobSidecars,
 		*types.Deposit,
-		*depositdb.KVStore[*types.Deposit],
+		DepositStore,
 	]
 	ChainSpec        primitives.ChainSpec
+	Pauser           *middleware.Pauser
 	StorageBackend   StorageBackend
-	TelemetrySink    *metrics.TelemetrySink
+	TelemetrySink    metrics.Telemetry
 	ValidatorService *validator.Service[
 		*types.BeaconBlock,
 		*types.BeaconBlockBody,
 		BeaconState,
 		*datypes.BlobSidecars,
-		*depositdb.KVStore[*types.Deposit],
+		DepositStore,
 		*types.ForkData,
-	]
+	] `optional:"true"`
 }
 
 // ProvideValidatorMiddleware is a depinject provider for the validator
-// middleware.
+// middleware. If no validator service is provided, as is the case for a
+// read-only node, the middleware refuses to prepare proposals.
 func ProvideValidatorMiddleware(
 	in ValidatorMiddlewareInput,
 ) *middleware.ValidatorMiddleware[
@@ -70,13 +71,20 @@ func ProvideValidatorMiddleware(
 	*datypes.BlobSidecars,
 	StorageBackend,
 ] {
+	var validatorService middleware.ValidatorService[
+		*types.BeaconBlock, BeaconState, *datypes.BlobSidecars,
+	] = readOnlyValidatorService{}
+	if in.ValidatorService != nil {
+		validatorService = in.ValidatorService
+	}
 	return middleware.
 		NewValidatorMiddleware[*dastore.Store[*types.BeaconBlockBody]](
 		in.ChainSpec,
-		in.ValidatorService,
+		validatorService,
 		in.ChainService,
 		in.TelemetrySink,
 		in.StorageBackend,
+		in.Pauser,
 	)
 }
 
@@ -90,10 +98,11 @@ type FinalizeBlockMiddlewareInput struct {
 		BeaconState,
 		*datypes.BlobSidecars,
 		*types.Deposit,
-		*depositdb.KVStore[*types.Deposit],
+		DepositStore,
 	]
 	ChainSpec     primitives.ChainSpec
-	TelemetrySink *metrics.TelemetrySink
+	Pauser        *middleware.Pauser
+	TelemetrySink metrics.Telemetry
 }
 
 // ProvideFinalizeBlockMiddleware is a depinject provider for the finalize block
@@ -109,5 +118,6 @@ func ProvideFinalizeBlockMiddleware(
 		in.ChainSpec,
 		in.ChainService,
 		in.TelemetrySink,
+		in.Pauser,
 	)
 }
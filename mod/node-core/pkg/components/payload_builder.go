@@ -45,11 +45,34 @@ func ProvideLocalBuilder(
 	in LocalBuilderInput,
 ) *payloadbuilder.PayloadBuilder[
 	BeaconState, *types.ExecutionPayload, *types.ExecutionPayloadHeader,
+] {
+	return newLocalBuilder(in, &in.Cfg.PayloadBuilder)
+}
+
+// ProvideReadOnlyLocalBuilder provides the payload builder of a read-only
+// node. The builder is always disabled, such that the node never requests
+// payloads from the execution client.
+func ProvideReadOnlyLocalBuilder(
+	in LocalBuilderInput,
+) *payloadbuilder.PayloadBuilder[
+	BeaconState, *types.ExecutionPayload, *types.ExecutionPayloadHeader,
+] {
+	cfg := in.Cfg.PayloadBuilder
+	cfg.Enabled = false
+	return newLocalBuilder(in, &cfg)
+}
+
+// newLocalBuilder returns a payload builder with the given config.
+func newLocalBuilder(
+	in LocalBuilderInput,
+	cfg *payloadbuilder.Config,
+) *payloadbuilder.PayloadBuilder[
+	BeaconState, *types.ExecutionPayload, *types.ExecutionPayloadHeader,
 ] {
 	return payloadbuilder.New[
 		BeaconState, *types.ExecutionPayload, *types.ExecutionPayloadHeader,
 	](
-		&in.Cfg.PayloadBuilder,
+		cfg,
 		in.ChainSpec,
 		in.Logger.With("service", "payload-builder"),
 		in.ExecutionEngine,
@@ -23,17 +23,17 @@ package components
 import (
 	"cosmossdk.io/core/log"
 	"cosmossdk.io/depinject"
-	"github.com/berachain/beacon-kit/mod/beacon/blockchain"
+	"github.com/berachain/beacon-kit/mod/async/pkg/event"
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	dastore "github.com/berachain/beacon-kit/mod/da/pkg/store"
 	datypes "github.com/berachain/beacon-kit/mod/da/pkg/types"
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/feed"
 	"github.com/berachain/beacon-kit/mod/runtime/pkg/runtime"
 	"github.com/berachain/beacon-kit/mod/runtime/pkg/runtime/middleware"
 	"github.com/berachain/beacon-kit/mod/runtime/pkg/service"
 	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
-	depositdb "github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
 )
 
 // BeaconState is a type alias for the BeaconState.
@@ -50,25 +50,22 @@ type BeaconKitRuntime = runtime.BeaconKitRuntime[
 	*types.BeaconBlockBody,
 	BeaconState,
 	*datypes.BlobSidecars,
-	*depositdb.KVStore[*types.Deposit],
-	blockchain.StorageBackend[
-		*dastore.Store[*types.BeaconBlockBody],
-		*types.BeaconBlockBody,
-		BeaconState,
-		*datypes.BlobSidecars,
-		*types.Deposit,
-		*depositdb.KVStore[*types.Deposit],
-	],
+	DepositStore,
+	StorageBackend,
 ]
 
 // RuntimeInput is the input for the runtime provider.
 type RuntimeInput struct {
 	depinject.In
+	BlockFeed *event.FeedOf[
+		feed.EventID, *feed.Event[*types.BeaconBlock],
+	]
 	ChainSpec               primitives.ChainSpec
 	FinalizeBlockMiddleware *middleware.FinalizeBlockMiddleware[
 		*types.BeaconBlock, BeaconState, *datypes.BlobSidecars,
 	]
 	Logger              log.Logger
+	Pauser              *middleware.Pauser
 	ServiceRegistry     *service.Registry
 	StorageBackend      StorageBackend
 	ValidatorMiddleware *middleware.ValidatorMiddleware[
@@ -92,19 +89,14 @@ func ProvideRuntime(
 		*types.BeaconBlockBody,
 		BeaconState,
 		*datypes.BlobSidecars,
-		*depositdb.KVStore[*types.Deposit],
-		blockchain.StorageBackend[
-			*dastore.Store[*types.BeaconBlockBody],
-			*types.BeaconBlockBody,
-			BeaconState,
-			*datypes.BlobSidecars,
-			*types.Deposit,
-			*depositdb.KVStore[*types.Deposit],
-		],
+		DepositStore,
+		StorageBackend,
 	](
+		in.BlockFeed,
 		in.ChainSpec,
 		in.FinalizeBlockMiddleware,
 		in.Logger,
+		in.Pauser,
 		in.ServiceRegistry,
 		in.StorageBackend,
 		in.ValidatorMiddleware,
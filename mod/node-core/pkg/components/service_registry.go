@@ -32,10 +32,12 @@ import (
 	engineclient "github.com/berachain/beacon-kit/mod/execution/pkg/client"
 	"github.com/berachain/beacon-kit/mod/execution/pkg/deposit"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/services/admin"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/services/health"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/services/version"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/feed"
 	"github.com/berachain/beacon-kit/mod/runtime/pkg/service"
-	depositdb "github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
+	blockstore "github.com/berachain/beacon-kit/mod/storage/pkg/block"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/manager"
 	sdkversion "github.com/cosmos/cosmos-sdk/version"
 )
@@ -43,6 +45,12 @@ import (
 // ServiceRegistryInput is the input for the service registry provider.
 type ServiceRegistryInput struct {
 	depinject.In
+	AdminServer       *admin.Server `optional:"true"`
+	BlockStoreService *blockstore.Service[
+		*types.BeaconBlock,
+		*feed.Event[*types.BeaconBlock],
+		event.Subscription,
+	]
 	ChainService *blockchain.Service[
 		*dastore.Store[*types.BeaconBlockBody],
 		*types.BeaconBlock,
@@ -50,7 +58,7 @@ type ServiceRegistryInput struct {
 		BeaconState,
 		*datypes.BlobSidecars,
 		*types.Deposit,
-		*depositdb.KVStore[*types.Deposit],
+		DepositStore,
 	]
 	DBManagerService *manager.DBManager[
 		*types.BeaconBlock,
@@ -67,25 +75,31 @@ type ServiceRegistryInput struct {
 		types.WithdrawalCredentials,
 	]
 	EngineClient     *engineclient.EngineClient[*types.ExecutionPayload]
+	HealthServer     *health.Server `optional:"true"`
 	Logger           log.Logger
-	TelemetrySink    *metrics.TelemetrySink
+	TelemetrySink    metrics.Telemetry
 	ValidatorService *validator.Service[
 		*types.BeaconBlock,
 		*types.BeaconBlockBody,
 		BeaconState,
 		*datypes.BlobSidecars,
-		*depositdb.KVStore[*types.Deposit],
+		DepositStore,
 		*types.ForkData,
-	]
+	] `optional:"true"`
 }
 
 // ProvideServiceRegistry is the depinject provider for the service registry.
 func ProvideServiceRegistry(
 	in ServiceRegistryInput,
 ) *service.Registry {
-	return service.NewRegistry(
+	opts := []service.RegistryOption{
 		service.WithLogger(in.Logger.With("service", "service-registry")),
-		service.WithService(in.ValidatorService),
+	}
+	// The validator service is not provided on read-only nodes.
+	if in.ValidatorService != nil {
+		opts = append(opts, service.WithService(in.ValidatorService))
+	}
+	opts = append(opts,
 		service.WithService(in.ChainService),
 		service.WithService(in.DepositService),
 		service.WithService(in.EngineClient),
@@ -95,5 +109,15 @@ func ProvideServiceRegistry(
 			sdkversion.Version,
 		)),
 		service.WithService(in.DBManagerService),
+		service.WithService(in.BlockStoreService),
 	)
+	// The health server is only provided if enabled on the node builder.
+	if in.HealthServer != nil {
+		opts = append(opts, service.WithService(in.HealthServer))
+	}
+	// The admin server is only provided if enabled on the node builder.
+	if in.AdminServer != nil {
+		opts = append(opts, service.WithService(in.AdminServer))
+	}
+	return service.NewRegistry(opts...)
 }
@@ -22,6 +22,7 @@ package components
 
 import (
 	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/signer"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
@@ -37,6 +38,12 @@ type BlsSignerInput struct {
 	PrivKey LegacyKey `optional:"true"`
 }
 
+// ErrSigningKeyInReadOnlyMode is returned when a validator signing key is
+// supplied to a read-only node.
+var ErrSigningKeyInReadOnlyMode = errors.New(
+	"read-only node must not be given a signing key",
+)
+
 // LegacyKey type alias to LegacyKey used for LegacySinger construction.
 type LegacyKey = signer.LegacyKey
 
@@ -53,6 +60,16 @@ func ProvideBlsSigner(in BlsSignerInput) (crypto.BLSSigner, error) {
 	return signer.NewLegacySigner(in.PrivKey)
 }
 
+// ProvideReadOnlySigner provides the signer of a read-only node, which
+// verifies signatures but never loads a validator signing key. It fails if a
+// signing key is supplied nonetheless.
+func ProvideReadOnlySigner(in BlsSignerInput) (crypto.BLSSigner, error) {
+	if in.PrivKey != [constants.BLSSecretKeyLength]byte{} {
+		return nil, ErrSigningKeyInReadOnlyMode
+	}
+	return signer.NewVerifyingSigner(), nil
+}
+
 func GetLegacyKey(privKey string) (LegacyKey, error) {
 	return signer.LegacyKeyFromString(privKey)
 }
@@ -38,4 +38,8 @@ var (
 	ErrInvalidValidatorPrivateKeyLength = errors.New(
 		"invalid validator private key length",
 	)
+
+	// ErrSigningDisabled is returned when a signer that holds no secret key
+	// is asked to sign.
+	ErrSigningDisabled = errors.New("signing is disabled")
 )
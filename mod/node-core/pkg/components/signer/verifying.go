@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package signer
+
+import "github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+
+// VerifyingSigner is a signer that holds no secret key. It verifies
+// signatures like any other signer but refuses to sign, which makes it
+// suitable for nodes that must never act as a validator.
+type VerifyingSigner struct{}
+
+// NewVerifyingSigner returns a new VerifyingSigner.
+func NewVerifyingSigner() VerifyingSigner {
+	return VerifyingSigner{}
+}
+
+// PublicKey returns the zero public key, as the signer holds no key.
+func (VerifyingSigner) PublicKey() crypto.BLSPubkey {
+	return crypto.BLSPubkey{}
+}
+
+// Sign always returns ErrSigningDisabled.
+func (VerifyingSigner) Sign([]byte) (crypto.BLSSignature, error) {
+	return crypto.BLSSignature{}, ErrSigningDisabled
+}
+
+// VerifySignature verifies a signature against a message and a public key.
+func (VerifyingSigner) VerifySignature(
+	pubKey crypto.BLSPubkey,
+	msg []byte,
+	signature crypto.BLSSignature,
+) error {
+	return BLSSigner{}.VerifySignature(pubKey, msg, signature)
+}
@@ -33,7 +33,6 @@ import (
 	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb/encoding"
-	depositdb "github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
 )
 
 // StorageBackend is the type alias for the storage backend interface.
@@ -43,15 +42,16 @@ type StorageBackend = blockchain.StorageBackend[
 	BeaconState,
 	*datypes.BlobSidecars,
 	*types.Deposit,
-	*depositdb.KVStore[*types.Deposit],
+	DepositStore,
 ]
 
 // StorageBackendInput is the input for the ProvideStorageBackend function.
 type StorageBackendInput struct {
 	depinject.In
 	AvailabilityStore *dastore.Store[*types.BeaconBlockBody]
+	BlockStore        *BlockStore `optional:"true"`
 	ChainSpec         primitives.ChainSpec
-	DepositStore      *depositdb.KVStore[*types.Deposit]
+	DepositStore      DepositStore
 	KVStore           *beacondb.KVStore[
 		*types.Fork, *types.BeaconBlockHeader, *types.ExecutionPayloadHeader,
 		*types.Eth1Data, *types.Validator,
@@ -63,7 +63,7 @@ type StorageBackendInput struct {
 func ProvideStorageBackend(
 	in StorageBackendInput,
 ) StorageBackend {
-	return storage.NewBackend[
+	backend := storage.NewBackend[
 		*dastore.Store[*types.BeaconBlockBody],
 		*types.BeaconBlock,
 		*types.BeaconBlockBody,
@@ -78,6 +78,10 @@ func ProvideStorageBackend(
 		in.KVStore,
 		in.DepositStore,
 	)
+	if in.BlockStore != nil {
+		backend.SetBlockStore(in.BlockStore)
+	}
+	return backend
 }
 
 // KVStoreInput is the input for the ProvideKVStore function.
@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package storage
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/block"
+)
+
+var (
+	// ErrHistoricalStateUnavailable is returned when a historical state or a
+	// view of the committed state is requested but no multi store was set on
+	// the backend.
+	ErrHistoricalStateUnavailable = errors.New(
+		"historical state unavailable: multi store not set",
+	)
+	// ErrSlotOutOfRange is returned when the state requested is outside of
+	// the range of slots retained by the multi store.
+	ErrSlotOutOfRange = errors.New("slot outside of the retained range")
+	// ErrStateNotRetained is returned when the state requested is older than
+	// the states retained by the multi store, which are pruned beyond its
+	// retention depth.
+	ErrStateNotRetained = errors.Wrap(
+		ErrSlotOutOfRange, "state beyond the retention depth",
+	)
+	// ErrBlockStoreUnavailable is returned when a block is requested but no
+	// block store was set on the backend.
+	ErrBlockStoreUnavailable = errors.New(
+		"blocks unavailable: block store not set",
+	)
+	// ErrValidatorNotFound is returned when the balance of a validator is
+	// requested for an index that is not in the committed state.
+	ErrValidatorNotFound = errors.New("validator not found")
+	// ErrBlockNotFound is returned when the block requested is not held by
+	// the block store.
+	ErrBlockNotFound = block.ErrNotFound
+)
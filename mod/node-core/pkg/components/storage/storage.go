@@ -22,16 +22,25 @@ package storage
 
 import (
 	"context"
+	"sort"
 
+	"cosmossdk.io/collections"
+	"cosmossdk.io/log"
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/mod/beacon/blockchain"
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	datypes "github.com/berachain/beacon-kit/mod/da/pkg/types"
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/runtime/pkg/runtime"
 	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core"
 	"github.com/berachain/beacon-kit/mod/state-transition/pkg/core/state"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // KVStore is a type alias for the beacon store with the generics defined using
@@ -41,6 +50,43 @@ type KVStore = beacondb.KVStore[
 	*types.Eth1Data, *types.Validator,
 ]
 
+// VersionedMultiStore is the committed multi store of the application, which
+// can be branched at any of its retained versions.
+type VersionedMultiStore interface {
+	// LatestVersion returns the latest committed version.
+	LatestVersion() int64
+	// CacheMultiStoreWithVersion branches the multi store at the given
+	// version.
+	CacheMultiStoreWithVersion(version int64) (storetypes.CacheMultiStore, error)
+	// GetPruning returns the pruning options of the multi store, which
+	// determine the depth its versions are retained to.
+	GetPruning() pruningtypes.PruningOptions
+}
+
+// statePruneInterval is the number of slots between two prunings of the
+// states beyond the retention depth of the multi store.
+const statePruneInterval = 10
+
+// PruningOptions returns the pruning options of a multi store retaining the
+// states of the given number of slots below the latest committed one. The
+// latest state is the finalized one and is always retained, while the older
+// ones are pruned every statePruneInterval slots as new states are committed.
+// All states are retained if slots is 0.
+func PruningOptions(slots uint64) pruningtypes.PruningOptions {
+	if slots == 0 {
+		return pruningtypes.NewPruningOptions(pruningtypes.PruningNothing)
+	}
+	return pruningtypes.NewCustomPruningOptions(slots, statePruneInterval)
+}
+
+// BlockStore is the store the finalized blocks are served from.
+type BlockStore[BeaconBlockT any] interface {
+	// GetByRoot returns the block with the given root.
+	GetByRoot(root [32]byte) (BeaconBlockT, error)
+	// GetBySlot returns the block at the given slot.
+	GetBySlot(slot math.Slot) (BeaconBlockT, error)
+}
+
 // Backend is a struct that holds the storage backend. It provides a simple
 // interface to access all types of storage required by the runtime.
 type Backend[
@@ -52,12 +98,14 @@ type Backend[
 	BeaconStateT core.BeaconState[
 		*types.BeaconBlockHeader, *types.Eth1Data, *types.ExecutionPayloadHeader,
 		*types.Fork, *types.Validator, *engineprimitives.Withdrawal],
-	DepositStoreT *deposit.KVStore[*types.Deposit],
+	DepositStoreT deposit.Store[*types.Deposit],
 ] struct {
 	cs primitives.ChainSpec
 	as AvailabilityStoreT
 	bs *KVStore
 	ds DepositStoreT
+	ms VersionedMultiStore
+	bk BlockStore[BeaconBlock]
 }
 
 func NewBackend[
@@ -70,7 +118,7 @@ func NewBackend[
 		*types.BeaconBlockHeader, *types.Eth1Data,
 		*types.ExecutionPayloadHeader, *types.Fork,
 		*types.Validator, *engineprimitives.Withdrawal],
-	DepositStoreT *deposit.KVStore[*types.Deposit],
+	DepositStoreT deposit.Store[*types.Deposit],
 ](
 	cs primitives.ChainSpec,
 	as AvailabilityStoreT,
@@ -100,8 +148,14 @@ func (k Backend[
 	return k.as
 }
 
-// BeaconState returns the beacon state struct initialized with a given
+// StateFromContext returns the beacon state struct initialized with a given
 // context and the store key.
+//
+// The state returned is a shared reference: it reads and writes through the
+// store of the given context, so any mutation is visible to every other
+// state obtained from the same context. Callers that mutate the state
+// speculatively, e.g. to simulate a state transition, must call Copy on it
+// first and only Save the copy once the changes are to be kept.
 func (k Backend[
 	AvailabilityStoreT, BeaconBlockT,
 	BeaconBlockBodyT, BeaconStateT, DepositT,
@@ -113,6 +167,214 @@ func (k Backend[
 	)
 }
 
+// StateAtSlot returns the beacon state as it was committed at the given slot.
+//
+// Since a block is committed at every height and the slot of a block is its
+// height, the state of a slot is the version of the multi store at that
+// height. As such, the slots retained are bounded by the pruning settings of
+// the application: with the default strategy only the most recent versions
+// are kept on disk, so serving older states requires running with
+// pruning set to "nothing". ErrStateNotRetained is returned for the slots
+// beyond the retention depth of the multi store. The state returned is backed
+// by a branch of the multi store that is never written, so it is safe to
+// mutate and does not affect the live state.
+func (k Backend[
+	AvailabilityStoreT, BeaconBlockT,
+	BeaconBlockBodyT, BeaconStateT, DepositStoreT,
+]) StateAtSlot(
+	ctx context.Context,
+	slot math.Slot,
+) (BeaconStateT, error) {
+	var st BeaconStateT
+	if k.ms == nil {
+		return st, ErrHistoricalStateUnavailable
+	}
+
+	latest := k.ms.LatestVersion()
+	if slot == 0 || slot.Unwrap() > uint64(latest) {
+		return st, errors.Wrapf(
+			ErrSlotOutOfRange, "slot %d, latest slot %d", slot, latest,
+		)
+	}
+	if earliest := k.earliestRetainedSlot(latest); slot < earliest {
+		return st, errors.Wrapf(
+			ErrStateNotRetained,
+			"slot %d, earliest retained slot %d", slot, earliest,
+		)
+	}
+
+	return k.stateAtVersion(ctx, int64(slot))
+}
+
+// AvailableStateSlots returns the lowest and the highest slot whose state is
+// retained by the multi store, such that StateAtSlot serves the state of each
+// slot in between. States below the lowest slot have either been pruned or
+// precede the initial height of the chain.
+func (k Backend[
+	AvailabilityStoreT, BeaconBlockT,
+	BeaconBlockBodyT, BeaconStateT, DepositStoreT,
+]) AvailableStateSlots(_ context.Context) (math.Slot, math.Slot, error) {
+	if k.ms == nil {
+		return 0, 0, ErrHistoricalStateUnavailable
+	}
+
+	latest := k.ms.LatestVersion()
+	if latest == 0 {
+		return 0, 0, errors.Wrap(ErrSlotOutOfRange, "no state committed yet")
+	}
+
+	// Pruning only ever removes the oldest versions, so the retained versions
+	// are contiguous up to the latest one and the lowest can be searched for.
+	earliest := sort.Search(int(latest), func(i int) bool {
+		_, err := k.ms.CacheMultiStoreWithVersion(int64(i) + 1)
+		return err == nil
+	}) + 1
+	// The states beyond the retention depth that are yet to be pruned are
+	// not served either.
+	return max(math.Slot(earliest), k.earliestRetainedSlot(latest)),
+		math.Slot(latest), nil
+}
+
+// earliestRetainedSlot returns the earliest slot within the retention depth
+// of the multi store, given the latest committed slot.
+func (k Backend[
+	AvailabilityStoreT, BeaconBlockT,
+	BeaconBlockBodyT, BeaconStateT, DepositStoreT,
+]) earliestRetainedSlot(latest int64) math.Slot {
+	opts := k.ms.GetPruning()
+	if opts.GetPruningStrategy() == pruningtypes.PruningNothing ||
+		opts.KeepRecent >= uint64(latest) {
+		return 1
+	}
+	return math.Slot(uint64(latest) - opts.KeepRecent)
+}
+
+// StateView returns a read-only view of the latest committed beacon state.
+//
+// The view is backed by a branch of the multi store taken at its latest
+// version when the view is created, such that every read through it is served
+// from that same version: the reads are consistent with each other even as
+// the live state advances, and they do not contend with the writes of the
+// live state.
+func (k Backend[
+	AvailabilityStoreT, BeaconBlockT,
+	BeaconBlockBodyT, BeaconStateT, DepositStoreT,
+]) StateView(ctx context.Context) (blockchain.StateReader, error) {
+	if k.ms == nil {
+		return nil, ErrHistoricalStateUnavailable
+	}
+
+	latest := k.ms.LatestVersion()
+	if latest == 0 {
+		return nil, errors.Wrap(ErrSlotOutOfRange, "no state committed yet")
+	}
+	st, err := k.stateAtVersion(ctx, latest)
+	if err != nil {
+		return nil, err
+	}
+	return st, nil
+}
+
+// BalanceOf returns the balance of the validator with the given index in the
+// latest committed beacon state. Only the balance of that validator is read,
+// not the whole list of balances. It returns ErrValidatorNotFound if the index
+// is beyond the validators of the state.
+func (k Backend[
+	AvailabilityStoreT, BeaconBlockT,
+	BeaconBlockBodyT, BeaconStateT, DepositStoreT,
+]) BalanceOf(
+	ctx context.Context,
+	index math.ValidatorIndex,
+) (math.Gwei, error) {
+	if k.ms == nil {
+		return 0, ErrHistoricalStateUnavailable
+	}
+
+	latest := k.ms.LatestVersion()
+	if latest == 0 {
+		return 0, errors.Wrap(ErrSlotOutOfRange, "no state committed yet")
+	}
+	st, err := k.stateAtVersion(ctx, latest)
+	if err != nil {
+		return 0, err
+	}
+	balance, err := st.GetBalance(index)
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, errors.Wrapf(ErrValidatorNotFound, "index %d", index)
+	}
+	return balance, err
+}
+
+// stateAtVersion returns the beacon state backed by a branch of the multi
+// store at the given version.
+func (k Backend[
+	AvailabilityStoreT, BeaconBlockT,
+	BeaconBlockBodyT, BeaconStateT, DepositStoreT,
+]) stateAtVersion(
+	ctx context.Context,
+	version int64,
+) (BeaconStateT, error) {
+	var st BeaconStateT
+	cms, err := k.ms.CacheMultiStoreWithVersion(version)
+	if err != nil {
+		return st, errors.Wrapf(
+			ErrStateNotRetained, "slot %d has been pruned: %v", version, err,
+		)
+	}
+
+	sdkCtx := sdk.NewContext(cms, true, log.NewNopLogger()).
+		WithContext(ctx).
+		WithBlockHeight(version)
+	//nolint:contextcheck // sdkCtx wraps ctx.
+	return state.NewBeaconStateFromDB[BeaconStateT](
+		k.bs.WithContext(sdkCtx), k.cs,
+	), nil
+}
+
+// SetMultiStore sets the multi store historical states are served from.
+func (k *Backend[
+	AvailabilityStoreT, BeaconBlockT,
+	BeaconBlockBodyT, BeaconStateT, DepositStoreT,
+]) SetMultiStore(ms VersionedMultiStore) {
+	k.ms = ms
+}
+
+// SetBlockStore sets the block store finalized blocks are served from.
+func (k *Backend[
+	AvailabilityStoreT, BeaconBlockT,
+	BeaconBlockBodyT, BeaconStateT, DepositStoreT,
+]) SetBlockStore(bk BlockStore[BeaconBlockT]) {
+	k.bk = bk
+}
+
+// BlockByRoot returns the finalized block with the given root. It returns
+// ErrBlockNotFound if the block store does not hold it, which is the case for
+// the blocks finalized before the block store was introduced.
+func (k Backend[
+	AvailabilityStoreT, BeaconBlockT,
+	BeaconBlockBodyT, BeaconStateT, DepositStoreT,
+]) BlockByRoot(root [32]byte) (BeaconBlockT, error) {
+	if k.bk == nil {
+		var blk BeaconBlockT
+		return blk, ErrBlockStoreUnavailable
+	}
+	return k.bk.GetByRoot(root)
+}
+
+// BlockBySlot returns the finalized block at the given slot. It returns
+// ErrBlockNotFound if the block store does not hold it, such as for a slot
+// that has not been finalized yet.
+func (k Backend[
+	AvailabilityStoreT, BeaconBlockT,
+	BeaconBlockBodyT, BeaconStateT, DepositStoreT,
+]) BlockBySlot(slot math.Slot) (BeaconBlockT, error) {
+	if k.bk == nil {
+		var blk BeaconBlockT
+		return blk, ErrBlockStoreUnavailable
+	}
+	return k.bk.GetBySlot(slot)
+}
+
 // BeaconStore returns the beacon store struct.
 func (k Backend[
 	AvailabilityStoreT, BeaconBlockT,
@@ -0,0 +1,490 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package storage_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	storev2 "cosmossdk.io/store/v2/db"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/state/deneb"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	dastore "github.com/berachain/beacon-kit/mod/da/pkg/store"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/storage"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/config/spec"
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	statedb "github.com/berachain/beacon-kit/mod/state-transition/pkg/core/state"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb/encoding"
+	blockstore "github.com/berachain/beacon-kit/mod/storage/pkg/block"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+type testBackend = storage.Backend[
+	*dastore.Store[*types.BeaconBlockBody],
+	*types.BeaconBlock,
+	*types.BeaconBlockBody,
+	components.BeaconState,
+	components.DepositStore,
+]
+
+// newTestBackend returns a backend over a fresh multi store, along with the
+// multi store itself.
+func newTestBackend(t *testing.T) (*testBackend, *rootmulti.Store) {
+	t.Helper()
+	key := storetypes.NewKVStoreKey("beacon")
+	ms := rootmulti.NewStore(
+		dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics(),
+	)
+	// The fast node index is disabled as it is by the app config.
+	ms.SetIAVLDisableFastNode(true)
+	ms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	kv := beacondb.New[
+		*types.Fork,
+		*types.BeaconBlockHeader,
+		*types.ExecutionPayloadHeader,
+		*types.Eth1Data,
+		*types.Validator,
+	](
+		runtime.NewKVStoreService(key),
+		&encoding.SSZInterfaceCodec[*types.ExecutionPayloadHeader]{},
+	)
+	return storage.NewBackend[
+		*dastore.Store[*types.BeaconBlockBody],
+		*types.BeaconBlock,
+		*types.BeaconBlockBody,
+		components.BeaconState,
+		components.DepositStore,
+	](chain.NewChainSpec(spec.BaseSpec()), nil, kv, nil), ms
+}
+
+// commitSlot writes the given slot to the live state and commits it.
+func commitSlot(
+	t *testing.T,
+	backend *testBackend,
+	ms *rootmulti.Store,
+	slot math.Slot,
+) {
+	t.Helper()
+	cms := ms.CacheMultiStore()
+	ctx := sdk.NewContext(cms, false, log.NewNopLogger())
+	require.NoError(t, backend.StateFromContext(ctx).SetSlot(slot))
+	cms.Write()
+	ms.Commit()
+}
+
+func TestStateAtSlot(t *testing.T) {
+	backend, ms := newTestBackend(t)
+	backend.SetMultiStore(ms)
+	for slot := math.Slot(1); slot <= 4; slot++ {
+		commitSlot(t, backend, ms, slot)
+	}
+
+	st, err := backend.StateAtSlot(context.Background(), 2)
+	require.NoError(t, err)
+	slot, err := st.GetSlot()
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(2), slot)
+
+	// Mutating the historical state must not affect the live state.
+	require.NoError(t, st.SetSlot(100))
+	live := backend.StateFromContext(
+		sdk.NewContext(ms.CacheMultiStore(), false, log.NewNopLogger()),
+	)
+	slot, err = live.GetSlot()
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(4), slot)
+
+	st, err = backend.StateAtSlot(context.Background(), 2)
+	require.NoError(t, err)
+	slot, err = st.GetSlot()
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(2), slot)
+}
+
+func TestStateAtSlotOutOfRange(t *testing.T) {
+	backend, ms := newTestBackend(t)
+	_, err := backend.StateAtSlot(context.Background(), 1)
+	require.ErrorIs(t, err, storage.ErrHistoricalStateUnavailable)
+
+	backend.SetMultiStore(ms)
+	commitSlot(t, backend, ms, 1)
+	for _, slot := range []math.Slot{0, 2} {
+		_, err = backend.StateAtSlot(context.Background(), slot)
+		require.ErrorIs(t, err, storage.ErrSlotOutOfRange)
+	}
+}
+
+func TestAvailableStateSlots(t *testing.T) {
+	backend, ms := newTestBackend(t)
+	_, _, err := backend.AvailableStateSlots(context.Background())
+	require.ErrorIs(t, err, storage.ErrHistoricalStateUnavailable)
+
+	backend.SetMultiStore(ms)
+	_, _, err = backend.AvailableStateSlots(context.Background())
+	require.ErrorIs(t, err, storage.ErrSlotOutOfRange)
+
+	for slot := math.Slot(1); slot <= 5; slot++ {
+		commitSlot(t, backend, ms, slot)
+	}
+	lowest, highest, err := backend.AvailableStateSlots(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(1), lowest)
+	require.Equal(t, math.Slot(5), highest)
+}
+
+func TestAvailableStateSlotsPruned(t *testing.T) {
+	backend, ms := newTestBackend(t)
+	backend.SetMultiStore(ms)
+	ms.SetPruning(pruningtypes.NewCustomPruningOptions(3, 1))
+	for slot := math.Slot(1); slot <= 10; slot++ {
+		commitSlot(t, backend, ms, slot)
+	}
+
+	lowest, highest, err := backend.AvailableStateSlots(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(7), lowest)
+	require.Equal(t, math.Slot(10), highest)
+
+	// The state of each slot within the bounds is served, and none below.
+	for slot := lowest; slot <= highest; slot++ {
+		var st components.BeaconState
+		st, err = backend.StateAtSlot(context.Background(), slot)
+		require.NoError(t, err)
+		var got math.Slot
+		got, err = st.GetSlot()
+		require.NoError(t, err)
+		require.Equal(t, slot, got)
+	}
+	_, err = backend.StateAtSlot(context.Background(), lowest-1)
+	require.ErrorIs(t, err, storage.ErrSlotOutOfRange)
+}
+
+func TestStateRetention(t *testing.T) {
+	const (
+		retention = 3
+		latest    = 20
+	)
+	backend, ms := newTestBackend(t)
+	backend.SetMultiStore(ms)
+	ms.SetPruning(storage.PruningOptions(retention))
+	for slot := math.Slot(1); slot <= latest; slot++ {
+		commitSlot(t, backend, ms, slot)
+	}
+
+	// The states beyond the retention depth are pruned, while the latest
+	// state, which is the finalized one, survives.
+	_, err := ms.CacheMultiStoreWithVersion(latest - retention - 1)
+	require.Error(t, err)
+	for slot := math.Slot(1); slot < latest-retention; slot++ {
+		_, err = backend.StateAtSlot(context.Background(), slot)
+		require.ErrorIs(t, err, storage.ErrStateNotRetained)
+		require.ErrorIs(t, err, storage.ErrSlotOutOfRange)
+	}
+	for slot := math.Slot(latest - retention); slot <= latest; slot++ {
+		var st components.BeaconState
+		st, err = backend.StateAtSlot(context.Background(), slot)
+		require.NoError(t, err)
+		var got math.Slot
+		got, err = st.GetSlot()
+		require.NoError(t, err)
+		require.Equal(t, slot, got)
+	}
+
+	lowest, highest, err := backend.AvailableStateSlots(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(latest-retention), lowest)
+	require.Equal(t, math.Slot(latest), highest)
+}
+
+func TestStateRetentionKeepsEverything(t *testing.T) {
+	backend, ms := newTestBackend(t)
+	backend.SetMultiStore(ms)
+	ms.SetPruning(storage.PruningOptions(0))
+	for slot := math.Slot(1); slot <= 20; slot++ {
+		commitSlot(t, backend, ms, slot)
+	}
+
+	lowest, highest, err := backend.AvailableStateSlots(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(1), lowest)
+	require.Equal(t, math.Slot(20), highest)
+}
+
+func TestStateCopy(t *testing.T) {
+	backend, ms := newTestBackend(t)
+	commitSlot(t, backend, ms, 1)
+	ctx := sdk.NewContext(ms.CacheMultiStore(), false, log.NewNopLogger())
+	st := backend.StateFromContext(ctx)
+
+	// Mutating the copy must not affect the original state.
+	cp := st.Copy()
+	require.NoError(t, cp.SetSlot(5))
+	slot, err := cp.GetSlot()
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(5), slot)
+	for _, s := range []components.BeaconState{
+		st, backend.StateFromContext(ctx),
+	} {
+		slot, err = s.GetSlot()
+		require.NoError(t, err)
+		require.Equal(t, math.Slot(1), slot)
+	}
+
+	// States read from the same context share their store, whereas saving
+	// the copy applies its changes to the original.
+	require.NoError(t, backend.StateFromContext(ctx).SetSlot(2))
+	slot, err = st.GetSlot()
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(2), slot)
+
+	cp.Save()
+	slot, err = st.GetSlot()
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(5), slot)
+}
+
+// newTestState returns the SSZ encoding of a beacon state with the given
+// number of validators.
+func newTestState(t *testing.T, numValidators int) []byte {
+	t.Helper()
+	cs := chain.NewChainSpec(spec.BaseSpec())
+	st := &deneb.BeaconState{
+		Slot:        7,
+		Fork:        &types.Fork{},
+		BlockRoots:  make([]primitives.Root, cs.SlotsPerHistoricalRoot()),
+		StateRoots:  make([]primitives.Root, cs.SlotsPerHistoricalRoot()),
+		RandaoMixes: make([]primitives.Bytes32, cs.EpochsPerHistoricalVector()),
+		LatestExecutionPayloadHeader: &types.ExecutionPayloadHeaderDeneb{
+			LogsBloom: make([]byte, 256),
+			ExtraData: []byte("extra"),
+		},
+		NextWithdrawalIndex: 3,
+		TotalSlashing:       9,
+	}
+	st.BlockRoots[1][0] = 1
+	st.StateRoots[2][0] = 2
+	st.RandaoMixes[3][0] = 3
+	for i := range numValidators {
+		val := &types.Validator{
+			EffectiveBalance: math.Gwei(i),
+			ExitEpoch:        math.Epoch(i),
+		}
+		binary.BigEndian.PutUint64(val.Pubkey[:], uint64(i))
+		st.Validators = append(st.Validators, val)
+		st.Balances = append(st.Balances, uint64(2*i))
+	}
+	st.Slashings = []uint64{4, 0, 5}
+
+	bz, err := st.MarshalSSZ()
+	require.NoError(t, err)
+	return bz
+}
+
+func TestStateStreamingSSZ(t *testing.T) {
+	bz := newTestState(t, 5_000)
+	backend, ms := newTestBackend(t)
+	ctx := sdk.NewContext(ms.CacheMultiStore(), false, log.NewNopLogger())
+	st := backend.StateFromContext(ctx)
+	require.NoError(t, st.UnmarshalSSZFrom(bytes.NewReader(bz)))
+
+	// Streaming the state must produce the same bytes as encoding it whole.
+	var buf bytes.Buffer
+	require.NoError(t, st.MarshalSSZTo(&buf))
+	require.Equal(t, bz, buf.Bytes())
+	encoded, err := st.MarshalSSZ()
+	require.NoError(t, err)
+	require.Equal(t, bz, encoded)
+
+	total, err := st.GetTotalValidators()
+	require.NoError(t, err)
+	require.Equal(t, uint64(5_000), total)
+	balance, err := st.GetBalance(42)
+	require.NoError(t, err)
+	require.Equal(t, math.Gwei(84), balance)
+
+	// A state can only be decoded into a state without validators.
+	require.ErrorIs(
+		t,
+		st.UnmarshalSSZFrom(bytes.NewReader(bz)),
+		statedb.ErrStateNotEmpty,
+	)
+}
+
+// commitValidator adds a validator with the given balance to the live state,
+// sets its slot and commits it.
+func commitValidator(
+	backend *testBackend,
+	ms *rootmulti.Store,
+	slot math.Slot,
+	balance math.Gwei,
+) error {
+	cms := ms.CacheMultiStore()
+	st := backend.StateFromContext(
+		sdk.NewContext(cms, false, log.NewNopLogger()),
+	)
+	if err := st.SetSlot(slot); err != nil {
+		return err
+	}
+	val := &types.Validator{EffectiveBalance: balance}
+	binary.BigEndian.PutUint64(val.Pubkey[:], slot.Unwrap())
+	if err := st.AddValidator(val); err != nil {
+		return err
+	}
+	cms.Write()
+	ms.Commit()
+	return nil
+}
+
+func TestStateView(t *testing.T) {
+	backend, ms := newTestBackend(t)
+	_, err := backend.StateView(context.Background())
+	require.ErrorIs(t, err, storage.ErrHistoricalStateUnavailable)
+
+	backend.SetMultiStore(ms)
+	_, err = backend.StateView(context.Background())
+	require.ErrorIs(t, err, storage.ErrSlotOutOfRange)
+
+	require.NoError(t, commitValidator(backend, ms, 1, 32))
+	view, err := backend.StateView(context.Background())
+	require.NoError(t, err)
+
+	// The live state advances while the view is being read.
+	const lastSlot = 50
+	errCh := make(chan error, 1)
+	go func() {
+		for slot := math.Slot(2); slot <= lastSlot; slot++ {
+			if cErr := commitValidator(backend, ms, slot, 64); cErr != nil {
+				errCh <- cErr
+				return
+			}
+		}
+		errCh <- nil
+	}()
+
+	for done := false; !done; {
+		select {
+		case err = <-errCh:
+			require.NoError(t, err)
+			done = true
+		default:
+		}
+
+		slot, sErr := view.GetSlot()
+		require.NoError(t, sErr)
+		require.Equal(t, math.Slot(1), slot)
+		vals, vErr := view.GetValidators()
+		require.NoError(t, vErr)
+		require.Len(t, vals, 1)
+		balances, bErr := view.GetBalances()
+		require.NoError(t, bErr)
+		require.Equal(t, []uint64{32}, balances)
+	}
+
+	// A new view observes the state the live state advanced to.
+	view, err = backend.StateView(context.Background())
+	require.NoError(t, err)
+	slot, err := view.GetSlot()
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(lastSlot), slot)
+	total, err := view.GetTotalValidators()
+	require.NoError(t, err)
+	require.Equal(t, uint64(lastSlot), total)
+}
+
+func TestBalanceOf(t *testing.T) {
+	backend, ms := newTestBackend(t)
+	_, err := backend.BalanceOf(context.Background(), 0)
+	require.ErrorIs(t, err, storage.ErrHistoricalStateUnavailable)
+
+	backend.SetMultiStore(ms)
+	require.NoError(t, commitValidator(backend, ms, 1, 32))
+	require.NoError(t, commitValidator(backend, ms, 2, 64))
+
+	balance, err := backend.BalanceOf(context.Background(), 1)
+	require.NoError(t, err)
+	require.Equal(t, math.Gwei(64), balance)
+
+	_, err = backend.BalanceOf(context.Background(), 2)
+	require.ErrorIs(t, err, storage.ErrValidatorNotFound)
+}
+
+func TestBlockByRootAndSlot(t *testing.T) {
+	backend, _ := newTestBackend(t)
+	cs := chain.NewChainSpec(spec.BaseSpec())
+	bs := blockstore.NewStore[*types.BeaconBlock](
+		&blockstore.KVStoreProvider{KVStoreWithBatch: storev2.NewMemDB()}, cs,
+	)
+	backend.SetBlockStore(bs)
+
+	blk, err := (&types.BeaconBlock{}).NewWithVersion(
+		3, 1, common.Root{0x01}, version.Deneb,
+	)
+	require.NoError(t, err)
+	require.NoError(t, blk.GetBody().SetExecutionData(&types.ExecutionPayload{
+		InnerExecutionPayload: &types.ExecutableDataDeneb{
+			LogsBloom: make([]byte, types.LogsBloomSize),
+		},
+	}))
+	require.NoError(t, bs.Set(blk))
+	root, err := blk.HashTreeRoot()
+	require.NoError(t, err)
+
+	got, err := backend.BlockBySlot(3)
+	require.NoError(t, err)
+	gotRoot, err := got.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, root, gotRoot)
+
+	got, err = backend.BlockByRoot(root)
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(3), got.GetSlot())
+
+	_, err = backend.BlockBySlot(4)
+	require.ErrorIs(t, err, storage.ErrBlockNotFound)
+	_, err = backend.BlockByRoot(common.Root{0x02})
+	require.ErrorIs(t, err, storage.ErrBlockNotFound)
+}
+
+func TestBlockStoreUnavailable(t *testing.T) {
+	backend, _ := newTestBackend(t)
+	_, err := backend.BlockBySlot(1)
+	require.ErrorIs(t, err, storage.ErrBlockStoreUnavailable)
+	_, err = backend.BlockByRoot(common.Root{})
+	require.ErrorIs(t, err, storage.ErrBlockStoreUnavailable)
+}
@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import "os"
+
+// StoreDirs are the directories the stores of the node are kept in. When
+// supplied, each directory that is set overrides the default location of its
+// store under the home directory of the node.
+type StoreDirs struct {
+	// AvailabilityStore is the directory of the availability store, which
+	// defaults to data/blobs under the home directory.
+	AvailabilityStore string
+	// DepositStore is the directory of the deposit store and of the block
+	// store, which defaults to data under the home directory.
+	DepositStore string
+}
+
+// availabilityStoreDir returns the directory of the availability store of a
+// node with the given home directory.
+func (d *StoreDirs) availabilityStoreDir(home string) string {
+	if d == nil || d.AvailabilityStore == "" {
+		return home + "/data/blobs"
+	}
+	return d.AvailabilityStore
+}
+
+// depositStoreDir returns the directory of the deposit store of a node with
+// the given home directory.
+func (d *StoreDirs) depositStoreDir(home string) string {
+	if d == nil || d.DepositStore == "" {
+		return home + "/data"
+	}
+	return d.DepositStore
+}
+
+// blockStoreDir returns the directory of the block store of a node with the
+// given home directory. The blocks are kept alongside the deposits.
+func (d *StoreDirs) blockStoreDir(home string) string {
+	return d.depositStoreDir(home)
+}
+
+// ValidateWritableDir returns an error if the given directory cannot be
+// written to. The directory is created if it does not exist yet.
+func ValidateWritableDir(dir string) error {
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(dir, ".write-check-*")
+	if err != nil {
+		return err
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+	return os.Remove(f.Name())
+}
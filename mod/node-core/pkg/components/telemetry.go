@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"cosmossdk.io/depinject"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
+)
+
+// TelemetryInput is the input for the ProvideTelemetry function.
+type TelemetryInput struct {
+	depinject.In
+	Config               *metrics.TelemetryConfig `optional:"true"`
+	PrometheusListenAddr *PrometheusListenAddr    `optional:"true"`
+}
+
+// PrometheusListenAddr is the address CometBFT serves its Prometheus metrics
+// on. When supplied, the metrics of the node are exposed on it as well.
+type PrometheusListenAddr string
+
+// ProvideTelemetry is a function that provides the telemetry the services of
+// the node emit metrics through. If a telemetry config is supplied, metrics
+// are pushed to the sink it describes, otherwise they are emitted through the
+// global telemetry of the SDK, which is a no-op unless enabled in the app.toml.
+// If a Prometheus listen address is supplied, metrics are also exposed on the
+// default Prometheus registry served on it.
+func ProvideTelemetry(in TelemetryInput) (metrics.Telemetry, error) {
+	if in.PrometheusListenAddr != nil {
+		return metrics.NewPrometheusTelemetrySink(in.Config)
+	}
+	if in.Config == nil {
+		return metrics.NewTelemetrySink(), nil
+	}
+	return metrics.NewTelemetrySinkFromConfig(*in.Config)
+}
@@ -21,6 +21,8 @@
 package components
 
 import (
+	"context"
+
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/log"
 	"github.com/berachain/beacon-kit/mod/beacon/validator"
@@ -28,14 +30,18 @@ import (
 	dablob "github.com/berachain/beacon-kit/mod/da/pkg/blob"
 	dastore "github.com/berachain/beacon-kit/mod/da/pkg/store"
 	datypes "github.com/berachain/beacon-kit/mod/da/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/config"
 	payloadbuilder "github.com/berachain/beacon-kit/mod/payload/pkg/builder"
 	"github.com/berachain/beacon-kit/mod/primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
-	depositdb "github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
+// ErrReadOnly is returned when a read-only node is asked to propose a block.
+var ErrReadOnly = errors.New("read-only node cannot propose blocks")
+
 // ValidatorServiceInput is the input for the validator service provider.
 type ValidatorServiceInput struct {
 	depinject.In
@@ -52,7 +58,7 @@ type ValidatorServiceInput struct {
 	StateProcessor StateProcessor
 	StorageBackend StorageBackend
 	Signer         crypto.BLSSigner
-	TelemetrySink  *metrics.TelemetrySink
+	TelemetrySink  metrics.Telemetry
 }
 
 // ProvideValidatorService is a depinject provider for the validator service.
@@ -63,7 +69,7 @@ func ProvideValidatorService(
 	*types.BeaconBlockBody,
 	BeaconState,
 	*datypes.BlobSidecars,
-	*depositdb.KVStore[*types.Deposit],
+	DepositStore,
 	*types.ForkData,
 ] {
 	// Build the builder service.
@@ -72,7 +78,7 @@ func ProvideValidatorService(
 		*types.BeaconBlockBody,
 		BeaconState,
 		*datypes.BlobSidecars,
-		*depositdb.KVStore[*types.Deposit],
+		DepositStore,
 		*types.ForkData,
 	](
 		&in.Cfg.Validator,
@@ -97,3 +103,14 @@ func ProvideValidatorService(
 		in.TelemetrySink,
 	)
 }
+
+// readOnlyValidatorService is the validator service of a read-only node,
+// which refuses to build blocks.
+type readOnlyValidatorService struct{}
+
+// RequestBlockForProposal always returns ErrReadOnly.
+func (readOnlyValidatorService) RequestBlockForProposal(
+	context.Context, math.Slot,
+) (*types.BeaconBlock, *datypes.BlobSidecars, error) {
+	return nil, nil, ErrReadOnly
+}
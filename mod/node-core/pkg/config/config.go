@@ -108,6 +108,11 @@ func ReadConfigFromAppOpts(opts servertypes.AppOptions) (*Config, error) {
 // AddBeaconKitFlags implements servertypes.ModuleInitFlags interface.
 func AddBeaconKitFlags(startCmd *cobra.Command) {
 	defaultCfg := DefaultConfig()
+	startCmd.Flags().Bool(
+		flags.DryRun,
+		false,
+		"resolve all components of the node and exit without starting it",
+	)
 	startCmd.Flags().String(
 		flags.JWTSecretPath,
 		defaultCfg.Engine.JWTSecretPath,
@@ -21,6 +21,14 @@
 package flags
 
 const (
+	// DryRun resolves all components of the node and exits without starting
+	// it.
+	DryRun = "dry-run"
+
+	// ChainSpec is the name of the chain spec of the network the node
+	// targets.
+	ChainSpec = "chain-spec"
+
 	// Beacon Kit Root Flag.
 	beaconKitRoot      = "beacon-kit."
 	BeaconKitAcceptTos = beaconKitRoot + "accept-tos"
@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package spec
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives"
+)
+
+const (
+	// Devnet is the name of the chain spec of the devnet.
+	Devnet = "devnet"
+	// Testnet is the name of the chain spec of the testnet.
+	Testnet = "testnet"
+	// Mainnet is the name of the chain spec of the mainnet, which is not
+	// defined yet.
+	Mainnet = "mainnet"
+)
+
+var (
+	// ErrUnknownChainSpec is returned when no chain spec is known by a given
+	// name.
+	ErrUnknownChainSpec = errors.New("unknown chain spec")
+
+	// ErrChainSpecUnavailable is returned when the chain spec of a known
+	// network is not defined yet.
+	ErrChainSpecUnavailable = errors.New("chain spec not available")
+)
+
+// ChainSpecs returns the known chain specs by name.
+func ChainSpecs() map[string]primitives.ChainSpec {
+	return map[string]primitives.ChainSpec{
+		Devnet:  DevnetChainSpec(),
+		Testnet: TestnetChainSpec(),
+	}
+}
+
+// Names returns the sorted names of the known chain specs.
+func Names() []string {
+	specs := ChainSpecs()
+	names := make([]string, 0, len(specs))
+	for name := range specs {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// NameOf returns the name of the known chain spec that is equal to the given
+// chain spec, and false if there is none.
+func NameOf(cs primitives.ChainSpec) (string, bool) {
+	specs := ChainSpecs()
+	for _, name := range Names() {
+		if reflect.DeepEqual(specs[name], cs) {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// ChainSpecByName returns the known chain spec with the given name. It fails
+// with ErrChainSpecUnavailable for the mainnet, whose chain spec is not
+// defined yet, and with ErrUnknownChainSpec, listing the known names, if
+// there is none.
+func ChainSpecByName(name string) (primitives.ChainSpec, error) {
+	cs, ok := ChainSpecs()[name]
+	if !ok && name == Mainnet {
+		return nil, errors.Wrapf(
+			ErrChainSpecUnavailable, "%q is not defined yet, use one of %s",
+			name, strings.Join(Names(), ", "),
+		)
+	}
+	if !ok {
+		return nil, errors.Wrapf(
+			ErrUnknownChainSpec, "%q, expected one of %s",
+			name, strings.Join(Names(), ", "),
+		)
+	}
+	return cs, nil
+}
@@ -73,4 +73,8 @@ graffiti = "{{.BeaconKit.Validator.Graffiti}}"
 # EnableOptimisticPayloadBuilds enables building the next block's payload optimistically in
 # process-proposal to allow for the execution client to have more time to assemble the block.
 enable-optimistic-payload-builds = "{{.BeaconKit.Validator.EnableOptimisticPayloadBuilds}}"
+
+# The time the proposer waits for the execution payload of its block, after
+# which an empty block is proposed instead. It is not bounded if set to zero.
+proposer-timeout = "{{ .BeaconKit.Validator.ProposerTimeout }}"
 `
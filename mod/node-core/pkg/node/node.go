@@ -21,14 +21,29 @@
 package node
 
 import (
+	"context"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/app"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/types"
+	cmtcli "github.com/cometbft/cometbft/libs/cli"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	svrcmd "github.com/cosmos/cosmos-sdk/server/cmd"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/rs/zerolog"
 	"github.com/spf13/cobra"
 )
 
+// DefaultShutdownTimeout is the default time the node waits for its closers
+// to finish once it shuts down.
+const DefaultShutdownTimeout = 30 * time.Second
+
 // Node represents the node application.
 type Node struct {
 	*app.BeaconApp
@@ -39,18 +54,223 @@ type Node struct {
 
 	// rootCmd is the root command for the application.
 	rootCmd *cobra.Command
+	// started is set once the root command has been executed.
+	started atomic.Bool
+	// rootCtx is the parent context of the root command when the node is
+	// run, it defaults to context.Background if unset.
+	rootCtx context.Context
+
+	// closers are invoked in LIFO order when the node shuts down.
+	closers   []closer
+	closersMu sync.Mutex
+	// shutdownTimeout bounds the time the closers are waited for, they are
+	// waited for indefinitely if it is not positive.
+	shutdownTimeout time.Duration
+
+	// info is the metadata of the node.
+	info   types.NodeInfo
+	infoMu sync.RWMutex
+
+	// healthReporter reports the health of the subsystems of the node.
+	healthReporter types.HealthReporter
+}
+
+// closer is a named function invoked when the node shuts down.
+type closer struct {
+	name string
+	fn   func() error
 }
 
 // New returns a new Node.
 func New[NodeT types.NodeI]() NodeT {
-	return types.NodeI(&Node{}).(NodeT)
+	return types.NodeI(&Node{
+		shutdownTimeout: DefaultShutdownTimeout,
+	}).(NodeT)
 }
 
-// Run runs the node's server application.
+// Run runs the node's root command with the arguments of the process. Once
+// the root command returns, the registered closers are invoked. If a root
+// context is set, cancelling it shuts the node down gracefully, otherwise the
+// node shuts down on OS signals only.
 func (n *Node) Run() error {
-	return svrcmd.Execute(
+	if n.rootCtx == nil {
+		return n.execute(context.Background(), nil)
+	}
+	return n.run(n.rootCtx, nil)
+}
+
+// Start starts the node's server bound to the given context, without parsing
+// the arguments of the process. It blocks until the context is cancelled or
+// the server fails, after which the registered closers are invoked. A node
+// can only be run or started once.
+func (n *Node) Start(ctx context.Context) error {
+	return n.run(ctx, []string{"start"})
+}
+
+// run executes the root command bound to the given context, treating the
+// cancellation of the context as a graceful shutdown rather than a failure.
+func (n *Node) run(ctx context.Context, args []string) error {
+	err := n.execute(ctx, args)
+	if ctx.Err() != nil && errors.Is(err, ctx.Err()) {
+		return nil
+	}
+	return err
+}
+
+// execute executes the root command with the given context and arguments,
+// or with the arguments of the process if args is nil. It mirrors
+// svrcmd.Execute, which does not accept a context.
+func (n *Node) execute(ctx context.Context, args []string) error {
+	if !n.started.CompareAndSwap(false, true) {
+		return ErrAlreadyStarted
+	}
+	if args != nil {
+		n.rootCmd.SetArgs(args)
+	}
+
+	n.rootCmd.PersistentFlags().String(
+		flags.FlagLogLevel, zerolog.InfoLevel.String(),
+		"The logging level (trace|debug|info|warn|error|fatal|panic|disabled "+
+			"or '*:<level>,<key>:<level>')",
+	)
+	n.rootCmd.PersistentFlags().String(
+		flags.FlagLogFormat, "plain", "The logging format (json|plain)",
+	)
+	n.rootCmd.PersistentFlags().Bool(
+		flags.FlagLogNoColor, false, "Disable colored logs",
+	)
+	executor := cmtcli.PrepareBaseCmd(
 		n.rootCmd, "", components.DefaultNodeHome,
 	)
+	return errors.Join(
+		executor.ExecuteContext(svrcmd.CreateExecuteContext(ctx)),
+		n.runClosers(),
+	)
+}
+
+// RegisterCloser registers a function to be called when the node shuts
+// down. Closers are invoked in the reverse order of their registration, and
+// are referred to by their name if they fail to finish in time.
+func (n *Node) RegisterCloser(name string, fn func() error) {
+	n.closersMu.Lock()
+	defer n.closersMu.Unlock()
+	n.closers = append(n.closers, closer{name: name, fn: fn})
+}
+
+// SetShutdownTimeout sets the time the node waits for its closers to finish
+// once it shuts down. The closers are waited for indefinitely if the timeout
+// is not positive.
+func (n *Node) SetShutdownTimeout(timeout time.Duration) {
+	n.shutdownTimeout = timeout
+}
+
+// ShutdownTimeout returns the time the node waits for its closers to finish
+// once it shuts down.
+func (n *Node) ShutdownTimeout() time.Duration {
+	return n.shutdownTimeout
+}
+
+// NodeInfo returns the metadata of the node, such as its name, build version
+// and chain id. It is not named Info, as that is the ABCI Info method of the
+// application.
+func (n *Node) NodeInfo() types.NodeInfo {
+	n.infoMu.RLock()
+	defer n.infoMu.RUnlock()
+	return n.info
+}
+
+// SetNodeInfo sets the metadata of the node.
+func (n *Node) SetNodeInfo(info types.NodeInfo) {
+	n.infoMu.Lock()
+	defer n.infoMu.Unlock()
+	n.info = info
+}
+
+// HealthSummary returns the health of each of the subsystems of the node,
+// along with its overall health, which is the worst of them. It fails with
+// ErrHealthUnavailable if the application of the node has not been created.
+func (n *Node) HealthSummary(ctx context.Context) (types.HealthReport, error) {
+	if n.healthReporter == nil {
+		return types.HealthReport{}, ErrHealthUnavailable
+	}
+	return n.healthReporter.Report(ctx)
+}
+
+// SetHealthReporter sets the reporter of the health of the node.
+func (n *Node) SetHealthReporter(reporter types.HealthReporter) {
+	n.healthReporter = reporter
+}
+
+// SetRootContext sets the parent context of the root command when the node
+// is run.
+func (n *Node) SetRootContext(ctx context.Context) {
+	n.rootCtx = ctx
+}
+
+// RootContext returns the parent context of the root command when the node
+// is run, or nil if it is unset.
+func (n *Node) RootContext() context.Context {
+	return n.rootCtx
+}
+
+// runClosers invokes the registered closers in LIFO order and returns the
+// joined errors of all closers that failed. If the closers do not finish
+// within the shutdown timeout, the pending closers are logged and
+// ErrShutdownTimeout is returned.
+func (n *Node) runClosers() error {
+	n.closersMu.Lock()
+	closers := n.closers
+	n.closers = nil
+	n.closersMu.Unlock()
+
+	var (
+		finished atomic.Int64
+		done     = make(chan error, 1)
+	)
+	go func() {
+		var errs []error
+		for i := len(closers) - 1; i >= 0; i-- {
+			if err := closers[i].fn(); err != nil {
+				errs = append(errs, err)
+			}
+			finished.Add(1)
+		}
+		done <- errors.Join(errs...)
+	}()
+
+	if n.shutdownTimeout <= 0 {
+		return <-done
+	}
+	timer := time.NewTimer(n.shutdownTimeout)
+	defer timer.Stop()
+	select {
+	case err := <-done:
+		return err
+	case <-timer.C:
+	}
+
+	// The closers that have not finished are the ones registered first.
+	pending := make([]string, 0, len(closers))
+	for i := len(closers) - 1 - int(finished.Load()); i >= 0; i-- {
+		pending = append(pending, closers[i].name)
+	}
+	n.logger().Error(
+		"timed out waiting for closers to finish",
+		"timeout", n.shutdownTimeout,
+		"pending", pending,
+	)
+	return errors.Wrapf(
+		ErrShutdownTimeout, "pending closers: %s", strings.Join(pending, ", "),
+	)
+}
+
+// logger returns the logger of the application, or a no-op logger if the
+// application has not been set.
+func (n *Node) logger() log.Logger {
+	if n.BeaconApp == nil {
+		return log.NewNopLogger()
+	}
+	return n.Logger()
 }
 
 // SetAppName sets the name of the application.
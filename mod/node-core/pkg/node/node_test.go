@@ -0,0 +1,160 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package node //nolint:testpackage // exercises the unexported closers.
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/types"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+// newBlockingNode returns a node whose start command blocks until its
+// context is cancelled. started is closed once the command is running.
+func newBlockingNode() (*Node, chan struct{}) {
+	started := make(chan struct{})
+	rootCmd := &cobra.Command{Use: "test"}
+	rootCmd.AddCommand(&cobra.Command{
+		Use: "start",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			close(started)
+			<-cmd.Context().Done()
+			return cmd.Context().Err()
+		},
+	})
+	n := &Node{}
+	n.SetRootCmd(rootCmd)
+	return n, started
+}
+
+func TestRunClosersLIFO(t *testing.T) {
+	n := &Node{}
+
+	var order []int
+	n.RegisterCloser("first", func() error {
+		order = append(order, 1)
+		return nil
+	})
+	n.RegisterCloser("second", func() error {
+		order = append(order, 2)
+		return errors.New("closer failed")
+	})
+
+	require.Error(t, n.runClosers())
+	require.Equal(t, []int{2, 1}, order)
+
+	// Closers are only invoked once.
+	require.NoError(t, n.runClosers())
+	require.Equal(t, []int{2, 1}, order)
+}
+
+func TestRunClosersTimeout(t *testing.T) {
+	n := &Node{}
+	n.SetShutdownTimeout(50 * time.Millisecond)
+
+	release := make(chan struct{})
+	defer close(release)
+	var closed []string
+	n.RegisterCloser("slow", func() error {
+		<-release
+		return nil
+	})
+	n.RegisterCloser("fast", func() error {
+		closed = append(closed, "fast")
+		return nil
+	})
+
+	start := time.Now()
+	err := n.runClosers()
+	require.ErrorIs(t, err, ErrShutdownTimeout)
+	require.ErrorContains(t, err, "pending closers: slow")
+	require.Less(t, time.Since(start), 5*time.Second)
+	require.Equal(t, []string{"fast"}, closed)
+}
+
+func TestStartReturnsOnCancel(t *testing.T) {
+	n, started := newBlockingNode()
+	closed := false
+	n.RegisterCloser("closer", func() error {
+		closed = true
+		return nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errCh := make(chan error, 1)
+	go func() { errCh <- n.Start(ctx) }()
+
+	<-started
+	require.ErrorIs(t, n.Start(ctx), ErrAlreadyStarted)
+	require.ErrorIs(t, n.Run(), ErrAlreadyStarted)
+
+	cancel()
+	require.NoError(t, <-errCh)
+	require.True(t, closed)
+}
+
+func TestRunReturnsOnRootContextCancel(t *testing.T) {
+	n, started := newBlockingNode()
+	n.rootCmd.SetArgs([]string{"start"})
+	closed := false
+	n.RegisterCloser("closer", func() error {
+		closed = true
+		return nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	n.SetRootContext(ctx)
+	errCh := make(chan error, 1)
+	go func() { errCh <- n.Run() }()
+
+	<-started
+	cancel()
+	require.NoError(t, <-errCh)
+	require.True(t, closed)
+}
+
+// fakeHealthReporter reports a fixed health report.
+type fakeHealthReporter types.HealthReport
+
+func (f fakeHealthReporter) Report(
+	context.Context,
+) (types.HealthReport, error) {
+	return types.HealthReport(f), nil
+}
+
+func TestHealthSummary(t *testing.T) {
+	n := &Node{}
+	_, err := n.HealthSummary(context.Background())
+	require.ErrorIs(t, err, ErrHealthUnavailable)
+
+	want := types.HealthReport{
+		Status: types.HealthStatusDegraded,
+		Peers:  types.HealthStatusDegraded,
+	}
+	n.SetHealthReporter(fakeHealthReporter(want))
+	report, err := n.HealthSummary(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, want, report)
+}
@@ -0,0 +1,166 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/types"
+)
+
+const (
+	// socketFileMode is the mode of the admin socket, which is only
+	// accessible to the user running the node.
+	socketFileMode = 0o600
+	// readHeaderTimeout is the timeout for reading the request headers.
+	readHeaderTimeout = 5 * time.Second
+	// shutdownTimeout is the timeout for gracefully shutting down the server.
+	shutdownTimeout = 5 * time.Second
+)
+
+// Config is the configuration of the admin server.
+type Config struct {
+	// SocketPath is the path of the unix socket the server listens on.
+	SocketPath string
+}
+
+// Server is a service that serves the maintenance controls of the node over
+// HTTP on a unix socket. Block processing is paused by a POST to /pause and
+// resumed by a POST to /resume, each responding with the resulting status of
+// block processing, which is also served at /status.
+type Server struct {
+	// logger is used to log information about the server.
+	logger log.Logger[any]
+	// socketPath is the path of the unix socket the server listens on.
+	socketPath string
+	// pauser pauses and resumes the processing of blocks.
+	pauser Pauser
+	// srv is the underlying HTTP server, set once the service is started.
+	srv *http.Server
+}
+
+// NewServer creates a new admin server.
+func NewServer(
+	logger log.Logger[any],
+	socketPath string,
+	pauser Pauser,
+) *Server {
+	return &Server{
+		logger:     logger,
+		socketPath: socketPath,
+		pauser:     pauser,
+	}
+}
+
+// Name returns the name of the service.
+func (*Server) Name() string {
+	return "admin"
+}
+
+// Start starts serving the controls, the server is shut down once the given
+// context is cancelled. A socket left behind by a previous run of the node is
+// replaced.
+func (s *Server) Start(ctx context.Context) error {
+	if err := os.Remove(s.socketPath); err != nil &&
+		!errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	listener, err := net.Listen("unix", s.socketPath)
+	if err != nil {
+		return err
+	}
+	if err = os.Chmod(s.socketPath, socketFileMode); err != nil {
+		return errors.Join(err, listener.Close())
+	}
+
+	s.srv = &http.Server{
+		Handler:           s.Handler(),
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+	go func() {
+		if serveErr := s.srv.Serve(listener); serveErr != nil &&
+			!errors.Is(serveErr, http.ErrServerClosed) {
+			s.logger.Error("admin server failed", "error", serveErr)
+		}
+	}()
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(
+			context.WithoutCancel(ctx), shutdownTimeout,
+		)
+		defer cancel()
+		if shutdownErr := s.srv.Shutdown(shutdownCtx); shutdownErr != nil {
+			s.logger.Error(
+				"failed to shut down admin server", "error", shutdownErr,
+			)
+		}
+	}()
+
+	s.logger.Info("admin server started", "socket", s.socketPath)
+	return nil
+}
+
+// Status returns nil if the service is healthy.
+func (*Server) Status() error {
+	return nil
+}
+
+// Handler returns the HTTP handler serving the controls.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /pause", func(w http.ResponseWriter, _ *http.Request) {
+		s.pauser.Pause()
+		s.logger.Info("paused block processing")
+		s.writeStatus(w)
+	})
+	mux.HandleFunc(
+		"POST /resume", func(w http.ResponseWriter, _ *http.Request) {
+			s.logger.Info(
+				"resuming block processing",
+				"waiting", s.pauser.Waiting(),
+			)
+			s.pauser.Resume()
+			s.writeStatus(w)
+		},
+	)
+	mux.HandleFunc("GET /status", func(w http.ResponseWriter, _ *http.Request) {
+		s.writeStatus(w)
+	})
+	return mux
+}
+
+// writeStatus writes the status of the processing of blocks as JSON.
+func (s *Server) writeStatus(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(types.PauseStatus{
+		Paused:  s.pauser.Paused(),
+		Waiting: s.pauser.Waiting(),
+	}); err != nil {
+		s.logger.Error("failed to write pause status", "error", err)
+	}
+}
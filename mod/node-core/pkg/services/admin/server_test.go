@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package admin_test
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/services/admin"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/types"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/runtime/middleware"
+	"github.com/stretchr/testify/require"
+)
+
+// do performs a request with the given method against the given path of the
+// server listening on the given socket, and returns the status code and the
+// pause status of the response.
+func do(
+	t *testing.T, socketPath, method, path string,
+) (int, types.PauseStatus) {
+	t.Helper()
+	client := &http.Client{Transport: &http.Transport{
+		DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+			var d net.Dialer
+			return d.DialContext(ctx, "unix", socketPath)
+		},
+	}}
+	req, err := http.NewRequestWithContext(
+		context.Background(), method, "http://admin"+path, http.NoBody,
+	)
+	require.NoError(t, err)
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	var status types.PauseStatus
+	if resp.StatusCode == http.StatusOK {
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&status))
+	}
+	return resp.StatusCode, status
+}
+
+func TestServer(t *testing.T) {
+	socketPath := filepath.Join(t.TempDir(), "admin.sock")
+	// A socket left behind by a previous run is replaced.
+	require.NoError(t, os.WriteFile(socketPath, nil, 0o600))
+
+	pauser := middleware.NewPauser(middleware.DefaultPauseBufferSize)
+	srv := admin.NewServer(noop.NewLogger(), socketPath, pauser)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, srv.Start(ctx))
+
+	info, err := os.Stat(socketPath)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	code, status := do(t, socketPath, http.MethodGet, "/status")
+	require.Equal(t, http.StatusOK, code)
+	require.False(t, status.Paused)
+
+	code, status = do(t, socketPath, http.MethodPost, "/pause")
+	require.Equal(t, http.StatusOK, code)
+	require.True(t, status.Paused)
+	require.True(t, pauser.Paused())
+
+	code, status = do(t, socketPath, http.MethodPost, "/resume")
+	require.Equal(t, http.StatusOK, code)
+	require.False(t, status.Paused)
+	require.False(t, pauser.Paused())
+
+	// The controls are only changed by POST requests.
+	code, _ = do(t, socketPath, http.MethodGet, "/pause")
+	require.Equal(t, http.StatusMethodNotAllowed, code)
+	require.False(t, pauser.Paused())
+}
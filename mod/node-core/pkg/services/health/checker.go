@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package health
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// Checker checks the health of the subsystems of the node. The node is
+// unhealthy if it lags behind the head of the chain, if its availability
+// store is not initialized or if its deposit database cannot be read, and
+// degraded if it has no peers or they cannot be counted.
+type Checker struct {
+	// maxSyncLag is the number of slots the node may lag behind the head
+	// of the chain while still being considered synced.
+	maxSyncLag math.Slot
+	// sync reports the sync status of the node.
+	sync SyncReporter
+	// availabilityStore is the data availability store of the node.
+	availabilityStore AvailabilityStore
+	// depositStore is the deposit database of the node.
+	depositStore DepositStore
+	// peers counts the peers of the node.
+	peers PeerCounter
+}
+
+// NewChecker creates a new health checker.
+func NewChecker(
+	maxSyncLag math.Slot,
+	sync SyncReporter,
+	availabilityStore AvailabilityStore,
+	depositStore DepositStore,
+	peers PeerCounter,
+) *Checker {
+	return &Checker{
+		maxSyncLag:        maxSyncLag,
+		sync:              sync,
+		availabilityStore: availabilityStore,
+		depositStore:      depositStore,
+		peers:             peers,
+	}
+}
+
+// Report returns the health of the node and of each of its subsystems. The
+// failures of the subsystems are reported through their statuses, an error is
+// only returned if the context is done.
+func (c *Checker) Report(ctx context.Context) (types.HealthReport, error) {
+	if err := ctx.Err(); err != nil {
+		return types.HealthReport{}, err
+	}
+
+	report := types.HealthReport{
+		Sync:              types.HealthStatusHealthy,
+		AvailabilityStore: types.HealthStatusHealthy,
+		DepositStore:      types.HealthStatusHealthy,
+		Peers:             types.HealthStatusHealthy,
+	}
+	if !c.sync.IsSynced(c.maxSyncLag) {
+		report.Sync = types.HealthStatusUnhealthy
+	}
+	if !c.availabilityStore.IsInitialized() {
+		report.AvailabilityStore = types.HealthStatusUnhealthy
+	}
+	if _, err := c.depositStore.Count(); err != nil {
+		report.DepositStore = types.HealthStatusUnhealthy
+	}
+	peerCount, err := c.peers.NumPeers(ctx)
+	if err != nil || peerCount == 0 {
+		report.Peers = types.HealthStatusDegraded
+	}
+	report.PeerCount = peerCount
+
+	report.Status = types.WorstHealthStatus(
+		report.Sync,
+		report.AvailabilityStore,
+		report.DepositStore,
+		report.Peers,
+	)
+	return report, nil
+}
@@ -0,0 +1,130 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package health_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/services/health"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckerReport(t *testing.T) {
+	errRead := errors.New("read failed")
+	tests := []struct {
+		name     string
+		sync     *fakeSync
+		store    fakeStore
+		deposits fakeDeposits
+		peers    fakePeers
+		want     types.HealthReport
+	}{
+		{
+			name:     "healthy",
+			sync:     &fakeSync{lag: 2},
+			store:    true,
+			deposits: fakeDeposits{},
+			peers:    fakePeers{n: 4},
+			want: types.HealthReport{
+				Status:    types.HealthStatusHealthy,
+				PeerCount: 4,
+			},
+		},
+		{
+			name:     "degraded without peers",
+			sync:     &fakeSync{},
+			store:    true,
+			deposits: fakeDeposits{},
+			peers:    fakePeers{},
+			want: types.HealthReport{
+				Status: types.HealthStatusDegraded,
+				Peers:  types.HealthStatusDegraded,
+			},
+		},
+		{
+			name:     "unhealthy when syncing without peers",
+			sync:     &fakeSync{lag: 3},
+			store:    true,
+			deposits: fakeDeposits{},
+			peers:    fakePeers{err: errRead},
+			want: types.HealthReport{
+				Status: types.HealthStatusUnhealthy,
+				Sync:   types.HealthStatusUnhealthy,
+				Peers:  types.HealthStatusDegraded,
+			},
+		},
+		{
+			name:     "unhealthy stores",
+			sync:     &fakeSync{},
+			store:    false,
+			deposits: fakeDeposits{err: errRead},
+			peers:    fakePeers{n: 1},
+			want: types.HealthReport{
+				Status:            types.HealthStatusUnhealthy,
+				AvailabilityStore: types.HealthStatusUnhealthy,
+				DepositStore:      types.HealthStatusUnhealthy,
+				PeerCount:         1,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report, err := health.NewChecker(
+				2, tt.sync, tt.store, tt.deposits, tt.peers,
+			).Report(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, tt.want, report)
+			require.Equal(t, types.WorstHealthStatus(
+				report.Sync,
+				report.AvailabilityStore,
+				report.DepositStore,
+				report.Peers,
+			), report.Status)
+		})
+	}
+}
+
+func TestCheckerReportCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := health.NewChecker(
+		2, &fakeSync{}, fakeStore(true), fakeDeposits{}, fakePeers{},
+	).Report(ctx)
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestWorstHealthStatus(t *testing.T) {
+	require.Equal(t, types.HealthStatusHealthy, types.WorstHealthStatus())
+	require.Equal(t, types.HealthStatusUnhealthy, types.WorstHealthStatus(
+		types.HealthStatusDegraded,
+		types.HealthStatusUnhealthy,
+		types.HealthStatusHealthy,
+	))
+	require.Equal(t, types.HealthStatusDegraded, types.WorstHealthStatus(
+		types.HealthStatusHealthy,
+		types.HealthStatusDegraded,
+	))
+}
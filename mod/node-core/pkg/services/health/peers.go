@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package health
+
+import (
+	"context"
+
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+)
+
+// CometPeerCounter counts the peers of the node through the RPC server of
+// CometBFT.
+type CometPeerCounter struct {
+	// addr is the address the RPC server of CometBFT listens on.
+	addr string
+}
+
+// NewCometPeerCounter creates a new peer counter querying the RPC server of
+// CometBFT listening on the given address.
+func NewCometPeerCounter(addr string) *CometPeerCounter {
+	return &CometPeerCounter{addr: addr}
+}
+
+// NumPeers returns the number of peers the node is connected to.
+func (c *CometPeerCounter) NumPeers(ctx context.Context) (int, error) {
+	client, err := rpchttp.New(c.addr)
+	if err != nil {
+		return 0, err
+	}
+	info, err := client.NetInfo(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return info.NPeers, nil
+}
@@ -0,0 +1,165 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+const (
+	// DefaultMaxSyncLag is the default number of slots the node may lag
+	// behind the head of the chain while still being considered ready.
+	DefaultMaxSyncLag math.Slot = 2
+	// readHeaderTimeout is the timeout for reading the request headers.
+	readHeaderTimeout = 5 * time.Second
+	// shutdownTimeout is the timeout for gracefully shutting down the server.
+	shutdownTimeout = 5 * time.Second
+)
+
+// Config is the configuration of the health server.
+type Config struct {
+	// Addr is the address the server listens on.
+	Addr string
+	// MaxSyncLag is the number of slots the node may lag behind the head of
+	// the chain while still being considered ready.
+	MaxSyncLag math.Slot
+}
+
+// Server is a service that serves the liveness and readiness probes of the
+// node over HTTP. The liveness probe at /live always succeeds while the
+// server is running, whereas the readiness probe at /ready only succeeds
+// while the node is not unhealthy, that is once it has caught up to the head
+// of the chain and its stores are available. The health report of the node
+// is served as JSON at /health.
+type Server struct {
+	// logger is used to log information about the server.
+	logger log.Logger[any]
+	// addr is the address the server listens on.
+	addr string
+	// reporter reports the health of the node.
+	reporter types.HealthReporter
+	// srv is the underlying HTTP server, set once the service is started.
+	srv *http.Server
+}
+
+// NewServer creates a new health server.
+func NewServer(
+	logger log.Logger[any],
+	addr string,
+	reporter types.HealthReporter,
+) *Server {
+	return &Server{
+		logger:   logger,
+		addr:     addr,
+		reporter: reporter,
+	}
+}
+
+// Name returns the name of the service.
+func (*Server) Name() string {
+	return "health"
+}
+
+// Start starts serving the probes, the server is shut down once the given
+// context is cancelled.
+func (s *Server) Start(ctx context.Context) error {
+	listener, err := net.Listen("tcp", s.addr)
+	if err != nil {
+		return err
+	}
+
+	s.srv = &http.Server{
+		Handler:           s.Handler(),
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+	go func() {
+		if serveErr := s.srv.Serve(listener); serveErr != nil &&
+			!errors.Is(serveErr, http.ErrServerClosed) {
+			s.logger.Error("health server failed", "error", serveErr)
+		}
+	}()
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(
+			context.WithoutCancel(ctx), shutdownTimeout,
+		)
+		defer cancel()
+		if shutdownErr := s.srv.Shutdown(shutdownCtx); shutdownErr != nil {
+			s.logger.Error(
+				"failed to shut down health server", "error", shutdownErr,
+			)
+		}
+	}()
+
+	s.logger.Info("health server started", "addr", listener.Addr())
+	return nil
+}
+
+// Status returns nil if the service is healthy.
+func (*Server) Status() error {
+	return nil
+}
+
+// Handler returns the HTTP handler serving the probes.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/live", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc("/ready", func(w http.ResponseWriter, r *http.Request) {
+		if !s.IsReady(r.Context()) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
+		report, err := s.reporter.Report(r.Context())
+		if err != nil {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if report.Status == types.HealthStatusUnhealthy {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		if err = json.NewEncoder(w).Encode(report); err != nil {
+			s.logger.Error("failed to write health report", "error", err)
+		}
+	})
+	return mux
+}
+
+// IsReady reports whether the node is not unhealthy, that is whether it has
+// caught up to the head of the chain and its stores are available.
+func (s *Server) IsReady(ctx context.Context) bool {
+	report, err := s.reporter.Report(ctx)
+	return err == nil && report.Status != types.HealthStatusUnhealthy
+}
@@ -0,0 +1,144 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package health_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/services/health"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSync reports the node as lagging behind the chain head by lag slots.
+type fakeSync struct {
+	lag math.Slot
+}
+
+func (f *fakeSync) IsSynced(maxLag math.Slot) bool {
+	return f.lag <= maxLag
+}
+
+// fakeStore is an availability store with a fixed initialization state.
+type fakeStore bool
+
+func (f fakeStore) IsInitialized() bool {
+	return bool(f)
+}
+
+// fakeDeposits is a deposit database that fails to be read if err is set.
+type fakeDeposits struct {
+	err error
+}
+
+func (f fakeDeposits) Count() (uint64, error) {
+	return 0, f.err
+}
+
+// fakePeers counts n peers, or fails to count them if err is set.
+type fakePeers struct {
+	n   int
+	err error
+}
+
+func (f fakePeers) NumPeers(context.Context) (int, error) {
+	return f.n, f.err
+}
+
+// get performs a GET request against the given path of the server and
+// returns the status code of the response.
+func get(t *testing.T, srv *httptest.Server, path string) int {
+	t.Helper()
+	req, err := http.NewRequestWithContext(
+		context.Background(), http.MethodGet, srv.URL+path, http.NoBody,
+	)
+	require.NoError(t, err)
+	resp, err := srv.Client().Do(req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	return resp.StatusCode
+}
+
+func TestReadiness(t *testing.T) {
+	sync := &fakeSync{lag: 10}
+	hs := health.NewServer(noop.NewLogger(), "", health.NewChecker(
+		2, sync, fakeStore(true), fakeDeposits{}, fakePeers{n: 1},
+	))
+	srv := httptest.NewServer(hs.Handler())
+	defer srv.Close()
+
+	// The liveness probe succeeds regardless of the sync status.
+	require.Equal(t, http.StatusOK, get(t, srv, "/live"))
+	require.Equal(t, http.StatusServiceUnavailable, get(t, srv, "/ready"))
+
+	sync.lag = 2
+	require.Equal(t, http.StatusOK, get(t, srv, "/ready"))
+
+	sync.lag = 3
+	require.Equal(t, http.StatusServiceUnavailable, get(t, srv, "/ready"))
+}
+
+func TestReadinessRequiresAvailabilityStore(t *testing.T) {
+	hs := health.NewServer(noop.NewLogger(), "", health.NewChecker(
+		2, &fakeSync{}, fakeStore(false), fakeDeposits{}, fakePeers{n: 1},
+	))
+	srv := httptest.NewServer(hs.Handler())
+	defer srv.Close()
+
+	require.Equal(t, http.StatusServiceUnavailable, get(t, srv, "/ready"))
+}
+
+func TestReadinessIgnoresDegradedPeers(t *testing.T) {
+	hs := health.NewServer(noop.NewLogger(), "", health.NewChecker(
+		2, &fakeSync{}, fakeStore(true), fakeDeposits{}, fakePeers{},
+	))
+	srv := httptest.NewServer(hs.Handler())
+	defer srv.Close()
+
+	require.Equal(t, http.StatusOK, get(t, srv, "/ready"))
+}
+
+func TestHealthReport(t *testing.T) {
+	hs := health.NewServer(noop.NewLogger(), "", health.NewChecker(
+		2, &fakeSync{}, fakeStore(true), fakeDeposits{}, fakePeers{n: 3},
+	))
+	srv := httptest.NewServer(hs.Handler())
+	defer srv.Close()
+
+	req, err := http.NewRequestWithContext(
+		context.Background(), http.MethodGet, srv.URL+"/health", http.NoBody,
+	)
+	require.NoError(t, err)
+	resp, err := srv.Client().Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var report types.HealthReport
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&report))
+	require.Equal(t, types.HealthReport{PeerCount: 3}, report)
+}
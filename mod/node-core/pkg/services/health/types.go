@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package health
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// SyncReporter reports the sync status of the node.
+type SyncReporter interface {
+	// IsSynced reports whether the node is at most maxLag slots behind the
+	// head of the chain.
+	IsSynced(maxLag math.Slot) bool
+}
+
+// AvailabilityStore is the data availability store of the node.
+type AvailabilityStore interface {
+	// IsInitialized reports whether the store is ready for use.
+	IsInitialized() bool
+}
+
+// DepositStore is the deposit database of the node.
+type DepositStore interface {
+	// Count returns the number of deposits in the store.
+	Count() (uint64, error)
+}
+
+// PeerCounter counts the peers of the node.
+type PeerCounter interface {
+	// NumPeers returns the number of peers the node is connected to.
+	NumPeers(ctx context.Context) (int, error)
+}
@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package pprof
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/log"
+)
+
+const (
+	// readHeaderTimeout is the timeout for reading the request headers.
+	readHeaderTimeout = 5 * time.Second
+	// shutdownTimeout is the timeout for gracefully shutting down the server.
+	shutdownTimeout = 5 * time.Second
+)
+
+// Server serves the runtime profiling data of the node in the format
+// expected by the pprof tool under /debug/pprof.
+type Server struct {
+	// logger is used to log information about the server.
+	logger log.Logger[any]
+	// addr is the address the server listens on.
+	addr string
+	// listener is the listener of the server, set once it is started.
+	listener net.Listener
+	// srv is the underlying HTTP server, set once it is started.
+	srv *http.Server
+}
+
+// NewServer creates a new pprof server.
+func NewServer(logger log.Logger[any], addr string) *Server {
+	return &Server{
+		logger: logger,
+		addr:   addr,
+	}
+}
+
+// Start starts serving the profiling data in the background.
+func (s *Server) Start() error {
+	listener, err := net.Listen("tcp", s.addr)
+	if err != nil {
+		return err
+	}
+
+	s.listener = listener
+	s.srv = &http.Server{
+		Handler:           Handler(),
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+	go func() {
+		if serveErr := s.srv.Serve(listener); serveErr != nil &&
+			!errors.Is(serveErr, http.ErrServerClosed) {
+			s.logger.Error("pprof server failed", "error", serveErr)
+		}
+	}()
+
+	s.logger.Info("pprof server started", "addr", listener.Addr())
+	return nil
+}
+
+// Addr returns the address the server listens on, which is only known once
+// it is started if the port of the configured address is zero.
+func (s *Server) Addr() net.Addr {
+	if s.listener == nil {
+		return nil
+	}
+	return s.listener.Addr()
+}
+
+// Close gracefully shuts the server down. It is a no-op if the server has
+// not been started.
+func (s *Server) Close() error {
+	if s.srv == nil {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	return s.srv.Shutdown(ctx)
+}
+
+// Handler returns the HTTP handler serving the profiling data.
+func Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	return mux
+}
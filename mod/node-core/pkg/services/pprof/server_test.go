@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package pprof_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/services/pprof"
+	"github.com/stretchr/testify/require"
+)
+
+// get performs a GET request against the given URL and returns the status
+// code of the response.
+func get(t *testing.T, url string) (int, error) {
+	t.Helper()
+	req, err := http.NewRequestWithContext(
+		context.Background(), http.MethodGet, url, http.NoBody,
+	)
+	require.NoError(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	require.NoError(t, resp.Body.Close())
+	return resp.StatusCode, nil
+}
+
+func TestServer(t *testing.T) {
+	srv := pprof.NewServer(noop.NewLogger(), "127.0.0.1:0")
+	require.Nil(t, srv.Addr())
+	require.NoError(t, srv.Start())
+
+	url := "http://" + srv.Addr().String() + "/debug/pprof/"
+	code, err := get(t, url)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, code)
+
+	code, err = get(t, url+"goroutine?debug=1")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, code)
+
+	// The server no longer responds once it is closed.
+	require.NoError(t, srv.Close())
+	_, err = get(t, url)
+	require.Error(t, err)
+}
+
+func TestServerCloseNotStarted(t *testing.T) {
+	require.NoError(t, pprof.NewServer(noop.NewLogger(), "").Close())
+}
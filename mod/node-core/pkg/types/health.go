@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+)
+
+// ErrUnknownHealthStatus is returned when a health status cannot be parsed.
+var ErrUnknownHealthStatus = errors.New("unknown health status")
+
+// HealthStatus is the health status of a node or of one of its subsystems.
+// The statuses are ordered from the healthiest to the least healthy.
+type HealthStatus uint8
+
+const (
+	// HealthStatusHealthy is the status of a subsystem that works as
+	// expected.
+	HealthStatusHealthy HealthStatus = iota
+	// HealthStatusDegraded is the status of a subsystem that works, but not
+	// as expected, such as a node without peers.
+	HealthStatusDegraded
+	// HealthStatusUnhealthy is the status of a subsystem that does not
+	// work, such as a node that is not synced.
+	HealthStatusUnhealthy
+)
+
+// healthStatusNames are the names of the health statuses.
+//
+//nolint:gochecknoglobals // lookup table.
+var healthStatusNames = map[HealthStatus]string{
+	HealthStatusHealthy:   "healthy",
+	HealthStatusDegraded:  "degraded",
+	HealthStatusUnhealthy: "unhealthy",
+}
+
+// String returns the name of the status.
+func (s HealthStatus) String() string {
+	if name, ok := healthStatusNames[s]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// MarshalText marshals the status into its name.
+func (s HealthStatus) MarshalText() ([]byte, error) {
+	if _, ok := healthStatusNames[s]; !ok {
+		return nil, errors.Wrapf(ErrUnknownHealthStatus, "%d", s)
+	}
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText unmarshals the status from its name.
+func (s *HealthStatus) UnmarshalText(text []byte) error {
+	for status, name := range healthStatusNames {
+		if name == string(text) {
+			*s = status
+			return nil
+		}
+	}
+	return errors.Wrapf(ErrUnknownHealthStatus, "%q", text)
+}
+
+// WorstHealthStatus returns the least healthy of the given statuses, or
+// HealthStatusHealthy if none are given.
+func WorstHealthStatus(statuses ...HealthStatus) HealthStatus {
+	worst := HealthStatusHealthy
+	for _, s := range statuses {
+		worst = max(worst, s)
+	}
+	return worst
+}
+
+// HealthReport is the health of a node and of each of its subsystems.
+type HealthReport struct {
+	// Status is the overall status of the node, which is the worst of the
+	// statuses of its subsystems.
+	Status HealthStatus `json:"status"`
+	// Sync is the status of the sync of the node to the head of the chain.
+	Sync HealthStatus `json:"sync"`
+	// AvailabilityStore is the status of the data availability store.
+	AvailabilityStore HealthStatus `json:"availability_store"`
+	// DepositStore is the status of the deposit database.
+	DepositStore HealthStatus `json:"deposit_store"`
+	// Peers is the status of the peer connections of the node.
+	Peers HealthStatus `json:"peers"`
+	// PeerCount is the number of peers the node is connected to.
+	PeerCount int `json:"peer_count"`
+}
+
+// HealthReporter reports the health of a node.
+type HealthReporter interface {
+	// Report returns the health of the node and of each of its subsystems.
+	Report(ctx context.Context) (HealthReport, error)
+}
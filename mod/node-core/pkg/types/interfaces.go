@@ -21,6 +21,9 @@
 package types
 
 import (
+	"context"
+	"time"
+
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/spf13/cobra"
 )
@@ -31,6 +34,13 @@ type NodeI interface {
 	servertypes.Application
 
 	Run() error
+	RegisterCloser(name string, closer func() error)
+	SetShutdownTimeout(timeout time.Duration)
+	SetRootContext(ctx context.Context)
+	NodeInfo() NodeInfo
+	SetNodeInfo(info NodeInfo)
+	HealthSummary(ctx context.Context) (HealthReport, error)
+	SetHealthReporter(reporter HealthReporter)
 
 	SetAppName(name string)
 	SetAppDescription(description string)
@@ -0,0 +1,39 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package primitives
+
+import "github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+
+// EarliestAvailableBlobSlot returns the earliest slot whose blob sidecars are
+// still available at the given head slot, which is the head slot minus the
+// MIN_EPOCHS_FOR_BLOB_SIDECARS_REQUESTS window of the chain spec. The blob
+// sidecars of the slots before it may be pruned. If the head slot is within
+// the window, the blob sidecars of every slot are available and zero is
+// returned.
+func EarliestAvailableBlobSlot(spec ChainSpec, head math.Slot) math.Slot {
+	window := math.Slot(
+		mulSat(spec.MinEpochsForBlobsSidecarsRequest(), spec.SlotsPerEpoch()),
+	)
+	if head < window {
+		return 0
+	}
+	return head - window
+}
@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package primitives_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEarliestAvailableBlobSlot(t *testing.T) {
+	// The window is 2 epochs of 4 slots.
+	spec := chain.NewChainSpec(chain.SpecData[
+		common.DomainType, math.Epoch,
+		common.ExecutionAddress, math.Slot, any,
+	]{
+		SlotsPerEpoch:                    4,
+		MinEpochsForBlobsSidecarsRequest: 2,
+	})
+
+	tests := []struct {
+		name     string
+		head     math.Slot
+		expected math.Slot
+	}{
+		{
+			name:     "genesis",
+			head:     0,
+			expected: 0,
+		},
+		{
+			name:     "below the window",
+			head:     7,
+			expected: 0,
+		},
+		{
+			name:     "at the window",
+			head:     8,
+			expected: 0,
+		},
+		{
+			name:     "past the window",
+			head:     9,
+			expected: 1,
+		},
+		{
+			name:     "far past the window",
+			head:     100,
+			expected: 92,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(
+				t, tt.expected,
+				primitives.EarliestAvailableBlobSlot(spec, tt.head),
+			)
+		})
+	}
+}
@@ -51,6 +51,8 @@ type Spec[
 	// MinEpochsToInactivityPenalty returns the minimum number of epochs before
 	// an inactivity penalty is applied.
 	MinEpochsToInactivityPenalty() uint64
+	// SecondsPerSlot returns the target time between slots.
+	SecondsPerSlot() uint64
 
 	// Signature Domains
 	//
@@ -91,6 +93,9 @@ type Spec[
 	// ElectraForkEpoch returns the epoch at which the Electra fork takes
 	// effect.
 	ElectraForkEpoch() EpochT
+	// ElectraSecondsPerSlot returns the target time between slots once the
+	// Electra fork takes effect, or zero if it is unchanged.
+	ElectraSecondsPerSlot() uint64
 
 	// State list lengths
 	//
@@ -147,6 +152,9 @@ type Spec[
 	ActiveForkVersionForEpoch(epoch EpochT) uint32
 	// SlotToEpoch converts a slot number to an epoch number.
 	SlotToEpoch(slot SlotT) EpochT
+	// SecondsPerSlotForEpoch returns the target time between slots in a given
+	// epoch.
+	SecondsPerSlotForEpoch(epoch EpochT) uint64
 	// WithinDAPeriod checks if a given block slot is within the data
 	// availability period relative to the current slot.
 	WithinDAPeriod(block, current SlotT) bool
@@ -237,6 +245,13 @@ func (c chainSpec[
 	return c.Data.MinEpochsToInactivityPenalty
 }
 
+// SecondsPerSlot returns the target time between slots.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) SecondsPerSlot() uint64 {
+	return c.Data.SecondsPerSlot
+}
+
 // DomainTypeProposer returns the domain for beacon proposer signatures.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
@@ -337,6 +352,14 @@ func (c chainSpec[
 	return c.Data.ElectraForkEpoch
 }
 
+// ElectraSecondsPerSlot returns the target time between slots once the
+// Electra fork takes effect.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) ElectraSecondsPerSlot() uint64 {
+	return c.Data.ElectraSecondsPerSlot
+}
+
 // EpochsPerHistoricalVector returns the number of epochs per historical vector.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
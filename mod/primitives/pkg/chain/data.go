@@ -52,6 +52,8 @@ type SpecData[
 	// MinEpochsToInactivityPenalty is the minimum number of epochs before a
 	// validator is penalized for inactivity.
 	MinEpochsToInactivityPenalty uint64 `mapstructure:"min-epochs-to-inactivity-penalty"`
+	// SecondsPerSlot is the target time between slots.
+	SecondsPerSlot uint64 `mapstructure:"seconds-per-slot"`
 
 	// Signature domains.
 	//
@@ -93,6 +95,9 @@ type SpecData[
 	//
 	// ElectraForkEpoch is the epoch at which the Electra fork is activated.
 	ElectraForkEpoch EpochT `mapstructure:"electra-fork-epoch"`
+	// ElectraSecondsPerSlot is the target time between slots once the Electra
+	// fork is activated. If zero, SecondsPerSlot is kept.
+	ElectraSecondsPerSlot uint64 `mapstructure:"electra-seconds-per-slot"`
 
 	// State list lengths
 	//
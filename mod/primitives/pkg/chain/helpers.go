@@ -54,6 +54,18 @@ func (c chainSpec[
 	return EpochT(uint64(slot) / c.SlotsPerEpoch())
 }
 
+// SecondsPerSlotForEpoch returns the target time between slots in a given
+// epoch.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) SecondsPerSlotForEpoch(epoch EpochT) uint64 {
+	if c.ActiveForkVersionForEpoch(epoch) == version.Electra &&
+		c.Data.ElectraSecondsPerSlot != 0 {
+		return c.Data.ElectraSecondsPerSlot
+	}
+	return c.Data.SecondsPerSlot
+}
+
 // WithinDAPeriod checks if the block epoch is within
 // MIN_EPOCHS_FOR_BLOB_SIDECARS_REQUESTS
 // of the given current epoch.
@@ -92,3 +92,16 @@ func TestFromUint32_CustomType(t *testing.T) {
 		t.Errorf("FromUint32(%d) = %v, expected %v", input, result, expected)
 	}
 }
+
+func TestName(t *testing.T) {
+	for input, expected := range map[uint32]string{
+		version.Phase0:  "phase0",
+		version.Deneb:   "deneb",
+		version.Electra: "electra",
+		42:              "unknown",
+	} {
+		if result := version.Name(input); result != expected {
+			t.Errorf("Name(%d) = %q, expected %q", input, result, expected)
+		}
+	}
+}
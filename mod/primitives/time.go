@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package primitives
+
+import (
+	stdmath "math"
+	"math/bits"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// SlotToTime returns the time at which the given slot is targeted to start,
+// given the genesis time of the chain. The time between slots is taken from
+// the chain spec, such that a change of it at a fork is accounted for. It
+// returns ErrZeroSecondsPerSlot if the chain spec sets no time between slots.
+func SlotToTime(
+	spec ChainSpec,
+	genesisTime time.Time,
+	slot math.Slot,
+) (time.Time, error) {
+	forkSlot, before, after, err := slotTimeParams(spec)
+	if err != nil {
+		return time.Time{}, err
+	}
+	secs := mulSat(min(slot, forkSlot).Unwrap(), before)
+	if slot > forkSlot {
+		secs = addSat(secs, mulSat((slot-forkSlot).Unwrap(), after))
+	}
+	if secs > uint64(stdmath.MaxInt64/time.Second) {
+		secs = uint64(stdmath.MaxInt64 / time.Second)
+	}
+	//#nosec:G701 // bounded above.
+	return genesisTime.Add(time.Duration(secs) * time.Second), nil
+}
+
+// TimeToSlot returns the slot that is targeted to be in progress at the given
+// time, given the genesis time of the chain. It is the inverse of SlotToTime.
+// Times before genesis belong to the genesis slot. It returns
+// ErrZeroSecondsPerSlot if the chain spec sets no time between slots.
+func TimeToSlot(
+	spec ChainSpec,
+	genesisTime time.Time,
+	t time.Time,
+) (math.Slot, error) {
+	forkSlot, before, after, err := slotTimeParams(spec)
+	if err != nil {
+		return 0, err
+	}
+	elapsed := t.Sub(genesisTime)
+	if elapsed <= 0 {
+		return 0, nil
+	}
+
+	//#nosec:G701 // elapsed is positive.
+	secs := uint64(elapsed / time.Second)
+	forkSecs := mulSat(forkSlot.Unwrap(), before)
+	if secs < forkSecs {
+		return math.Slot(secs / before), nil
+	}
+	return forkSlot + math.Slot((secs-forkSecs)/after), nil
+}
+
+// slotTimeParams returns the first slot of the Electra fork, and the time
+// between slots before and after it. It returns ErrZeroSecondsPerSlot if
+// either time is zero, e.g. for a chain spec predating the time between
+// slots.
+func slotTimeParams(spec ChainSpec) (math.Slot, uint64, uint64, error) {
+	forkEpoch := spec.ElectraForkEpoch()
+	forkSlot := math.Slot(mulSat(forkEpoch.Unwrap(), spec.SlotsPerEpoch()))
+	before := spec.SecondsPerSlotForEpoch(0)
+	after := spec.SecondsPerSlotForEpoch(forkEpoch)
+	if before == 0 || after == 0 {
+		return 0, 0, 0, ErrZeroSecondsPerSlot
+	}
+	return forkSlot, before, after, nil
+}
+
+// mulSat returns a * b, saturated at the maximum uint64.
+func mulSat(a, b uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+	if hi != 0 {
+		return stdmath.MaxUint64
+	}
+	return lo
+}
+
+// addSat returns a + b, saturated at the maximum uint64.
+func addSat(a, b uint64) uint64 {
+	sum, carry := bits.Add64(a, b, 0)
+	if carry != 0 {
+		return stdmath.MaxUint64
+	}
+	return sum
+}
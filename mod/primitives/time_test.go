@@ -0,0 +1,171 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package primitives_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+func newTimeSpec(
+	forkEpoch math.Epoch,
+	electraSecondsPerSlot uint64,
+) primitives.ChainSpec {
+	return chain.NewChainSpec(chain.SpecData[
+		common.DomainType, math.Epoch,
+		common.ExecutionAddress, math.Slot, any,
+	]{
+		SlotsPerEpoch:         4,
+		SecondsPerSlot:        6,
+		ElectraForkEpoch:      forkEpoch,
+		ElectraSecondsPerSlot: electraSecondsPerSlot,
+	})
+}
+
+func TestSlotToTime(t *testing.T) {
+	genesis := time.Unix(1_700_000_000, 0)
+
+	tests := []struct {
+		name     string
+		spec     primitives.ChainSpec
+		slot     math.Slot
+		expected time.Duration
+	}{
+		{
+			name: "genesis slot",
+			spec: newTimeSpec(10, 2),
+			slot: 0,
+		},
+		{
+			name:     "before fork",
+			spec:     newTimeSpec(10, 2),
+			slot:     39,
+			expected: 39 * 6 * time.Second,
+		},
+		{
+			name:     "at fork",
+			spec:     newTimeSpec(10, 2),
+			slot:     40,
+			expected: 40 * 6 * time.Second,
+		},
+		{
+			name:     "after fork",
+			spec:     newTimeSpec(10, 2),
+			slot:     45,
+			expected: (40*6 + 5*2) * time.Second,
+		},
+		{
+			name:     "fork keeps seconds per slot",
+			spec:     newTimeSpec(10, 0),
+			slot:     45,
+			expected: 45 * 6 * time.Second,
+		},
+		{
+			name:     "fork at genesis",
+			spec:     newTimeSpec(0, 2),
+			slot:     45,
+			expected: 45 * 2 * time.Second,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := primitives.SlotToTime(tt.spec, genesis, tt.slot)
+			require.NoError(t, err)
+			require.Equal(t, genesis.Add(tt.expected), got)
+			slot, err := primitives.TimeToSlot(tt.spec, genesis, got)
+			require.NoError(t, err)
+			require.Equal(t, tt.slot, slot)
+		})
+	}
+}
+
+func TestTimeToSlot(t *testing.T) {
+	genesis := time.Unix(1_700_000_000, 0)
+	spec := newTimeSpec(10, 2)
+
+	tests := []struct {
+		name     string
+		elapsed  time.Duration
+		expected math.Slot
+	}{
+		{
+			name:     "before genesis",
+			elapsed:  -time.Hour,
+			expected: 0,
+		},
+		{
+			name:     "within a slot before fork",
+			elapsed:  13 * time.Second,
+			expected: 2,
+		},
+		{
+			name:     "last second before fork",
+			elapsed:  (40*6 - 1) * time.Second,
+			expected: 39,
+		},
+		{
+			name:     "within a slot after fork",
+			elapsed:  (40*6 + 5) * time.Second,
+			expected: 42,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			slot, err := primitives.TimeToSlot(
+				spec, genesis, genesis.Add(tt.elapsed),
+			)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, slot)
+		})
+	}
+}
+
+func TestSlotToTimeSaturates(t *testing.T) {
+	genesis := time.Unix(0, 0)
+	spec := newTimeSpec(math.Epoch(1<<62), 2)
+	got, err := primitives.SlotToTime(spec, genesis, math.Slot(1<<63))
+	require.NoError(t, err)
+	require.True(t, got.After(genesis))
+}
+
+func TestZeroSecondsPerSlot(t *testing.T) {
+	genesis := time.Unix(1_700_000_000, 0)
+	spec := chain.NewChainSpec(chain.SpecData[
+		common.DomainType, math.Epoch,
+		common.ExecutionAddress, math.Slot, any,
+	]{
+		SlotsPerEpoch:    4,
+		ElectraForkEpoch: 10,
+	})
+
+	_, err := primitives.SlotToTime(spec, genesis, 1)
+	require.ErrorIs(t, err, primitives.ErrZeroSecondsPerSlot)
+	_, err = primitives.TimeToSlot(spec, genesis, genesis.Add(time.Hour))
+	require.ErrorIs(t, err, primitives.ErrZeroSecondsPerSlot)
+}
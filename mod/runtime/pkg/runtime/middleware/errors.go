@@ -25,3 +25,7 @@ import "errors"
 // ErrUndefinedValidatorUpdate is returned when an undefined validator update is
 // encountered.
 var ErrUndefinedValidatorUpdate = errors.New("undefined validator update")
+
+// ErrPauserClosed is returned when a block or proposal held while block
+// processing is paused is released as the node shuts down.
+var ErrPauserClosed = errors.New("pauser closed")
@@ -56,6 +56,9 @@ type FinalizeBlockMiddleware[
 	chainService BlockchainService[BeaconBlockT, BlobSidecarsT]
 	// metrics is the metrics for the middleware.
 	metrics *finalizeMiddlewareMetrics
+	// pauser buffers the blocks to finalize while block processing is
+	// paused.
+	pauser *Pauser
 	// valUpdates caches the validator updates as they are produced.
 	valUpdates []*transition.ValidatorUpdate
 }
@@ -71,6 +74,7 @@ func NewFinalizeBlockMiddleware[
 	chainSpec primitives.ChainSpec,
 	chainService BlockchainService[BeaconBlockT, BlobSidecarsT],
 	telemetrySink TelemetrySink,
+	pauser *Pauser,
 ) *FinalizeBlockMiddleware[BeaconBlockT, BeaconStateT, BlobSidecarsT] {
 	// This is just for nilaway, TODO: remove later.
 	if chainService == nil {
@@ -81,6 +85,7 @@ func NewFinalizeBlockMiddleware[
 		chainSpec:    chainSpec,
 		chainService: chainService,
 		metrics:      newFinalizeMiddlewareMetrics(telemetrySink),
+		pauser:       pauser,
 	}
 }
 
@@ -111,11 +116,24 @@ func (h *FinalizeBlockMiddleware[
 
 // PreBlock is called by the base app before the block is finalized. It
 // is responsible for aggregating oracle data from each validator and writing
-// the oracle data to the store.
+// the oracle data to the store. While block processing is paused, the block is
+// buffered and applied in order once processing is resumed, holding back
+// consensus in the meantime.
 func (h *FinalizeBlockMiddleware[
 	BeaconBlockT, BeaconStateT, BlobSidecarsT,
 ]) PreBlock(
 	ctx sdk.Context, req *cometabci.FinalizeBlockRequest,
+) error {
+	return h.pauser.Apply(ctx, func() error {
+		return h.preBlock(ctx, req)
+	})
+}
+
+// preBlock applies the block of the given request.
+func (h *FinalizeBlockMiddleware[
+	BeaconBlockT, BeaconStateT, BlobSidecarsT,
+]) preBlock(
+	ctx sdk.Context, req *cometabci.FinalizeBlockRequest,
 ) error {
 	startTime := time.Now()
 	defer h.metrics.measureEndBlockDuration(startTime)
@@ -133,10 +151,15 @@ func (h *FinalizeBlockMiddleware[
 		return nil
 	}
 
-	// Process the state transition and produce the required delta from
-	// the sync committee.
-	h.valUpdates, err = h.chainService.ProcessBlockAndBlobs(
-		ctx, blk, blobs,
+	// Track the height the consensus engine is syncing to, such that the
+	// chain service can report whether the node has caught up.
+	h.chainService.SetSyncTarget(math.Slot(req.GetSyncingToHeight()))
+
+	// Verify the blob sidecars, then process the state transition and
+	// produce the required delta from the sync committee. CometBFT
+	// finalizes a single block at a time.
+	h.valUpdates, err = h.chainService.ImportBlocks(
+		ctx, []BeaconBlockT{blk}, []BlobSidecarsT{blobs},
 		// TODO: Speak with @melekes about this, doesn't seem to
 		// work reliably.
 		/*req.SyncingToHeight == req.Height*/
@@ -0,0 +1,219 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package middleware
+
+import (
+	"context"
+	"slices"
+	"sync"
+)
+
+// DefaultPauseBufferSize is the default number of blocks that may be buffered
+// while block processing is paused.
+const DefaultPauseBufferSize = 64
+
+// Pauser pauses and resumes the processing of blocks, such as for coordinated
+// upgrades. While paused, the node does not propose blocks, and the blocks
+// delivered for application are buffered, up to the capacity of the Pauser,
+// and applied in the order they were delivered once processing is resumed.
+// The delivery of further blocks is held back while the buffer is full,
+// rather than failing it. CometBFT delivers one block at a time and keeps
+// receiving gossip in the meantime.
+type Pauser struct {
+	// slots holds a token per buffered block, bounding the buffer.
+	slots chan struct{}
+	// closed is closed once the Pauser is closed.
+	closed    chan struct{}
+	closeOnce sync.Once
+
+	mu sync.Mutex
+	// resumed is closed once processing is resumed. It is nil while
+	// processing is not paused.
+	resumed chan struct{}
+	// buffered holds a channel per buffered block, in the order the blocks
+	// were delivered. The channel of the first block is closed once it may
+	// be applied.
+	buffered []chan struct{}
+	// holding is the number of proposals held until processing is resumed.
+	holding int
+}
+
+// NewPauser creates a new Pauser, which buffers up to the given number of
+// blocks while paused, or a single block if it is not positive.
+func NewPauser(capacity int) *Pauser {
+	return &Pauser{
+		slots:  make(chan struct{}, max(1, capacity)),
+		closed: make(chan struct{}),
+	}
+}
+
+// Pause pauses the processing of blocks. A block being applied is not
+// interrupted.
+func (p *Pauser) Pause() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.resumed == nil {
+		p.resumed = make(chan struct{})
+	}
+}
+
+// Resume resumes the processing of blocks, applying the blocks buffered while
+// paused in order, and releasing the proposal held, if any.
+func (p *Pauser) Resume() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.resumed != nil {
+		close(p.resumed)
+		p.resumed = nil
+	}
+	p.signalNext()
+}
+
+// Close releases the blocks and proposals held while paused with
+// ErrPauserClosed, such that the node can shut down while paused. Blocks
+// delivered while paused are not buffered once the Pauser is closed.
+func (p *Pauser) Close() {
+	p.closeOnce.Do(func() { close(p.closed) })
+}
+
+// Paused reports whether the processing of blocks is paused.
+func (p *Pauser) Paused() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.resumed != nil
+}
+
+// Waiting reports whether a block or proposal waits for processing to be
+// resumed.
+func (p *Pauser) Waiting() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.buffered) > 0 || p.holding > 0
+}
+
+// Buffered returns the number of blocks waiting to be applied.
+func (p *Pauser) Buffered() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.buffered)
+}
+
+// Apply applies a block with the given function. While the processing of
+// blocks is paused, or other blocks are buffered, the block is buffered until
+// its turn, waiting for room in the buffer if it is full. It fails with
+// ErrPauserClosed if the Pauser is closed, or with the error of the context
+// if it is done, before the block is applied.
+func (p *Pauser) Apply(ctx context.Context, apply func() error) error {
+	p.mu.Lock()
+	if p.resumed == nil && len(p.buffered) == 0 {
+		p.mu.Unlock()
+		return apply()
+	}
+	p.mu.Unlock()
+
+	// Wait for room in the buffer, holding back the delivery of the blocks
+	// following this one.
+	if p.isClosed() {
+		return ErrPauserClosed
+	}
+	select {
+	case p.slots <- struct{}{}:
+	case <-p.closed:
+		return ErrPauserClosed
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	defer func() { <-p.slots }()
+
+	p.mu.Lock()
+	turn := make(chan struct{})
+	p.buffered = append(p.buffered, turn)
+	p.signalNext()
+	p.mu.Unlock()
+	defer func() {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		p.buffered = slices.DeleteFunc(
+			p.buffered, func(c chan struct{}) bool { return c == turn },
+		)
+		p.signalNext()
+	}()
+
+	select {
+	case <-turn:
+		return apply()
+	case <-p.closed:
+		return ErrPauserClosed
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Wait blocks while the processing of blocks is paused. It fails with
+// ErrPauserClosed if the Pauser is closed, or with the error of the context
+// if it is done, before processing is resumed.
+func (p *Pauser) Wait(ctx context.Context) error {
+	p.mu.Lock()
+	resumed := p.resumed
+	if resumed == nil {
+		p.mu.Unlock()
+		return nil
+	}
+	p.holding++
+	p.mu.Unlock()
+
+	defer func() {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		p.holding--
+	}()
+	select {
+	case <-resumed:
+		return nil
+	case <-p.closed:
+		return ErrPauserClosed
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// isClosed reports whether the Pauser is closed.
+func (p *Pauser) isClosed() bool {
+	select {
+	case <-p.closed:
+		return true
+	default:
+		return false
+	}
+}
+
+// signalNext gives the first buffered block its turn, unless paused.
+func (p *Pauser) signalNext() {
+	if p.resumed != nil || len(p.buffered) == 0 {
+		return
+	}
+	select {
+	case <-p.buffered[0]:
+		// The first block already has its turn.
+	default:
+		close(p.buffered[0])
+	}
+}
@@ -0,0 +1,312 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package middleware_test
+
+import (
+	"context"
+	"encoding/binary"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/genesis"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/chain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/runtime/middleware"
+	cometabci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+// testBlock is a beacon block that only holds its slot.
+type testBlock struct {
+	slot uint64
+}
+
+func (b *testBlock) NewFromSSZ(bz []byte, _ uint32) (*testBlock, error) {
+	blk := new(testBlock)
+	return blk, blk.UnmarshalSSZ(bz)
+}
+
+func (b *testBlock) MarshalSSZTo(dst []byte) ([]byte, error) {
+	return binary.LittleEndian.AppendUint64(dst, b.slot), nil
+}
+
+func (b *testBlock) MarshalSSZ() ([]byte, error) {
+	return b.MarshalSSZTo(nil)
+}
+
+func (b *testBlock) UnmarshalSSZ(bz []byte) error {
+	b.slot = binary.LittleEndian.Uint64(bz)
+	return nil
+}
+
+func (b *testBlock) SizeSSZ() int {
+	return 8
+}
+
+func (b *testBlock) HashTreeRoot() ([32]byte, error) {
+	var root [32]byte
+	binary.LittleEndian.PutUint64(root[:], b.slot)
+	return root, nil
+}
+
+// testSidecars are blob sidecars without any content.
+type testSidecars struct{}
+
+func (*testSidecars) MarshalSSZTo(dst []byte) ([]byte, error) {
+	return dst, nil
+}
+
+func (*testSidecars) MarshalSSZ() ([]byte, error) {
+	return []byte{}, nil
+}
+
+func (*testSidecars) UnmarshalSSZ([]byte) error {
+	return nil
+}
+
+func (*testSidecars) SizeSSZ() int {
+	return 0
+}
+
+func (*testSidecars) HashTreeRoot() ([32]byte, error) {
+	return [32]byte{}, nil
+}
+
+// testChainService records the slots of the blocks it processes.
+type testChainService struct {
+	mu        sync.Mutex
+	processed []uint64
+}
+
+func (*testChainService) ProcessGenesisData(
+	context.Context,
+	*genesis.Genesis[*types.Deposit, *types.ExecutionPayloadHeaderDeneb],
+) ([]*transition.ValidatorUpdate, error) {
+	return nil, nil
+}
+
+func (s *testChainService) ImportBlocks(
+	_ context.Context, blks []*testBlock, _ []*testSidecars,
+) ([]*transition.ValidatorUpdate, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, blk := range blks {
+		s.processed = append(s.processed, blk.slot)
+	}
+	return nil, nil
+}
+
+func (*testChainService) ReceiveBlockAndBlobs(
+	context.Context, *testBlock, *testSidecars,
+) error {
+	return nil
+}
+
+func (*testChainService) SetSyncTarget(math.Slot) {}
+
+func (s *testChainService) Processed() []uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]uint64(nil), s.processed...)
+}
+
+type noopTelemetrySink struct{}
+
+func (noopTelemetrySink) MeasureSince(string, time.Time, ...string) {}
+
+// finalizeBlock drives the finalization of the block at the given height
+// through the middleware, as the base app does for FinalizeBlock.
+func finalizeBlock(
+	h *middleware.FinalizeBlockMiddleware[
+		*testBlock, any, *testSidecars,
+	],
+	height uint64,
+) error {
+	blk := binary.LittleEndian.AppendUint64(nil, height)
+	ctx := sdk.Context{}.WithContext(context.Background())
+	if err := h.PreBlock(ctx, &cometabci.FinalizeBlockRequest{
+		Height: int64(height),
+		Txs:    [][]byte{blk, {}},
+	}); err != nil {
+		return err
+	}
+	_, err := h.EndBlock(ctx)
+	return err
+}
+
+func TestPauserHoldsFinalizeBlock(t *testing.T) {
+	var (
+		chainService = new(testChainService)
+		pauser       = middleware.NewPauser(1)
+		h            = middleware.NewFinalizeBlockMiddleware[
+			*testBlock, any, *testSidecars,
+		](
+			chain.NewChainSpec(chain.SpecData[
+				common.DomainType, math.Epoch,
+				common.ExecutionAddress, math.Slot, any,
+			]{
+				SlotsPerEpoch: 32,
+			}),
+			chainService,
+			noopTelemetrySink{},
+			pauser,
+		)
+	)
+
+	require.NoError(t, finalizeBlock(h, 1))
+	require.Equal(t, []uint64{1}, chainService.Processed())
+
+	// CometBFT delivers the next block once the previous one is finalized,
+	// so the block delivered while paused holds consensus until resumed.
+	pauser.Pause()
+	require.True(t, pauser.Paused())
+	done := make(chan error)
+	go func() {
+		done <- finalizeBlock(h, 2)
+	}()
+	require.Eventually(t, pauser.Waiting, time.Second, time.Millisecond)
+	select {
+	case err := <-done:
+		t.Fatalf("block finalized while paused: %v", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+	require.Equal(t, []uint64{1}, chainService.Processed())
+
+	// The block is finalized without an error once resumed.
+	pauser.Resume()
+	require.NoError(t, <-done)
+	require.False(t, pauser.Paused())
+	require.False(t, pauser.Waiting())
+	require.Equal(t, []uint64{1, 2}, chainService.Processed())
+
+	require.NoError(t, finalizeBlock(h, 3))
+	require.Equal(t, []uint64{1, 2, 3}, chainService.Processed())
+}
+
+func TestPauserWaitCancelled(t *testing.T) {
+	pauser := middleware.NewPauser(1)
+	pauser.Pause()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- pauser.Wait(ctx)
+	}()
+	require.Eventually(t, pauser.Waiting, time.Second, time.Millisecond)
+
+	cancel()
+	require.ErrorIs(t, <-done, context.Canceled)
+	require.False(t, pauser.Waiting())
+	require.True(t, pauser.Paused())
+}
+
+func TestPauserBuffersBlocks(t *testing.T) {
+	const capacity = 2
+	var (
+		pauser  = middleware.NewPauser(capacity)
+		mu      sync.Mutex
+		applied []int
+		done    = make(chan error, 3)
+	)
+	apply := func(i int) {
+		done <- pauser.Apply(context.Background(), func() error {
+			mu.Lock()
+			defer mu.Unlock()
+			applied = append(applied, i)
+			return nil
+		})
+	}
+
+	// The blocks delivered while paused are buffered in order, and the
+	// delivery of the block beyond the capacity waits for room.
+	pauser.Pause()
+	for i := range capacity {
+		go apply(i)
+		require.Eventually(t, func() bool {
+			return pauser.Buffered() == i+1
+		}, time.Second, time.Millisecond)
+	}
+	go apply(capacity)
+	time.Sleep(50 * time.Millisecond)
+	require.Equal(t, capacity, pauser.Buffered())
+	mu.Lock()
+	require.Empty(t, applied)
+	mu.Unlock()
+
+	// The blocks are applied in the order they were delivered once resumed.
+	pauser.Resume()
+	for range capacity + 1 {
+		require.NoError(t, <-done)
+	}
+	require.Equal(t, []int{0, 1, 2}, applied)
+	require.Zero(t, pauser.Buffered())
+}
+
+func TestPauserClose(t *testing.T) {
+	var (
+		chainService = new(testChainService)
+		pauser       = middleware.NewPauser(1)
+		h            = middleware.NewFinalizeBlockMiddleware[
+			*testBlock, any, *testSidecars,
+		](
+			chain.NewChainSpec(chain.SpecData[
+				common.DomainType, math.Epoch,
+				common.ExecutionAddress, math.Slot, any,
+			]{
+				SlotsPerEpoch: 32,
+			}),
+			chainService,
+			noopTelemetrySink{},
+			pauser,
+		)
+	)
+
+	pauser.Pause()
+	finalized := make(chan error)
+	go func() {
+		finalized <- finalizeBlock(h, 1)
+	}()
+	waited := make(chan error)
+	go func() {
+		waited <- pauser.Wait(context.Background())
+	}()
+	require.Eventually(t, func() bool {
+		return pauser.Buffered() == 1
+	}, time.Second, time.Millisecond)
+
+	// Closing the pauser releases the block held, without applying it, and
+	// the proposal held, such that the node can shut down.
+	pauser.Close()
+	require.ErrorIs(t, <-finalized, middleware.ErrPauserClosed)
+	require.ErrorIs(t, <-waited, middleware.ErrPauserClosed)
+	require.Empty(t, chainService.Processed())
+	require.ErrorIs(t, finalizeBlock(h, 1), middleware.ErrPauserClosed)
+
+	// Blocks are still applied once resumed.
+	pauser.Resume()
+	require.NoError(t, finalizeBlock(h, 1))
+	require.Equal(t, []uint64{1}, chainService.Processed())
+}
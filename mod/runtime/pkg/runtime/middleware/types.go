@@ -46,12 +46,12 @@ type BlockchainService[
 			*types.Deposit, *types.ExecutionPayloadHeaderDeneb,
 		],
 	) ([]*transition.ValidatorUpdate, error)
-	// ProcessBlockAndBlobs processes the given beacon block and associated
-	// blobs sidecars.
-	ProcessBlockAndBlobs(
+	// ImportBlocks verifies the blob sidecars of the given beacon blocks and
+	// processes the blocks in order.
+	ImportBlocks(
 		context.Context,
-		BeaconBlockT,
-		BlobSidecarsT,
+		[]BeaconBlockT,
+		[]BlobSidecarsT,
 	) ([]*transition.ValidatorUpdate, error)
 
 	// ReceiveBlockAndBlobs receives a beacon block and
@@ -61,6 +61,8 @@ type BlockchainService[
 		blk BeaconBlockT,
 		blobs BlobSidecarsT,
 	) error
+	// SetSyncTarget sets the slot of the chain head the node is syncing to.
+	SetSyncTarget(math.Slot)
 }
 
 // ValidatorService is responsible for building beacon blocks.
@@ -94,6 +94,10 @@ type ValidatorMiddleware[
 
 	// storageBackend is the storage backend.
 	storageBackend StorageBackend[BeaconStateT]
+
+	// pauser holds back the proposal of blocks while block processing is
+	// paused.
+	pauser *Pauser
 }
 
 // NewValidatorMiddleware creates a new instance of the Handler struct.
@@ -130,6 +134,7 @@ func NewValidatorMiddleware[
 	chainService BlockchainService[BeaconBlockT, BlobSidecarsT],
 	telemetrySink TelemetrySink,
 	storageBackend StorageBackendT,
+	pauser *Pauser,
 ) *ValidatorMiddleware[
 	AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT,
 	BeaconStateT, BlobSidecarsT, StorageBackendT,
@@ -149,11 +154,13 @@ func NewValidatorMiddleware[
 		),
 		metrics:        newValidatorMiddlewareMetrics(telemetrySink),
 		storageBackend: storageBackend,
+		pauser:         pauser,
 	}
 }
 
 // PrepareProposalHandler is a wrapper around the prepare proposal handler
-// that injects the beacon block into the proposal.
+// that injects the beacon block into the proposal. While block processing is
+// paused, consensus is held until it is resumed before preparing a proposal.
 func (h *ValidatorMiddleware[
 	AvailabilityStoreT,
 	BeaconBlockT,
@@ -165,6 +172,11 @@ func (h *ValidatorMiddleware[
 	ctx sdk.Context,
 	req *cmtabci.PrepareProposalRequest,
 ) (*cmtabci.PrepareProposalResponse, error) {
+	// Hold consensus while block processing is paused.
+	if err := h.pauser.Wait(ctx); err != nil {
+		return nil, err
+	}
+
 	var (
 		startTime     = time.Now()
 		sidecarsBz    []byte
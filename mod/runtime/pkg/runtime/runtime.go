@@ -23,11 +23,12 @@ package runtime
 import (
 	"context"
 
-	"github.com/berachain/beacon-kit/mod/beacon/blockchain"
+	"github.com/berachain/beacon-kit/mod/async/pkg/event"
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/feed"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/runtime/pkg/runtime/middleware"
 	"github.com/berachain/beacon-kit/mod/runtime/pkg/service"
@@ -76,6 +77,8 @@ type BeaconKitRuntime[
 ] struct {
 	// logger is used for logging within the BeaconKitRuntime.
 	logger log.Logger[any]
+	// pauser pauses and resumes the processing of blocks.
+	pauser *middleware.Pauser
 	// services is a registry of services used by the BeaconKitRuntime.
 	services *service.Registry
 	// storageBackend is the backend storage interface used by the
@@ -83,6 +86,9 @@ type BeaconKitRuntime[
 	storageBackend StorageBackendT
 	// chainSpec defines the chain specifications for the BeaconKitRuntime.
 	chainSpec primitives.ChainSpec
+	// blockFeed is the feed of the blocks processed by the node, which
+	// block subscriptions are served from.
+	blockFeed *event.FeedOf[feed.EventID, *feed.Event[BeaconBlockT]]
 	// abciFinalizeBlockMiddleware handles ABCI interactions for the
 	// BeaconKitRuntime.
 	abciFinalizeBlockMiddleware *middleware.FinalizeBlockMiddleware[
@@ -119,20 +125,18 @@ func NewBeaconKitRuntime[
 	],
 	BlobSidecarsT BlobSidecars,
 	DepositStoreT DepositStore,
-	StorageBackendT blockchain.StorageBackend[
-		AvailabilityStoreT,
-		BeaconBlockBodyT,
-		BeaconStateT,
-		BlobSidecarsT,
-		*types.Deposit,
-		DepositStoreT,
+	StorageBackendT StorageBackend[
+		AvailabilityStoreT, BeaconBlockBodyT,
+		BeaconStateT, BlobSidecarsT, DepositStoreT,
 	],
 ](
+	blockFeed *event.FeedOf[feed.EventID, *feed.Event[BeaconBlockT]],
 	chainSpec primitives.ChainSpec,
 	finalizeBlockMiddleware *middleware.FinalizeBlockMiddleware[
 		BeaconBlockT, BeaconStateT, BlobSidecarsT,
 	],
 	logger log.Logger[any],
+	pauser *middleware.Pauser,
 	services *service.Registry,
 	storageBackend StorageBackendT,
 	validatorMiddleware *middleware.ValidatorMiddleware[
@@ -149,8 +153,10 @@ func NewBeaconKitRuntime[
 	]{
 		abciFinalizeBlockMiddleware: finalizeBlockMiddleware,
 		abciValidatorMiddleware:     validatorMiddleware,
+		blockFeed:                   blockFeed,
 		chainSpec:                   chainSpec,
 		logger:                      logger,
+		pauser:                      pauser,
 		services:                    services,
 		storageBackend:              storageBackend,
 	}, nil
@@ -166,6 +172,64 @@ func (r *BeaconKitRuntime[
 	return r.services.StartAll(ctx)
 }
 
+// Pause pauses the processing of blocks, for instance for a coordinated
+// upgrade. While paused, the node does not propose blocks, and the blocks
+// delivered to it are buffered until processing is resumed.
+func (r *BeaconKitRuntime[
+	AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BeaconStateT,
+	BlobSidecarsT, DepositStoreT, StorageBackendT,
+]) Pause() {
+	r.pauser.Pause()
+	r.logger.Info("paused block processing")
+}
+
+// Resume resumes the processing of blocks, applying the blocks buffered
+// while paused in order.
+func (r *BeaconKitRuntime[
+	AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BeaconStateT,
+	BlobSidecarsT, DepositStoreT, StorageBackendT,
+]) Resume() {
+	buffered := r.pauser.Buffered()
+	r.pauser.Resume()
+	r.logger.Info("resumed block processing", "buffered", buffered)
+}
+
+// Shutdown releases the blocks and proposals held while block processing is
+// paused, such that the node can shut down while paused. The blocks released
+// are not applied, and are delivered again once the node restarts.
+func (r *BeaconKitRuntime[
+	AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BeaconStateT,
+	BlobSidecarsT, DepositStoreT, StorageBackendT,
+]) Shutdown() {
+	r.pauser.Close()
+}
+
+// Paused reports whether the processing of blocks is paused.
+func (r *BeaconKitRuntime[
+	AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BeaconStateT,
+	BlobSidecarsT, DepositStoreT, StorageBackendT,
+]) Paused() bool {
+	return r.pauser.Paused()
+}
+
+// StorageBackend returns the storage backend of the runtime.
+func (r *BeaconKitRuntime[
+	AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BeaconStateT,
+	BlobSidecarsT, DepositStoreT, StorageBackendT,
+]) StorageBackend() StorageBackendT {
+	return r.storageBackend
+}
+
+// EarliestAvailableBlobSlot returns the earliest slot whose blob sidecars are
+// still available at the given head slot, as described by
+// primitives.EarliestAvailableBlobSlot.
+func (r *BeaconKitRuntime[
+	AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BeaconStateT,
+	BlobSidecarsT, DepositStoreT, StorageBackendT,
+]) EarliestAvailableBlobSlot(head math.Slot) math.Slot {
+	return primitives.EarliestAvailableBlobSlot(r.chainSpec, head)
+}
+
 // ABCIFinalizeBlockMiddleware returns the ABCI handler.
 func (r *BeaconKitRuntime[
 	AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BeaconStateT,
@@ -0,0 +1,124 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package runtime_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/runtime"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeAvailabilityStore is an availability store that holds no sidecars and
+// reports the data of every block as available if available is set.
+type fakeAvailabilityStore struct {
+	available bool
+}
+
+func (f *fakeAvailabilityStore) IsDataAvailable(
+	context.Context, math.Slot, *types.BeaconBlockBody,
+) bool {
+	return f.available
+}
+
+func (*fakeAvailabilityStore) Persist(math.Slot, runtime.BlobSidecars) error {
+	return nil
+}
+
+// fakeBlobStore only implements runtime.BlobStore.
+type fakeBlobStore struct {
+	availability *fakeAvailabilityStore
+}
+
+func (f fakeBlobStore) AvailabilityStore(
+	context.Context,
+) *fakeAvailabilityStore {
+	return f.availability
+}
+
+// fakeStateStore only implements runtime.StateStore.
+type fakeStateStore struct {
+	state runtime.BeaconState
+}
+
+func (f fakeStateStore) StateFromContext(context.Context) runtime.BeaconState {
+	return f.state
+}
+
+// fakeDepositStore serves the deposits of a slice indexed by their index.
+type fakeDepositStore []*types.Deposit
+
+func (f fakeDepositStore) GetDepositsByIndex(
+	startIndex, numView uint64,
+) ([]*types.Deposit, error) {
+	end := min(startIndex+numView, uint64(len(f)))
+	if startIndex >= end {
+		return nil, nil
+	}
+	return f[startIndex:end], nil
+}
+
+// fakeDepositReader only implements runtime.DepositReader.
+type fakeDepositReader struct {
+	deposits fakeDepositStore
+}
+
+func (f fakeDepositReader) DepositStore(context.Context) fakeDepositStore {
+	return f.deposits
+}
+
+// fakeStorageBackend is a storage backend made of the three fakes, each
+// implementing one of the segregated interfaces only.
+type fakeStorageBackend struct {
+	fakeBlobStore
+	fakeStateStore
+	fakeDepositReader
+}
+
+func TestSegregatedStorageBackend(t *testing.T) {
+	backend := fakeStorageBackend{
+		fakeBlobStore: fakeBlobStore{
+			availability: &fakeAvailabilityStore{available: true},
+		},
+		fakeDepositReader: fakeDepositReader{
+			deposits: fakeDepositStore{{Index: 0}, {Index: 1}},
+		},
+	}
+
+	r, err := runtime.NewBeaconKitRuntime[
+		*fakeAvailabilityStore, *types.BeaconBlock, *types.BeaconBlockBody,
+		runtime.BeaconState, runtime.BlobSidecars, fakeDepositStore,
+		fakeStorageBackend,
+	](nil, nil, nil, noop.NewLogger(), nil, nil, backend, nil)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	storage := r.StorageBackend()
+	require.Same(t, backend.availability, storage.AvailabilityStore(ctx))
+	require.Nil(t, storage.StateFromContext(ctx))
+	deposits, err := storage.DepositStore(ctx).GetDepositsByIndex(1, 2)
+	require.NoError(t, err)
+	require.Equal(t, []*types.Deposit{{Index: 1}}, deposits)
+}
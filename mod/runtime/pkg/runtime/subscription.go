@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package runtime
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/feed"
+)
+
+// BlockSubscriptionBufferSize is the number of finalized blocks buffered for
+// a block subscription before the oldest of them are dropped.
+const BlockSubscriptionBufferSize = 32
+
+// SubscribeBlocks returns a channel on which every block is sent once it is
+// finalized, in the order the blocks are finalized. Up to
+// BlockSubscriptionBufferSize blocks are buffered, after which the oldest
+// buffered block is dropped for every new one, such that a slow consumer
+// never stalls the node. The channel is closed once the given context is
+// done.
+func (r *BeaconKitRuntime[
+	AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BeaconStateT,
+	BlobSidecarsT, DepositStoreT, StorageBackendT,
+]) SubscribeBlocks(ctx context.Context) (<-chan BeaconBlockT, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if r.blockFeed == nil {
+		return nil, ErrNoBlockFeed
+	}
+
+	ch := make(chan *feed.Event[BeaconBlockT])
+	sub := r.blockFeed.Subscribe(ch)
+	blocks := make(chan BeaconBlockT, BlockSubscriptionBufferSize)
+	go func() {
+		defer close(blocks)
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-sub.Err():
+				return
+			case event := <-ch:
+				if event.Is(events.BeaconBlockFinalized) {
+					r.sendBlock(blocks, event.Data())
+				}
+			}
+		}
+	}()
+	return blocks, nil
+}
+
+// sendBlock sends the block on the given channel, dropping the oldest block
+// buffered on the channel if it is full.
+func (r *BeaconKitRuntime[
+	AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BeaconStateT,
+	BlobSidecarsT, DepositStoreT, StorageBackendT,
+]) sendBlock(blocks chan BeaconBlockT, blk BeaconBlockT) {
+	for {
+		select {
+		case blocks <- blk:
+			return
+		default:
+		}
+		select {
+		case dropped := <-blocks:
+			r.logger.Warn(
+				"dropping block from slow block subscriber",
+				"slot", dropped.GetSlot(),
+			)
+		default:
+		}
+	}
+}
@@ -0,0 +1,161 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package runtime_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/async/pkg/event"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/feed"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	"github.com/berachain/beacon-kit/mod/runtime/pkg/runtime"
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	testAvailabilityStore = runtime.AvailabilityStore[
+		*types.BeaconBlockBody, runtime.BlobSidecars,
+	]
+	testStorageBackend = runtime.StorageBackend[
+		testAvailabilityStore, *types.BeaconBlockBody,
+		runtime.BeaconState, runtime.BlobSidecars, runtime.DepositStore,
+	]
+	testBlockFeed = event.FeedOf[feed.EventID, *feed.Event[*types.BeaconBlock]]
+	testRuntime   = runtime.BeaconKitRuntime[
+		testAvailabilityStore, *types.BeaconBlock, *types.BeaconBlockBody,
+		runtime.BeaconState, runtime.BlobSidecars, runtime.DepositStore,
+		testStorageBackend,
+	]
+)
+
+// newTestRuntime returns a runtime that only serves block subscriptions from
+// the given feed.
+func newTestRuntime(t *testing.T, blockFeed *testBlockFeed) *testRuntime {
+	t.Helper()
+	r, err := runtime.NewBeaconKitRuntime[
+		testAvailabilityStore, *types.BeaconBlock, *types.BeaconBlockBody,
+		runtime.BeaconState, runtime.BlobSidecars, runtime.DepositStore,
+		testStorageBackend,
+	](blockFeed, nil, nil, noop.NewLogger(), nil, nil, nil, nil)
+	require.NoError(t, err)
+	return r
+}
+
+// sendBlock sends a block with the given slot on the feed as an event of the
+// given type.
+func sendBlock(
+	t *testing.T,
+	blockFeed *testBlockFeed,
+	eventType feed.EventID,
+	slot math.Slot,
+) {
+	t.Helper()
+	blk, err := (&types.BeaconBlock{}).NewWithVersion(
+		slot, 0, [32]byte{1}, version.Deneb,
+	)
+	require.NoError(t, err)
+	blockFeed.Send(feed.NewEvent(context.Background(), eventType, blk))
+}
+
+// receiveBlock receives a block from the given channel, failing the test if
+// none is received in time.
+func receiveBlock(
+	t *testing.T,
+	blocks <-chan *types.BeaconBlock,
+) *types.BeaconBlock {
+	t.Helper()
+	select {
+	case blk, ok := <-blocks:
+		require.True(t, ok, "channel closed")
+		return blk
+	case <-time.After(time.Second):
+		require.FailNow(t, "no block received")
+		return nil
+	}
+}
+
+func TestSubscribeBlocks(t *testing.T) {
+	blockFeed := &testBlockFeed{}
+	r := newTestRuntime(t, blockFeed)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	blocks, err := r.SubscribeBlocks(ctx)
+	require.NoError(t, err)
+
+	sendBlock(t, blockFeed, events.BeaconBlockFinalized, 1)
+	sendBlock(t, blockFeed, events.BeaconBlockAccepted, 2)
+	sendBlock(t, blockFeed, events.BeaconBlockFinalized, 3)
+	sendBlock(t, blockFeed, events.BeaconBlockFinalized, 4)
+
+	// Only finalized blocks are emitted, in order.
+	for _, slot := range []math.Slot{1, 3, 4} {
+		require.Equal(t, slot, receiveBlock(t, blocks).GetSlot())
+	}
+
+	cancel()
+	select {
+	case _, ok := <-blocks:
+		require.False(t, ok)
+	case <-time.After(time.Second):
+		require.FailNow(t, "channel not closed")
+	}
+}
+
+func TestSubscribeBlocksDropsOldest(t *testing.T) {
+	blockFeed := &testBlockFeed{}
+	r := newTestRuntime(t, blockFeed)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	blocks, err := r.SubscribeBlocks(ctx)
+	require.NoError(t, err)
+
+	const dropped = 3
+	n := math.Slot(runtime.BlockSubscriptionBufferSize + dropped)
+	for slot := math.Slot(1); slot <= n; slot++ {
+		sendBlock(t, blockFeed, events.BeaconBlockFinalized, slot)
+	}
+	// The feed only returns once the subscription received the event, by
+	// which point all prior blocks have been buffered.
+	sendBlock(t, blockFeed, events.BeaconBlockAccepted, n+1)
+
+	require.Len(t, blocks, runtime.BlockSubscriptionBufferSize)
+	for slot := math.Slot(dropped + 1); slot <= n; slot++ {
+		require.Equal(t, slot, receiveBlock(t, blocks).GetSlot())
+	}
+}
+
+func TestSubscribeBlocksCanceledContext(t *testing.T) {
+	r := newTestRuntime(t, &testBlockFeed{})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := r.SubscribeBlocks(ctx)
+	require.ErrorIs(t, err, context.Canceled)
+
+	_, err = newTestRuntime(t, nil).SubscribeBlocks(context.Background())
+	require.ErrorIs(t, err, runtime.ErrNoBlockFeed)
+}
@@ -49,7 +49,9 @@ type AvailabilityStore[BeaconBlockBodyT, BlobSidecarsT any] interface {
 }
 
 // StorageBackend defines an interface for accessing various storage components
-// required by the beacon node.
+// required by the beacon node. It is made of the narrower BlobStore,
+// StateStore and DepositReader interfaces, such that the components that only
+// need one of the stores depend on that store alone.
 type StorageBackend[
 	AvailabilityStoreT AvailabilityStore[BeaconBlockBodyT, BlobSidecarsT],
 	BeaconBlockBodyT,
@@ -57,10 +59,25 @@ type StorageBackend[
 	BlobSidecarsT any,
 	DepositStoreT DepositStore,
 ] interface {
+	BlobStore[AvailabilityStoreT]
+	StateStore[BeaconStateT]
+	DepositReader[DepositStoreT]
+}
+
+// BlobStore provides the availability store the blob sidecars are kept in.
+type BlobStore[AvailabilityStoreT any] interface {
 	// AvailabilityStore returns the availability store for the given context.
 	AvailabilityStore(context.Context) AvailabilityStoreT
+}
+
+// StateStore provides the beacon state.
+type StateStore[BeaconStateT any] interface {
 	// StateFromContext retrieves the beacon state from the given context.
 	StateFromContext(context.Context) BeaconStateT
+}
+
+// DepositReader provides the deposit store the deposits are read from.
+type DepositReader[DepositStoreT DepositStore] interface {
 	// DepositStore returns the deposit store for the given context.
 	DepositStore(context.Context) DepositStoreT
 }
@@ -79,8 +96,6 @@ type DepositStore interface {
 		startIndex uint64,
 		numView uint64,
 	) ([]*types.Deposit, error)
-	EnqueueDeposits(deposits []*types.Deposit) error
-	Prune(index uint64, numPrune uint64) error
 }
 
 // Service is a struct that can be registered into a ServiceRegistry for
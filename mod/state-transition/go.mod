@@ -11,6 +11,8 @@ require (
 	github.com/go-faster/xor v1.0.0
 	github.com/minio/sha256-simd v1.0.1
 	github.com/sourcegraph/conc v0.3.0
+	go.opentelemetry.io/otel v1.24.0
+	go.opentelemetry.io/otel/trace v1.24.0
 	golang.org/x/sync v0.7.0
 )
 
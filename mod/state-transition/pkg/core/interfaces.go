@@ -22,6 +22,7 @@ package core
 
 import (
 	"context"
+	"io"
 
 	"github.com/berachain/beacon-kit/mod/primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
@@ -36,6 +37,8 @@ type BeaconState[
 	Eth1DataT, ExecutionPayloadHeaderT, ForkT,
 	ValidatorT, WithdrawalT any,
 ] interface {
+	// Copy returns a copy of the state that can be mutated without affecting
+	// the original, until the copy is saved.
 	Copy() BeaconState[
 		BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT, ForkT,
 		ValidatorT, WithdrawalT,
@@ -43,6 +46,13 @@ type BeaconState[
 	Save()
 	Context() context.Context
 	HashTreeRoot() ([32]byte, error)
+	MarshalSSZ() ([]byte, error)
+	// MarshalSSZTo writes the SSZ encoding of the state to the writer.
+	MarshalSSZTo(w io.Writer) error
+	// UnmarshalSSZFrom reads the SSZ encoding of a state from the reader
+	// into the state, which must not hold any validators yet.
+	UnmarshalSSZFrom(r io.Reader) error
+	MarshalJSON() ([]byte, error)
 	ReadOnlyBeaconState[
 		BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
 		ValidatorT, WithdrawalT,
@@ -65,6 +75,7 @@ type ReadOnlyBeaconState[
 	ReadOnlyWithdrawals[WithdrawalT]
 
 	GetBalance(math.ValidatorIndex) (math.Gwei, error)
+	GetBalances() ([]uint64, error)
 	GetSlot() (math.Slot, error)
 	GetGenesisValidatorsRoot() (primitives.Root, error)
 	GetBlockRootAtIndex(uint64) (primitives.Root, error)
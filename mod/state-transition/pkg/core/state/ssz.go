@@ -0,0 +1,194 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package state
+
+import (
+	"io"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/state/deneb"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+var (
+	// ErrStateNotEmpty is returned when a beacon state is decoded into a
+	// state that already holds validators.
+	ErrStateNotEmpty = errors.New("beacon state is not empty")
+
+	// ErrBalancesMismatch is returned when a decoded beacon state does not
+	// hold a balance for each of its validators.
+	ErrBalancesMismatch = errors.New(
+		"number of balances does not match number of validators",
+	)
+)
+
+// MarshalSSZTo writes the SSZ encoding of the beacon state to w. The bytes
+// written are the same as those of MarshalSSZ, but the encoding of the whole
+// state is never held in memory.
+func (s *StateDB[
+	BeaconStateT, KVStoreT, ForkT,
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ValidatorT, WithdrawalCredentialsT,
+]) MarshalSSZTo(w io.Writer) error {
+	st, err := s.GetMarshallable()
+	if err != nil {
+		return err
+	}
+	return st.MarshalSSZToWriter(w)
+}
+
+// UnmarshalSSZFrom reads the SSZ encoding of a beacon state, as written by
+// MarshalSSZTo, from r into the beacon state, which must not hold any
+// validators yet. The encoding of the whole state is never held in memory.
+func (s *StateDB[
+	BeaconStateT, KVStoreT, ForkT,
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ValidatorT, WithdrawalCredentialsT,
+]) UnmarshalSSZFrom(r io.Reader) error {
+	total, err := s.GetTotalValidators()
+	if err != nil {
+		return err
+	} else if total > 0 {
+		return ErrStateNotEmpty
+	}
+
+	st := new(deneb.BeaconState)
+	if err = st.UnmarshalSSZFromReader(r); err != nil {
+		return err
+	}
+	return s.setMarshallable(st)
+}
+
+// setMarshallable writes every field of the given beacon state to the store.
+//
+//nolint:funlen,gocognit // todo fix somehow
+func (s *StateDB[
+	BeaconStateT, KVStoreT, ForkT,
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ValidatorT, WithdrawalCredentialsT,
+]) setMarshallable(st *deneb.BeaconState) error {
+	if len(st.Balances) != len(st.Validators) {
+		return ErrBalancesMismatch
+	}
+
+	fork, err := castTo[ForkT](st.Fork)
+	if err != nil {
+		return err
+	}
+	latestBlockHeader, err := castTo[BeaconBlockHeaderT](st.LatestBlockHeader)
+	if err != nil {
+		return err
+	}
+	eth1Data, err := castTo[Eth1DataT](st.Eth1Data)
+	if err != nil {
+		return err
+	}
+	latestExecutionPayloadHeader, err := castTo[ExecutionPayloadHeaderT](
+		&types.ExecutionPayloadHeader{
+			InnerExecutionPayloadHeader: st.LatestExecutionPayloadHeader,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	if err = s.SetGenesisValidatorsRoot(st.GenesisValidatorsRoot); err != nil {
+		return err
+	}
+	if err = s.SetSlot(st.Slot); err != nil {
+		return err
+	}
+	if err = s.SetFork(fork); err != nil {
+		return err
+	}
+	if err = s.SetLatestBlockHeader(latestBlockHeader); err != nil {
+		return err
+	}
+	for i, root := range st.BlockRoots {
+		if err = s.UpdateBlockRootAtIndex(uint64(i), root); err != nil {
+			return err
+		}
+	}
+	for i, root := range st.StateRoots {
+		if err = s.UpdateStateRootAtIndex(uint64(i), root); err != nil {
+			return err
+		}
+	}
+	if err = s.SetEth1Data(eth1Data); err != nil {
+		return err
+	}
+	if err = s.SetEth1DepositIndex(st.Eth1DepositIndex); err != nil {
+		return err
+	}
+	if err = s.SetLatestExecutionPayloadHeader(
+		latestExecutionPayloadHeader,
+	); err != nil {
+		return err
+	}
+
+	var val ValidatorT
+	for i, v := range st.Validators {
+		if val, err = castTo[ValidatorT](v); err != nil {
+			return err
+		}
+		if err = s.AddValidator(val); err != nil {
+			return err
+		}
+		if err = s.SetBalance(
+			math.ValidatorIndex(i), math.Gwei(st.Balances[i]),
+		); err != nil {
+			return err
+		}
+	}
+
+	for i, mix := range st.RandaoMixes {
+		if err = s.UpdateRandaoMixAtIndex(uint64(i), mix); err != nil {
+			return err
+		}
+	}
+	if err = s.SetNextWithdrawalIndex(st.NextWithdrawalIndex); err != nil {
+		return err
+	}
+	if err = s.SetNextWithdrawalValidatorIndex(
+		st.NextWithdrawalValidatorIndex,
+	); err != nil {
+		return err
+	}
+	for i, slashing := range st.Slashings {
+		if err = s.SetSlashingAtIndex(
+			uint64(i), math.Gwei(slashing),
+		); err != nil {
+			return err
+		}
+	}
+	return s.SetTotalSlashing(st.TotalSlashing)
+}
+
+// castTo converts the given field of a decoded beacon state to the type the
+// beacon state is instantiated with.
+func castTo[T any](v any) (T, error) {
+	t, ok := v.(T)
+	if !ok {
+		return t, errors.Newf("unexpected type %T of beacon state field", v)
+	}
+	return t, nil
+}
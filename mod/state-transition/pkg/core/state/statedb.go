@@ -21,6 +21,7 @@
 package state
 
 import (
+	"encoding/json"
 	"reflect"
 
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/state"
@@ -108,7 +109,9 @@ func NewBeaconStateFromDB[
 	return reflect.ValueOf(result).Interface().(BeaconStateT)
 }
 
-// Copy returns a copy of the beacon state.
+// Copy returns a copy of the beacon state that is safe to modify
+// independently. Writes to the copy are buffered in a branch of the
+// underlying store and are only applied to the original state on Save.
 func (s *StateDB[
 	BeaconStateT, KVStoreT, ForkT,
 	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
@@ -278,39 +281,46 @@ func (s *StateDB[
 	return withdrawals, nil
 }
 
-// HashTreeRoot is the interface for the beacon store.
+// GetMarshallable returns the beacon state in a form that can be marshalled,
+// which is built by reading every field of the beacon state from the store.
 //
 //nolint:funlen,gocognit // todo fix somehow
 func (s *StateDB[
 	BeaconStateT, KVStoreT, ForkT,
 	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
 	ValidatorT, WithdrawalCredentialsT,
-]) HashTreeRoot() ([32]byte, error) {
+]) GetMarshallable() (*state.BeaconState[
+	BeaconBlockHeaderT,
+	ExecutionPayloadHeaderT,
+	Eth1DataT,
+	ForkT,
+	ValidatorT,
+], error) {
 	slot, err := s.GetSlot()
 	if err != nil {
-		return [32]byte{}, err
+		return nil, err
 	}
 
 	fork, err := s.GetFork()
 	if err != nil {
-		return [32]byte{}, err
+		return nil, err
 	}
 
 	genesisValidatorsRoot, err := s.GetGenesisValidatorsRoot()
 	if err != nil {
-		return [32]byte{}, err
+		return nil, err
 	}
 
 	latestBlockHeader, err := s.GetLatestBlockHeader()
 	if err != nil {
-		return [32]byte{}, err
+		return nil, err
 	}
 
 	blockRoots := make([]primitives.Root, s.cs.SlotsPerHistoricalRoot())
 	for i := range s.cs.SlotsPerHistoricalRoot() {
 		blockRoots[i], err = s.GetBlockRootAtIndex(i)
 		if err != nil {
-			return [32]byte{}, err
+			return nil, err
 		}
 	}
 
@@ -318,65 +328,65 @@ func (s *StateDB[
 	for i := range s.cs.SlotsPerHistoricalRoot() {
 		stateRoots[i], err = s.StateRootAtIndex(i)
 		if err != nil {
-			return [32]byte{}, err
+			return nil, err
 		}
 	}
 
 	latestExecutionPayloadHeader, err := s.GetLatestExecutionPayloadHeader()
 	if err != nil {
-		return [32]byte{}, err
+		return nil, err
 	}
 
 	eth1Data, err := s.GetEth1Data()
 	if err != nil {
-		return [32]byte{}, err
+		return nil, err
 	}
 
 	eth1DepositIndex, err := s.GetEth1DepositIndex()
 	if err != nil {
-		return [32]byte{}, err
+		return nil, err
 	}
 
 	validators, err := s.GetValidators()
 	if err != nil {
-		return [32]byte{}, err
+		return nil, err
 	}
 
 	balances, err := s.GetBalances()
 	if err != nil {
-		return [32]byte{}, err
+		return nil, err
 	}
 
 	randaoMixes := make([]primitives.Bytes32, s.cs.EpochsPerHistoricalVector())
 	for i := range s.cs.EpochsPerHistoricalVector() {
 		randaoMixes[i], err = s.GetRandaoMixAtIndex(i)
 		if err != nil {
-			return [32]byte{}, err
+			return nil, err
 		}
 	}
 
 	nextWithdrawalIndex, err := s.GetNextWithdrawalIndex()
 	if err != nil {
-		return [32]byte{}, err
+		return nil, err
 	}
 
 	nextWithdrawalValidatorIndex, err := s.GetNextWithdrawalValidatorIndex()
 	if err != nil {
-		return [32]byte{}, err
+		return nil, err
 	}
 
 	slashings, err := s.GetSlashings()
 	if err != nil {
-		return [32]byte{}, err
+		return nil, err
 	}
 
 	totalSlashings, err := s.GetTotalSlashing()
 	if err != nil {
-		return [32]byte{}, err
+		return nil, err
 	}
 
 	// TODO: Properly move BeaconState into full generics.
-	st, err := new(state.BeaconState[
+	return new(state.BeaconState[
 		BeaconBlockHeaderT,
 		ExecutionPayloadHeaderT,
 		Eth1DataT,
@@ -401,8 +411,43 @@ func (s *StateDB[
 		slashings,
 		totalSlashings,
 	)
+}
+
+// HashTreeRoot is the interface for the beacon store.
+func (s *StateDB[
+	BeaconStateT, KVStoreT, ForkT,
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ValidatorT, WithdrawalCredentialsT,
+]) HashTreeRoot() ([32]byte, error) {
+	st, err := s.GetMarshallable()
 	if err != nil {
 		return [32]byte{}, err
 	}
 	return st.HashTreeRoot()
 }
+
+// MarshalSSZ returns the SSZ encoding of the beacon state.
+func (s *StateDB[
+	BeaconStateT, KVStoreT, ForkT,
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ValidatorT, WithdrawalCredentialsT,
+]) MarshalSSZ() ([]byte, error) {
+	st, err := s.GetMarshallable()
+	if err != nil {
+		return nil, err
+	}
+	return st.MarshalSSZ()
+}
+
+// MarshalJSON returns the JSON encoding of the beacon state.
+func (s *StateDB[
+	BeaconStateT, KVStoreT, ForkT,
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ValidatorT, WithdrawalCredentialsT,
+]) MarshalJSON() ([]byte, error) {
+	st, err := s.GetMarshallable()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(st)
+}
@@ -323,7 +323,7 @@ func (sp *StateProcessor[
 	// phase0.ProcessEth1Vote
 
 	// process the deposits and ensure they match the local state.
-	if err := sp.processOperations(st, blk); err != nil {
+	if err := sp.processOperations(ctx, st, blk); err != nil {
 		return err
 	}
 
@@ -36,6 +36,7 @@ func (sp *StateProcessor[
 	DepositT, Eth1DataT, ExecutionPayloadT, ExecutionPayloadHeaderT,
 	ForkT, ForkDataT, ValidatorT, WithdrawalT, WithdrawalCredentialsT,
 ]) processOperations(
+	ctx ContextT,
 	st BeaconStateT,
 	blk BeaconBlockT,
 ) error {
@@ -59,7 +60,11 @@ func (sp *StateProcessor[
 	// if uint64(len(deposits)) != depositCount {
 	// 	return errors.New("deposit count mismatch")
 	// }
-	return sp.processDeposits(st, deposits)
+	_, span := tracerFromContext(ctx).Start(ctx, SpanDepositApply)
+	defer span.End()
+	err = sp.processDeposits(st, deposits)
+	recordError(span, err)
+	return err
 }
 
 // ProcessDeposits processes the deposits and ensures they match the
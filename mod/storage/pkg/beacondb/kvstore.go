@@ -249,7 +249,8 @@ func New[
 	}
 }
 
-// Copy returns a copy of the Store.
+// Copy returns a copy of the Store backed by a cache branch of its context.
+// Writes to the copy are not visible to the Store until the copy is saved.
 func (kv *KVStore[
 	ForkT, BeaconBlockHeaderT, ExecutionPayloadT, Eth1DataT, ValidatorT,
 ]) Copy() *KVStore[
@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package block
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
+)
+
+// ServiceName is the name of the service persisting the finalized blocks.
+const ServiceName = "block-store"
+
+// Service persists the blocks of the chain to a block store as they are
+// finalized.
+type Service[
+	BeaconBlockT BeaconBlock[BeaconBlockT],
+	BlockEventT Event[BeaconBlockT],
+	SubscriptionT Subscription,
+] struct {
+	logger log.Logger[any]
+	store  *KVStore[BeaconBlockT]
+	feed   Feed[BeaconBlockT, BlockEventT, SubscriptionT]
+}
+
+// NewService creates a new service persisting the blocks finalized on the
+// given feed to the store.
+func NewService[
+	BeaconBlockT BeaconBlock[BeaconBlockT],
+	BlockEventT Event[BeaconBlockT],
+	SubscriptionT Subscription,
+](
+	logger log.Logger[any],
+	store *KVStore[BeaconBlockT],
+	feed Feed[BeaconBlockT, BlockEventT, SubscriptionT],
+) *Service[BeaconBlockT, BlockEventT, SubscriptionT] {
+	return &Service[BeaconBlockT, BlockEventT, SubscriptionT]{
+		logger: logger,
+		store:  store,
+		feed:   feed,
+	}
+}
+
+// Name returns the name of the service.
+func (s *Service[BeaconBlockT, BlockEventT, SubscriptionT]) Name() string {
+	return ServiceName
+}
+
+// Status returns nil, as the service has no health to report.
+func (s *Service[BeaconBlockT, BlockEventT, SubscriptionT]) Status() error {
+	return nil
+}
+
+// Start subscribes to the block feed and persists every finalized block
+// until the context is done.
+func (s *Service[BeaconBlockT, BlockEventT, SubscriptionT]) Start(
+	ctx context.Context,
+) error {
+	ch := make(chan BlockEventT)
+	sub := s.feed.Subscribe(ch)
+	go func() {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-ch:
+				if event.Is(events.BeaconBlockFinalized) {
+					s.persist(event.Data())
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+// persist stores the block, logging any failure to do so.
+func (s *Service[BeaconBlockT, BlockEventT, SubscriptionT]) persist(
+	blk BeaconBlockT,
+) {
+	if err := s.store.Set(blk); err != nil {
+		s.logger.Error(
+			"failed to persist finalized block",
+			"slot", blk.GetSlot(),
+			"error", err,
+		)
+	}
+}
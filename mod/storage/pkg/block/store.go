@@ -0,0 +1,167 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package block
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+
+	sdkcollections "cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+const (
+	// KeyBlockPrefix is the name of the map of the blocks by slot.
+	KeyBlockPrefix = "block"
+	// KeyRootPrefix is the name of the map of the slots by block root.
+	KeyRootPrefix = "root"
+)
+
+// KVStoreProvider is a store service backed by a single database.
+type KVStoreProvider struct {
+	store.KVStoreWithBatch
+}
+
+// OpenKVStore opens a new KV store.
+func (p *KVStoreProvider) OpenKVStore(context.Context) store.KVStore {
+	return p.KVStoreWithBatch
+}
+
+// KVStore keeps the history of the blocks of the chain. The blocks are stored
+// by slot in their SSZ encoding, along with an index of their roots to their
+// slots.
+type KVStore[BeaconBlockT BeaconBlock[BeaconBlockT]] struct {
+	cs     ChainSpec
+	blocks sdkcollections.Map[uint64, []byte]
+	roots  sdkcollections.Map[[]byte, uint64]
+	mu     sync.RWMutex
+	// closer releases the database of the store, it is nil if the store
+	// service does not hold any resources.
+	closer io.Closer
+}
+
+// NewStore creates a new block store. The fork version the blocks are
+// decoded with is read from the given chain spec.
+func NewStore[BeaconBlockT BeaconBlock[BeaconBlockT]](
+	kvsp store.KVStoreService,
+	cs ChainSpec,
+) *KVStore[BeaconBlockT] {
+	schemaBuilder := sdkcollections.NewSchemaBuilder(kvsp)
+	closer, _ := kvsp.(io.Closer)
+	return &KVStore[BeaconBlockT]{
+		cs: cs,
+		blocks: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{uint8(0)}),
+			KeyBlockPrefix,
+			sdkcollections.Uint64Key,
+			sdkcollections.BytesValue,
+		),
+		roots: sdkcollections.NewMap(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{uint8(1)}),
+			KeyRootPrefix,
+			sdkcollections.BytesKey,
+			sdkcollections.Uint64Value,
+		),
+		closer: closer,
+	}
+}
+
+// Set stores the block, replacing any block previously stored at its slot.
+func (kv *KVStore[BeaconBlockT]) Set(blk BeaconBlockT) error {
+	bz, err := blk.MarshalSSZ()
+	if err != nil {
+		return err
+	}
+	root, err := blk.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	slot := blk.GetSlot().Unwrap()
+	// The block is written before its root, such that a root in the index
+	// always resolves to a block.
+	if err = kv.blocks.Set(context.TODO(), slot, bz); err != nil {
+		return err
+	}
+	return kv.roots.Set(context.TODO(), root[:], slot)
+}
+
+// GetBySlot returns the block at the given slot, or ErrNotFound if the store
+// does not hold it.
+func (kv *KVStore[BeaconBlockT]) GetBySlot(
+	slot math.Slot,
+) (BeaconBlockT, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	return kv.getBySlot(slot)
+}
+
+// GetByRoot returns the block with the given root, or ErrNotFound if the
+// store does not hold it.
+func (kv *KVStore[BeaconBlockT]) GetByRoot(
+	root [32]byte,
+) (BeaconBlockT, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	slot, err := kv.roots.Get(context.TODO(), root[:])
+	if errors.Is(err, sdkcollections.ErrNotFound) {
+		var blk BeaconBlockT
+		return blk, ErrNotFound
+	}
+	if err != nil {
+		var blk BeaconBlockT
+		return blk, err
+	}
+	return kv.getBySlot(math.Slot(slot))
+}
+
+// getBySlot returns the block at the given slot.
+func (kv *KVStore[BeaconBlockT]) getBySlot(
+	slot math.Slot,
+) (BeaconBlockT, error) {
+	var blk BeaconBlockT
+	bz, err := kv.blocks.Get(context.TODO(), slot.Unwrap())
+	if errors.Is(err, sdkcollections.ErrNotFound) {
+		return blk, ErrNotFound
+	}
+	if err != nil {
+		return blk, err
+	}
+	return blk.NewFromSSZ(bz, kv.cs.ActiveForkVersionForSlot(slot))
+}
+
+// Close closes the database of the store, waiting for any in-flight
+// operation to complete first.
+func (kv *KVStore[BeaconBlockT]) Close() error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	if kv.closer == nil {
+		return nil
+	}
+	return kv.closer.Close()
+}
@@ -0,0 +1,220 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package block_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"testing"
+	"time"
+
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/events"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/feed"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/block"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetBySlot(t *testing.T) {
+	kv := newTestStore()
+	blk := &testBlock{Slot: 3, Data: 7}
+	require.NoError(t, kv.Set(blk))
+
+	got, err := kv.GetBySlot(3)
+	require.NoError(t, err)
+	require.Equal(t, blk, got)
+
+	_, err = kv.GetBySlot(4)
+	require.ErrorIs(t, err, block.ErrNotFound)
+}
+
+func TestGetByRoot(t *testing.T) {
+	kv := newTestStore()
+	blk := &testBlock{Slot: 3, Data: 7}
+	require.NoError(t, kv.Set(blk))
+	require.NoError(t, kv.Set(&testBlock{Slot: 4, Data: 8}))
+
+	root, err := blk.HashTreeRoot()
+	require.NoError(t, err)
+	got, err := kv.GetByRoot(root)
+	require.NoError(t, err)
+	require.Equal(t, blk, got)
+
+	_, err = kv.GetByRoot([32]byte{0x01})
+	require.ErrorIs(t, err, block.ErrNotFound)
+}
+
+func TestGetDecodesWithForkVersionOfSlot(t *testing.T) {
+	kv := block.NewStore[*testBlock](&block.KVStoreProvider{
+		KVStoreWithBatch: newMemKVStore(),
+	}, testChainSpec{forkSlot: 10})
+	require.NoError(t, kv.Set(&testBlock{Slot: 9}))
+	require.NoError(t, kv.Set(&testBlock{Slot: 10}))
+
+	_, err := kv.GetBySlot(9)
+	require.NoError(t, err)
+	_, err = kv.GetBySlot(10)
+	require.ErrorIs(t, err, errUnsupportedFork)
+}
+
+func TestServicePersistsFinalizedBlocks(t *testing.T) {
+	kv := newTestStore()
+	f := &testFeed{}
+	svc := block.NewService[
+		*testBlock, *feed.Event[*testBlock], block.Subscription,
+	](log.NewNopLogger(), kv, f)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, svc.Start(ctx))
+
+	f.send(feed.NewEvent(
+		ctx, events.BeaconBlockAccepted, &testBlock{Slot: 1},
+	))
+	f.send(feed.NewEvent(
+		ctx, events.BeaconBlockFinalized, &testBlock{Slot: 2},
+	))
+
+	require.Eventually(t, func() bool {
+		_, err := kv.GetBySlot(2)
+		return err == nil
+	}, time.Second, 10*time.Millisecond)
+	_, err := kv.GetBySlot(1)
+	require.ErrorIs(t, err, block.ErrNotFound)
+}
+
+// =============================== HELPERS ==================================
+
+// newTestStore returns a new block store backed by an in-memory kv store.
+func newTestStore() *block.KVStore[*testBlock] {
+	return block.NewStore[*testBlock](&block.KVStoreProvider{
+		KVStoreWithBatch: newMemKVStore(),
+	}, testChainSpec{})
+}
+
+// errUnsupportedFork is returned when a test block is decoded with a fork
+// version other than zero.
+var errUnsupportedFork = errors.New("unsupported fork version")
+
+// testChainSpec activates the fork version one from forkSlot on, if it is
+// set.
+type testChainSpec struct {
+	forkSlot math.Slot
+}
+
+func (cs testChainSpec) ActiveForkVersionForSlot(slot math.Slot) uint32 {
+	if cs.forkSlot != 0 && slot >= cs.forkSlot {
+		return 1
+	}
+	return 0
+}
+
+type testBlock struct {
+	Slot uint64
+	Data uint64
+}
+
+func (b *testBlock) GetSlot() math.Slot {
+	return math.Slot(b.Slot)
+}
+
+func (b *testBlock) MarshalSSZ() ([]byte, error) {
+	bz := binary.LittleEndian.AppendUint64(nil, b.Slot)
+	return binary.LittleEndian.AppendUint64(bz, b.Data), nil
+}
+
+func (b *testBlock) HashTreeRoot() ([32]byte, error) {
+	bz, err := b.MarshalSSZ()
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return sha256.Sum256(bz), nil
+}
+
+func (*testBlock) NewFromSSZ(
+	bz []byte,
+	forkVersion uint32,
+) (*testBlock, error) {
+	if forkVersion != 0 {
+		return nil, errUnsupportedFork
+	}
+	if len(bz) != 16 {
+		return nil, errors.New("invalid test block size")
+	}
+	return &testBlock{
+		Slot: binary.LittleEndian.Uint64(bz),
+		Data: binary.LittleEndian.Uint64(bz[8:]),
+	}, nil
+}
+
+// testFeed is a block feed with a single subscriber.
+type testFeed struct {
+	subscriber chan<- *feed.Event[*testBlock]
+}
+
+func (f *testFeed) Subscribe(
+	ch chan<- *feed.Event[*testBlock],
+) block.Subscription {
+	f.subscriber = ch
+	return testSubscription{}
+}
+
+func (f *testFeed) send(event *feed.Event[*testBlock]) {
+	f.subscriber <- event
+}
+
+type testSubscription struct{}
+
+func (testSubscription) Unsubscribe() {}
+
+// memKVStore is a minimal in-memory store, only the reads and writes of
+// single keys are implemented.
+type memKVStore struct {
+	store.KVStoreWithBatch
+	data map[string][]byte
+}
+
+func newMemKVStore() *memKVStore {
+	return &memKVStore{data: make(map[string][]byte)}
+}
+
+func (m *memKVStore) Get(key []byte) ([]byte, error) {
+	return m.data[string(key)], nil
+}
+
+func (m *memKVStore) Has(key []byte) (bool, error) {
+	_, ok := m.data[string(key)]
+	return ok, nil
+}
+
+func (m *memKVStore) Set(key, value []byte) error {
+	m.data[string(key)] = value
+	return nil
+}
+
+func (m *memKVStore) Delete(key []byte) error {
+	delete(m.data, string(key))
+	return nil
+}
@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package block
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/feed"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// BeaconBlock is the interface of the blocks kept in the store.
+type BeaconBlock[T any] interface {
+	// GetSlot returns the slot of the block.
+	GetSlot() math.Slot
+	// HashTreeRoot returns the root of the block.
+	HashTreeRoot() ([32]byte, error)
+	// MarshalSSZ returns the SSZ encoding of the block.
+	MarshalSSZ() ([]byte, error)
+	// NewFromSSZ decodes a block of the given fork version from its SSZ
+	// encoding.
+	NewFromSSZ([]byte, uint32) (T, error)
+}
+
+// ChainSpec is the chain spec the fork version of the blocks is read from.
+type ChainSpec interface {
+	// ActiveForkVersionForSlot returns the fork version active at the given
+	// slot.
+	ActiveForkVersionForSlot(math.Slot) uint32
+}
+
+// Event is an interface for block events.
+type Event[BeaconBlockT any] interface {
+	Is(feed.EventID) bool
+	Data() BeaconBlockT
+}
+
+// Subscription is the subscription to a block feed.
+type Subscription interface {
+	Unsubscribe()
+}
+
+// Feed is an interface for subscribing to block events.
+type Feed[
+	BeaconBlockT any,
+	BlockEventT Event[BeaconBlockT],
+	SubscriptionT Subscription,
+] interface {
+	Subscribe(chan<- (BlockEventT)) SubscriptionT
+}
@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+const (
+	// operationGet is the label of the operations reading deposits.
+	operationGet = "get"
+	// operationSet is the label of the operations writing deposits.
+	operationSet = "set"
+	// operationIterate is the label of the operations iterating deposits.
+	operationIterate = "iterate"
+	// operationRemove is the label of the operations removing deposits.
+	operationRemove = "remove"
+)
+
+// metrics is a struct that contains metrics for the deposit store.
+type metrics struct {
+	// sink is the telemetry sink.
+	sink TelemetrySink
+}
+
+// newMetrics creates a new instance of the metrics struct. The metrics are
+// discarded if the sink is nil.
+func newMetrics(sink TelemetrySink) *metrics {
+	if sink == nil {
+		sink = noopSink{}
+	}
+	return &metrics{
+		sink: sink,
+	}
+}
+
+// markOperation increments the counter for the given store operation, and
+// the error counter for it if the operation failed.
+func (m *metrics) markOperation(op string, err error) {
+	m.sink.IncrementCounter(
+		"beacon_kit.storage.deposit.operations", "operation", op,
+	)
+	if err != nil {
+		m.sink.IncrementCounter(
+			"beacon_kit.storage.deposit.errors", "operation", op,
+		)
+	}
+}
+
+// setDepositCount sets the gauge for the number of deposits in the store.
+func (m *metrics) setDepositCount(count uint64) {
+	//#nosec:G701 // the deposit count will never exceed int64.
+	m.sink.SetGauge("beacon_kit.storage.deposit.count", int64(count))
+}
+
+// noopSink is a TelemetrySink that discards all metrics.
+type noopSink struct{}
+
+// IncrementCounter implements TelemetrySink.
+func (noopSink) IncrementCounter(string, ...string) {}
+
+// SetGauge implements TelemetrySink.
+func (noopSink) SetGauge(string, int64, ...string) {}
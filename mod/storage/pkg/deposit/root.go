@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"context"
+	"math"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle/zero"
+)
+
+// DepositContractDepth is the depth of the deposit tree of the deposit
+// contract.
+const DepositContractDepth uint8 = 32
+
+// DepositRoot returns the root of the deposit tree over the deposits in the
+// store, as reported by the deposit contract for the same deposits. It is
+// computed over every deposit from index zero, so it returns
+// ErrMissingDeposits if any of them is not in the store, such as once the
+// store has been pruned.
+func (kv *KVStore[DepositT]) DepositRoot() ([32]byte, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	root, err := kv.depositRoot()
+	kv.metrics.markOperation(operationIterate, err)
+	return root, err
+}
+
+// depositRoot returns the root of the deposit tree over the deposits in the
+// store, with the number of deposits mixed in.
+func (kv *KVStore[DepositT]) depositRoot() ([32]byte, error) {
+	var leaves []common.Root
+	if err := kv.iterateDeposits(
+		context.TODO(), 0, math.MaxUint64,
+		func(index uint64, deposit DepositT) error {
+			if index != uint64(len(leaves)) {
+				return errors.Wrapf(
+					ErrMissingDeposits, "expected deposit %d, got %d",
+					len(leaves), index,
+				)
+			}
+			leaf, err := deposit.DepositDataRoot()
+			if err != nil {
+				return err
+			}
+			leaves = append(leaves, leaf)
+			return nil
+		},
+	); err != nil {
+		return [32]byte{}, err
+	}
+
+	if len(leaves) == 0 {
+		return merkle.MixinLength(zero.Hashes[DepositContractDepth], 0), nil
+	}
+	tree, err := merkle.NewTreeFromLeavesWithDepth[common.Root, common.Root](
+		leaves, DepositContractDepth,
+	)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return merkle.MixinLength(tree.Root(), uint64(len(leaves))), nil
+}
@@ -23,6 +23,7 @@ package deposit
 import (
 	"context"
 	"errors"
+	"io"
 	"sync"
 
 	sdkcollections "cosmossdk.io/collections"
@@ -32,9 +33,29 @@ import (
 )
 
 // Deposit is a struct that holds the deposit information.
-var _ pruner.Prunable = (*KVStore[Deposit])(nil)
+var (
+	_ pruner.Prunable = (*KVStore[Deposit])(nil)
+	_ Store[Deposit]  = (*KVStore[Deposit])(nil)
+)
+
+const (
+	KeyDepositPrefix = "deposit"
+	// KeyDepositCountPrefix is the name of the counter of the deposits in
+	// the store.
+	KeyDepositCountPrefix = "deposit_count"
+	// KeyLatestDepositIndexPrefix is the name of the highest deposit index
+	// in the store.
+	KeyLatestDepositIndexPrefix = "latest_deposit_index"
 
-const KeyDepositPrefix = "deposit"
+	// depositPrefix is the prefix of the deposits in the store.
+	depositPrefix = 0
+	// depositCountPrefix is the prefix of the counter of the deposits in the
+	// store.
+	depositCountPrefix = 1
+	// latestDepositIndexPrefix is the prefix of the highest deposit index in
+	// the store.
+	latestDepositIndexPrefix = 2
+)
 
 type KVStoreProvider struct {
 	store.KVStoreWithBatch
@@ -45,24 +66,78 @@ func (p *KVStoreProvider) OpenKVStore(context.Context) store.KVStore {
 	return p.KVStoreWithBatch
 }
 
+// ForceCompact compacts the [start, limit) key range of the database. It is a
+// no-op if the database does not support manual compaction.
+func (p *KVStoreProvider) ForceCompact(start, limit []byte) error {
+	if c, ok := p.KVStoreWithBatch.(compacter); ok {
+		return c.ForceCompact(start, limit)
+	}
+	return nil
+}
+
+// compacter is implemented by the databases that can be compacted manually,
+// such that the space of the deleted entries is reclaimed.
+type compacter interface {
+	ForceCompact(start, limit []byte) error
+}
+
 // KVStore is a simple KV store based implementation that assumes
 // the deposit indexes are tracked outside of the kv store.
 type KVStore[DepositT Deposit] struct {
 	store sdkcollections.Map[uint64, DepositT]
-	mu    sync.RWMutex
+	// counter is the number of deposits in the store, kept alongside them
+	// such that they can be counted without a scan.
+	counter sdkcollections.Item[uint64]
+	// latest is the highest deposit index in the store, it is not set if
+	// the store is empty.
+	latest  sdkcollections.Item[uint64]
+	mu      sync.RWMutex
+	metrics *metrics
+	// batcher creates the batches deposits are written atomically with, it is
+	// nil if the store service does not support batches.
+	batcher store.BatchCreator
+	// closer releases the database of the store, it is nil if the store
+	// service does not hold any resources.
+	closer io.Closer
+	// compacter compacts the database once deposits are pruned, it is nil if
+	// the store service does not support manual compaction.
+	compacter compacter
 }
 
-// NewStore creates a new deposit store.
-func NewStore[DepositT Deposit](kvsp store.KVStoreService) *KVStore[DepositT] {
+// NewStore creates a new deposit store. The metrics of the store are sent to
+// the given telemetry sink, or discarded if it is nil.
+func NewStore[DepositT Deposit](
+	kvsp store.KVStoreService,
+	sink TelemetrySink,
+) *KVStore[DepositT] {
 	schemaBuilder := sdkcollections.NewSchemaBuilder(kvsp)
+	batcher, _ := kvsp.(store.BatchCreator)
+	closer, _ := kvsp.(io.Closer)
+	c, _ := kvsp.(compacter)
 	return &KVStore[DepositT]{
 		store: sdkcollections.NewMap(
 			schemaBuilder,
-			sdkcollections.NewPrefix([]byte{uint8(0)}),
+			sdkcollections.NewPrefix([]byte{depositPrefix}),
 			KeyDepositPrefix,
 			sdkcollections.Uint64Key,
 			encoding.SSZValueCodec[DepositT]{},
 		),
+		counter: sdkcollections.NewItem(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{depositCountPrefix}),
+			KeyDepositCountPrefix,
+			sdkcollections.Uint64Value,
+		),
+		latest: sdkcollections.NewItem(
+			schemaBuilder,
+			sdkcollections.NewPrefix([]byte{latestDepositIndexPrefix}),
+			KeyLatestDepositIndexPrefix,
+			sdkcollections.Uint64Value,
+		),
+		metrics:   newMetrics(sink),
+		batcher:   batcher,
+		closer:    closer,
+		compacter: c,
 	}
 }
 
@@ -75,6 +150,17 @@ func (kv *KVStore[DepositT]) GetDepositsByIndex(
 ) ([]DepositT, error) {
 	kv.mu.RLock()
 	defer kv.mu.RUnlock()
+	deposits, err := kv.getDepositsByIndex(startIndex, numView)
+	kv.metrics.markOperation(operationGet, err)
+	return deposits, err
+}
+
+// getDepositsByIndex returns the first N deposits starting from the given
+// index.
+func (kv *KVStore[DepositT]) getDepositsByIndex(
+	startIndex uint64,
+	numView uint64,
+) ([]DepositT, error) {
 	deposits := []DepositT{}
 	for i := range numView {
 		deposit, err := kv.store.Get(context.TODO(), startIndex+i)
@@ -89,11 +175,168 @@ func (kv *KVStore[DepositT]) GetDepositsByIndex(
 	return deposits, nil
 }
 
+// Has returns whether a deposit with the given index exists in the store
+// without deserializing it.
+func (kv *KVStore[DepositT]) Has(index uint64) (bool, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	has, err := kv.store.Has(context.TODO(), index)
+	kv.metrics.markOperation(operationGet, err)
+	return has, err
+}
+
+// IterateDeposits calls fn for every deposit in the [start, end) index range
+// in ascending index order, stopping early at the first error returned by fn.
+// The deposits are streamed from the store rather than loaded all at once.
+// fn must not write to the store.
+func (kv *KVStore[DepositT]) IterateDeposits(
+	ctx context.Context,
+	start, end uint64,
+	fn func(index uint64, deposit DepositT) error,
+) error {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+
+	// Errors returned by fn or caused by ctx are not failures of the store.
+	var stopErr error
+	err := kv.iterateDeposits(ctx, start, end, func(
+		index uint64, deposit DepositT,
+	) error {
+		if stopErr = ctx.Err(); stopErr != nil {
+			return stopErr
+		}
+		stopErr = fn(index, deposit)
+		return stopErr
+	})
+	if stopErr != nil {
+		kv.metrics.markOperation(operationIterate, nil)
+		return stopErr
+	}
+	kv.metrics.markOperation(operationIterate, err)
+	return err
+}
+
+// iterateDeposits calls fn for every deposit in the [start, end) index range
+// in ascending index order, stopping early at the first error returned by fn.
+func (kv *KVStore[DepositT]) iterateDeposits(
+	ctx context.Context,
+	start, end uint64,
+	fn func(index uint64, deposit DepositT) error,
+) error {
+	iter, err := kv.store.Iterate(
+		ctx,
+		new(sdkcollections.Range[uint64]).
+			StartInclusive(start).
+			EndExclusive(end),
+	)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	var kvPair sdkcollections.KeyValue[uint64, DepositT]
+	for ; iter.Valid(); iter.Next() {
+		if kvPair, err = iter.KeyValue(); err != nil {
+			return err
+		}
+		if err = fn(kvPair.Key, kvPair.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Count returns the number of deposits in the store. It reads the counter
+// kept alongside the deposits rather than scanning them.
+func (kv *KVStore[DepositT]) Count() (uint64, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	count, err := kv.count()
+	kv.metrics.markOperation(operationIterate, err)
+	if err == nil {
+		kv.metrics.setDepositCount(count)
+	}
+	return count, err
+}
+
+// count returns the number of deposits in the store. The deposits of a store
+// written before the counter was kept are counted once by a scan, until the
+// counter is persisted by the next write.
+func (kv *KVStore[DepositT]) count() (uint64, error) {
+	count, err := kv.counter.Get(context.TODO())
+	if errors.Is(err, sdkcollections.ErrNotFound) {
+		return kv.scanCount()
+	}
+	return count, err
+}
+
+// scanCount counts the deposits in the store by scanning their keys.
+func (kv *KVStore[DepositT]) scanCount() (uint64, error) {
+	iter, err := kv.store.Iterate(context.TODO(), nil)
+	if err != nil {
+		return 0, err
+	}
+	defer iter.Close()
+
+	var count uint64
+	for ; iter.Valid(); iter.Next() {
+		count++
+	}
+	return count, nil
+}
+
+// LatestIndex returns the highest deposit index in the store, and false if
+// the store is empty.
+func (kv *KVStore[DepositT]) LatestIndex() (uint64, bool, error) {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	index, found, err := kv.latestIndex()
+	kv.metrics.markOperation(operationIterate, err)
+	return index, found, err
+}
+
+// latestIndex returns the highest deposit index in the store, and false if
+// the store is empty. The index is sought in a store written before it was
+// kept.
+func (kv *KVStore[DepositT]) latestIndex() (uint64, bool, error) {
+	index, err := kv.latest.Get(context.TODO())
+	if errors.Is(err, sdkcollections.ErrNotFound) {
+		return kv.seekLatestIndex()
+	}
+	return index, err == nil, err
+}
+
+// seekLatestIndex seeks the highest deposit index in the store, and returns
+// false if the store is empty.
+func (kv *KVStore[DepositT]) seekLatestIndex() (uint64, bool, error) {
+	iter, err := kv.store.Iterate(
+		context.TODO(),
+		new(sdkcollections.Range[uint64]).Descending(),
+	)
+	if err != nil {
+		return 0, false, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return 0, false, nil
+	}
+	index, err := iter.Key()
+	if err != nil {
+		return 0, false, err
+	}
+	return index, true, nil
+}
+
 // EnqueueDeposit pushes the deposit to the queue.
 func (kv *KVStore[DepositT]) EnqueueDeposit(deposit DepositT) error {
 	kv.mu.Lock()
 	defer kv.mu.Unlock()
-	return kv.setDeposit(deposit)
+	if err := kv.setDeposit(deposit); err != nil {
+		return err
+	}
+	kv.updateDepositCount()
+	return nil
 }
 
 // EnqueueDeposits pushes multiple deposits to the queue.
@@ -105,23 +348,344 @@ func (kv *KVStore[DepositT]) EnqueueDeposits(deposits []DepositT) error {
 			return err
 		}
 	}
+	kv.updateDepositCount()
 	return nil
 }
 
-// setDeposit sets the deposit in the store.
+// SetBatch writes the deposits to the store in a single batch, such that
+// either all of them are persisted or none are. The deposit count and the
+// latest deposit index are written in the same batch. It returns
+// ErrBatchNotSupported if the store service does not support batches.
+func (kv *KVStore[DepositT]) SetBatch(deposits []DepositT) error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	err := kv.setBatch(deposits)
+	kv.metrics.markOperation(operationSet, err)
+	if err != nil {
+		return err
+	}
+	kv.updateDepositCount()
+	return nil
+}
+
+// setBatch writes the deposits to the store in a single batch. The batch is
+// discarded without being written if any of the deposits fails to be added.
+func (kv *KVStore[DepositT]) setBatch(deposits []DepositT) (err error) {
+	if kv.batcher == nil {
+		return ErrBatchNotSupported
+	}
+	if len(deposits) == 0 {
+		return nil
+	}
+
+	count, err := kv.count()
+	if err != nil {
+		return err
+	}
+	added, err := kv.countNew(deposits)
+	if err != nil {
+		return err
+	}
+	latest, found, err := kv.latestIndex()
+	if err != nil {
+		return err
+	}
+
+	// The batch holds the counter and the latest index besides the deposits.
+	const bookkeepingKeys = 2
+	batch := kv.batcher.NewBatchWithSize(len(deposits) + bookkeepingKeys)
+	defer func() {
+		err = errors.Join(err, batch.Close())
+	}()
+
+	var key, value []byte
+	for _, deposit := range deposits {
+		if !found || deposit.GetIndex() > latest {
+			latest, found = deposit.GetIndex(), true
+		}
+		if key, err = sdkcollections.EncodeKeyWithPrefix(
+			kv.store.GetPrefix(), kv.store.KeyCodec(), deposit.GetIndex(),
+		); err != nil {
+			return err
+		}
+		if value, err = kv.store.ValueCodec().Encode(deposit); err != nil {
+			return err
+		}
+		if err = batch.Set(key, value); err != nil {
+			return err
+		}
+	}
+
+	// The counter and the latest index are written with the deposits, such
+	// that they cannot be left out of sync with them.
+	if value, err = sdkcollections.Uint64Value.Encode(
+		count + added,
+	); err != nil {
+		return err
+	}
+	if err = batch.Set([]byte{depositCountPrefix}, value); err != nil {
+		return err
+	}
+	if value, err = sdkcollections.Uint64Value.Encode(latest); err != nil {
+		return err
+	}
+	if err = batch.Set([]byte{latestDepositIndexPrefix}, value); err != nil {
+		return err
+	}
+	return batch.Write()
+}
+
+// countNew returns the number of distinct indexes of the given deposits that
+// are not in the store yet.
+func (kv *KVStore[DepositT]) countNew(deposits []DepositT) (uint64, error) {
+	var added uint64
+	seen := make(map[uint64]struct{}, len(deposits))
+	for _, deposit := range deposits {
+		index := deposit.GetIndex()
+		if _, ok := seen[index]; ok {
+			continue
+		}
+		seen[index] = struct{}{}
+		has, err := kv.store.Has(context.TODO(), index)
+		if err != nil {
+			return 0, err
+		}
+		if !has {
+			added++
+		}
+	}
+	return added, nil
+}
+
+// setDeposit sets the deposit in the store and counts it if its index was
+// not in the store yet.
 func (kv *KVStore[DepositT]) setDeposit(deposit DepositT) error {
-	return kv.store.Set(context.TODO(), deposit.GetIndex(), deposit)
+	err := kv.addDeposit(deposit)
+	kv.metrics.markOperation(operationSet, err)
+	return err
+}
+
+// addDeposit sets the deposit in the store and counts it if its index was
+// not in the store yet.
+func (kv *KVStore[DepositT]) addDeposit(deposit DepositT) error {
+	count, err := kv.count()
+	if err != nil {
+		return err
+	}
+	added, err := kv.countNew([]DepositT{deposit})
+	if err != nil {
+		return err
+	}
+	latest, found, err := kv.latestIndex()
+	if err != nil {
+		return err
+	}
+	if err = kv.store.Set(
+		context.TODO(), deposit.GetIndex(), deposit,
+	); err != nil {
+		return err
+	}
+	if err = kv.setCount(count, count+added); err != nil {
+		return err
+	}
+	if found && latest >= deposit.GetIndex() {
+		return nil
+	}
+	return kv.latest.Set(context.TODO(), deposit.GetIndex())
+}
+
+// setCount sets the counter to the given count, if it differs from the
+// previous one.
+func (kv *KVStore[DepositT]) setCount(prev, count uint64) error {
+	if prev == count {
+		return nil
+	}
+	return kv.counter.Set(context.TODO(), count)
 }
 
 // Prune removes the [start, end) deposits from the store.
 func (kv *KVStore[DepositT]) Prune(start, end uint64) error {
 	kv.mu.Lock()
 	defer kv.mu.Unlock()
+	count, err := kv.count()
+	if err != nil {
+		return err
+	}
+
+	var (
+		removed uint64
+		has     bool
+	)
 	for i := range end {
+		has, err = kv.store.Has(context.TODO(), start+i)
+		if err != nil {
+			kv.metrics.markOperation(operationRemove, err)
+			return err
+		}
+		if !has {
+			continue
+		}
 		// This only errors if the key passed in cannot be encoded.
-		if err := kv.store.Remove(context.TODO(), start+i); err != nil {
+		err = kv.store.Remove(context.TODO(), start+i)
+		kv.metrics.markOperation(operationRemove, err)
+		if err != nil {
 			return err
 		}
+		removed++
+	}
+	if err = kv.setCount(count, count-removed); err != nil {
+		return err
+	}
+	if err = kv.resetLatestIndex(); err != nil {
+		return err
+	}
+	kv.updateDepositCount()
+	return nil
+}
+
+// RollbackToIndex removes all the deposits with an index greater than the
+// given one from the store, such as the deposits persisted from an orphaned
+// chain. It is a no-op if no deposit has a greater index.
+func (kv *KVStore[DepositT]) RollbackToIndex(index uint64) error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	indexes, err := kv.indexes(
+		new(sdkcollections.Range[uint64]).StartExclusive(index),
+	)
+	kv.metrics.markOperation(operationIterate, err)
+	if err != nil {
+		return err
+	}
+	if len(indexes) == 0 {
+		return nil
+	}
+
+	if err = kv.removeDeposits(indexes); err != nil {
+		return err
 	}
+	kv.updateDepositCount()
 	return nil
 }
+
+// PruneBelow removes all the deposits with an index strictly below the given
+// one from the store and returns the number of deposits removed. The pruned
+// range of the database is compacted afterwards if the store service supports
+// it, such that the space of the removed deposits is reclaimed.
+func (kv *KVStore[DepositT]) PruneBelow(index uint64) (uint64, error) {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	indexes, err := kv.indexes(
+		new(sdkcollections.Range[uint64]).EndExclusive(index),
+	)
+	kv.metrics.markOperation(operationIterate, err)
+	if err != nil {
+		return 0, err
+	}
+	if len(indexes) == 0 {
+		return 0, nil
+	}
+
+	if err = kv.removeDeposits(indexes); err != nil {
+		return 0, err
+	}
+	kv.updateDepositCount()
+	return uint64(len(indexes)), kv.compact(0, index)
+}
+
+// removeDeposits removes the deposits with the given indexes, which must all
+// be in the store, and subtracts them from the counter and resets the latest
+// index.
+func (kv *KVStore[DepositT]) removeDeposits(indexes []uint64) error {
+	count, err := kv.count()
+	if err != nil {
+		return err
+	}
+	for _, i := range indexes {
+		err = kv.store.Remove(context.TODO(), i)
+		kv.metrics.markOperation(operationRemove, err)
+		if err != nil {
+			return err
+		}
+	}
+	if err = kv.setCount(count, count-uint64(len(indexes))); err != nil {
+		return err
+	}
+	return kv.resetLatestIndex()
+}
+
+// resetLatestIndex sets the latest index to the highest deposit index left in
+// the store once deposits are removed, or removes it if the store is empty.
+func (kv *KVStore[DepositT]) resetLatestIndex() error {
+	index, found, err := kv.seekLatestIndex()
+	if err != nil {
+		return err
+	}
+	if !found {
+		return kv.latest.Remove(context.TODO())
+	}
+	return kv.latest.Set(context.TODO(), index)
+}
+
+// compact compacts the [start, end) index range of the database, if the store
+// service supports it.
+func (kv *KVStore[DepositT]) compact(start, end uint64) error {
+	if kv.compacter == nil {
+		return nil
+	}
+	startKey, err := sdkcollections.EncodeKeyWithPrefix(
+		kv.store.GetPrefix(), kv.store.KeyCodec(), start,
+	)
+	if err != nil {
+		return err
+	}
+	endKey, err := sdkcollections.EncodeKeyWithPrefix(
+		kv.store.GetPrefix(), kv.store.KeyCodec(), end,
+	)
+	if err != nil {
+		return err
+	}
+	return kv.compacter.ForceCompact(startKey, endKey)
+}
+
+// indexes returns the indexes of the deposits in the store within the given
+// range, in ascending order.
+func (kv *KVStore[DepositT]) indexes(
+	ranger *sdkcollections.Range[uint64],
+) ([]uint64, error) {
+	iter, err := kv.store.Iterate(context.TODO(), ranger)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	var indexes []uint64
+	for ; iter.Valid(); iter.Next() {
+		key, keyErr := iter.Key()
+		if keyErr != nil {
+			return nil, keyErr
+		}
+		indexes = append(indexes, key)
+	}
+	return indexes, nil
+}
+
+// Close closes the database of the store, waiting for any in-flight
+// operation to complete first.
+func (kv *KVStore[DepositT]) Close() error {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	if kv.closer == nil {
+		return nil
+	}
+	return kv.closer.Close()
+}
+
+// updateDepositCount updates the gauge for the number of deposits in the
+// store from the counter kept alongside them, such that no write has to
+// scan the store. The gauge is left unchanged if no counter is persisted yet.
+func (kv *KVStore[DepositT]) updateDepositCount() {
+	count, err := kv.counter.Get(context.TODO())
+	if err == nil {
+		kv.metrics.setDepositCount(count)
+	}
+}
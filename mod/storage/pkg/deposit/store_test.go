@@ -0,0 +1,703 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"sort"
+	"strings"
+	"testing"
+
+	"cosmossdk.io/core/store"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHas(t *testing.T) {
+	kv := newTestStore()
+	require.NoError(t, kv.EnqueueDeposit(&testDeposit{Index: 5}))
+
+	has, err := kv.Has(5)
+	require.NoError(t, err)
+	require.True(t, has)
+
+	has, err = kv.Has(6)
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestIterateDeposits(t *testing.T) {
+	kv := newTestStore()
+	for _, index := range []uint64{7, 2, 5, 0, 9, 3} {
+		require.NoError(t, kv.EnqueueDeposit(&testDeposit{Index: index}))
+	}
+
+	var visited []uint64
+	require.NoError(t, kv.IterateDeposits(
+		context.Background(), 2, 9,
+		func(index uint64, dep *testDeposit) error {
+			require.Equal(t, index, dep.GetIndex())
+			visited = append(visited, index)
+			return nil
+		},
+	))
+	require.Equal(t, []uint64{2, 3, 5, 7}, visited)
+}
+
+func TestIterateDepositsStopsEarly(t *testing.T) {
+	kv := newTestStore()
+	for index := range uint64(10) {
+		require.NoError(t, kv.EnqueueDeposit(&testDeposit{Index: index}))
+	}
+
+	errStop := errors.New("stop")
+	var visited []uint64
+	err := kv.IterateDeposits(
+		context.Background(), 0, 10,
+		func(index uint64, _ *testDeposit) error {
+			visited = append(visited, index)
+			if index == 3 {
+				return errStop
+			}
+			return nil
+		},
+	)
+	require.ErrorIs(t, err, errStop)
+	require.Equal(t, []uint64{0, 1, 2, 3}, visited)
+}
+
+func TestCountAndLatestIndex(t *testing.T) {
+	tests := []struct {
+		name          string
+		indexes       []uint64
+		expectedCount uint64
+		expectedIndex uint64
+		expectedFound bool
+	}{
+		{
+			name:          "empty",
+			expectedCount: 0,
+			expectedIndex: 0,
+			expectedFound: false,
+		},
+		{
+			name:          "single",
+			indexes:       []uint64{0},
+			expectedCount: 1,
+			expectedIndex: 0,
+			expectedFound: true,
+		},
+		{
+			name:          "gapped",
+			indexes:       []uint64{3, 10, 1, 256},
+			expectedCount: 4,
+			expectedIndex: 256,
+			expectedFound: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kv := newTestStore()
+			for _, index := range tt.indexes {
+				require.NoError(
+					t, kv.EnqueueDeposit(&testDeposit{Index: index}),
+				)
+			}
+
+			count, err := kv.Count()
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedCount, count)
+
+			index, found, err := kv.LatestIndex()
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedFound, found)
+			require.Equal(t, tt.expectedIndex, index)
+		})
+	}
+}
+
+func TestCountPersisted(t *testing.T) {
+	db := &memKVStore{data: make(map[string][]byte)}
+	kv := deposit.NewStore[*testDeposit](
+		&deposit.KVStoreProvider{KVStoreWithBatch: db}, nil,
+	)
+	for _, index := range []uint64{0, 1, 2, 2} {
+		require.NoError(t, kv.EnqueueDeposit(&testDeposit{Index: index}))
+	}
+	require.NoError(t, kv.SetBatch([]*testDeposit{
+		{Index: 2}, {Index: 3}, {Index: 4}, {Index: 4},
+	}))
+	require.NoError(t, kv.Prune(0, 1))
+	require.NoError(t, kv.RollbackToIndex(3))
+	_, err := kv.PruneBelow(2)
+	require.NoError(t, err)
+
+	// The counter is persisted alongside the deposits.
+	count, err := deposit.NewStore[*testDeposit](
+		&deposit.KVStoreProvider{KVStoreWithBatch: db}, nil,
+	).Count()
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), count)
+
+	// The deposits of a store without a counter are counted once, and the
+	// counter is persisted with the next write.
+	delete(db.data, string([]byte{1}))
+	kv = deposit.NewStore[*testDeposit](
+		&deposit.KVStoreProvider{KVStoreWithBatch: db}, nil,
+	)
+	count, err = kv.Count()
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), count)
+	require.NoError(t, kv.EnqueueDeposit(&testDeposit{Index: 5}))
+	require.Contains(t, db.data, string([]byte{1}))
+	count, err = kv.Count()
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), count)
+}
+
+func TestRollbackToIndex(t *testing.T) {
+	tests := []struct {
+		name          string
+		index         uint64
+		expectedCount uint64
+		expectedIndex uint64
+	}{
+		{
+			name:          "middle of the set",
+			index:         2,
+			expectedCount: 3,
+			expectedIndex: 2,
+		},
+		{
+			name:          "latest index",
+			index:         4,
+			expectedCount: 5,
+			expectedIndex: 4,
+		},
+		{
+			name:          "beyond the latest index",
+			index:         10,
+			expectedCount: 5,
+			expectedIndex: 4,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sink := newTestSink()
+			kv := newTestStoreWithSink(sink)
+			require.NoError(t, kv.EnqueueDeposits([]*testDeposit{
+				{Index: 0}, {Index: 1}, {Index: 2}, {Index: 3}, {Index: 4},
+			}))
+
+			require.NoError(t, kv.RollbackToIndex(tt.index))
+
+			count, err := kv.Count()
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedCount, count)
+			//#nosec:G701 // the count is small.
+			require.Equal(
+				t, int64(tt.expectedCount),
+				sink.gauges["beacon_kit.storage.deposit.count"],
+			)
+
+			index, found, err := kv.LatestIndex()
+			require.NoError(t, err)
+			require.True(t, found)
+			require.Equal(t, tt.expectedIndex, index)
+
+			// Deposits can be enqueued again after the rollback.
+			require.NoError(
+				t, kv.EnqueueDeposit(&testDeposit{Index: tt.index + 1}),
+			)
+			has, err := kv.Has(tt.index + 1)
+			require.NoError(t, err)
+			require.True(t, has)
+		})
+	}
+}
+
+func TestPruneBelow(t *testing.T) {
+	db := &compactingKVStore{
+		memKVStore: memKVStore{data: make(map[string][]byte)},
+	}
+	kv := deposit.NewStore[*testDeposit](
+		&deposit.KVStoreProvider{KVStoreWithBatch: db}, nil,
+	)
+	require.NoError(t, kv.EnqueueDeposits([]*testDeposit{
+		{Index: 1}, {Index: 2}, {Index: 3}, {Index: 4},
+	}))
+
+	removed, err := kv.PruneBelow(3)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), removed)
+	require.Equal(t, 1, db.compactions)
+
+	deposits, err := kv.GetDepositsByIndex(0, 5)
+	require.NoError(t, err)
+	require.Empty(t, deposits)
+	deposits, err = kv.GetDepositsByIndex(3, 5)
+	require.NoError(t, err)
+	require.Len(t, deposits, 2)
+
+	// Nothing is left to prune, so the database is not compacted again.
+	removed, err = kv.PruneBelow(3)
+	require.NoError(t, err)
+	require.Zero(t, removed)
+	require.Equal(t, 1, db.compactions)
+}
+
+func TestMetrics(t *testing.T) {
+	const (
+		operations = "beacon_kit.storage.deposit.operations,operation,"
+		errs       = "beacon_kit.storage.deposit.errors,operation,"
+		count      = "beacon_kit.storage.deposit.count"
+	)
+	sink := newTestSink()
+	kv := newTestStoreWithSink(sink)
+
+	require.NoError(t, kv.EnqueueDeposits([]*testDeposit{
+		{Index: 0}, {Index: 1}, {Index: 2},
+	}))
+	require.Equal(t, 3, sink.counters[operations+"set"])
+	require.Equal(t, int64(3), sink.gauges[count])
+	// The gauge is set from the counter, without scanning the store.
+	require.Zero(t, sink.counters[operations+"iterate"])
+
+	deposits, err := kv.GetDepositsByIndex(0, 2)
+	require.NoError(t, err)
+	require.Len(t, deposits, 2)
+	require.Equal(t, 1, sink.counters[operations+"get"])
+
+	errStop := errors.New("stop")
+	require.ErrorIs(t, kv.IterateDeposits(
+		context.Background(), 0, 3,
+		func(uint64, *testDeposit) error { return errStop },
+	), errStop)
+	require.Zero(t, sink.counters[errs+"iterate"])
+
+	require.NoError(t, kv.Prune(0, 2))
+	require.Equal(t, int64(1), sink.gauges[count])
+
+	_, err = kv.GetDepositsByIndex(0, 1)
+	require.NoError(t, err)
+	require.Zero(t, sink.counters[errs+"get"])
+}
+
+func TestSetBatch(t *testing.T) {
+	const count = "beacon_kit.storage.deposit.count"
+	sink := newTestSink()
+	kv := newTestStoreWithSink(sink)
+
+	require.NoError(t, kv.SetBatch([]*testDeposit{
+		{Index: 4}, {Index: 0}, {Index: 7},
+	}))
+	require.Equal(t, int64(3), sink.gauges[count])
+
+	for _, index := range []uint64{0, 4, 7} {
+		has, err := kv.Has(index)
+		require.NoError(t, err)
+		require.True(t, has, "index %d", index)
+	}
+	index, found, err := kv.LatestIndex()
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, uint64(7), index)
+
+	// An empty batch is a no-op.
+	require.NoError(t, kv.SetBatch(nil))
+	require.Equal(t, int64(3), sink.gauges[count])
+}
+
+func TestSetBatchBookkeeping(t *testing.T) {
+	db := &memKVStore{data: make(map[string][]byte)}
+	kv := deposit.NewStore[*testDeposit](
+		&deposit.KVStoreProvider{KVStoreWithBatch: db}, nil,
+	)
+	require.NoError(t, kv.EnqueueDeposit(&testDeposit{Index: 1}))
+	require.NoError(t, kv.SetBatch([]*testDeposit{
+		{Index: 9}, {Index: 1}, {Index: 4}, {Index: 9},
+	}))
+
+	// The counter and the latest index are written with the deposits.
+	require.Contains(t, db.data, string([]byte{1}))
+	require.Contains(t, db.data, string([]byte{2}))
+	kv = deposit.NewStore[*testDeposit](
+		&deposit.KVStoreProvider{KVStoreWithBatch: db}, nil,
+	)
+	count, err := kv.Count()
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), count)
+	index, found, err := kv.LatestIndex()
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, uint64(9), index)
+
+	// The latest index follows the deposits removed from the store.
+	require.NoError(t, kv.RollbackToIndex(4))
+	index, found, err = kv.LatestIndex()
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, uint64(4), index)
+	_, err = kv.PruneBelow(5)
+	require.NoError(t, err)
+	require.NotContains(t, db.data, string([]byte{2}))
+	_, found, err = kv.LatestIndex()
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+func TestSetBatchFailureLeavesStoreUnchanged(t *testing.T) {
+	db := &memKVStore{data: make(map[string][]byte), failSetAt: 2}
+	kv := deposit.NewStore[*testDeposit](
+		&deposit.KVStoreProvider{KVStoreWithBatch: db}, nil,
+	)
+	require.NoError(t, kv.EnqueueDeposit(&testDeposit{Index: 0}))
+
+	err := kv.SetBatch([]*testDeposit{{Index: 1}, {Index: 2}, {Index: 3}})
+	require.ErrorIs(t, err, errBatchSet)
+
+	count, err := kv.Count()
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), count)
+	index, found, err := kv.LatestIndex()
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Zero(t, index)
+	has, err := kv.Has(1)
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestSetBatchNotSupported(t *testing.T) {
+	kv := deposit.NewStore[*testDeposit](plainKVStoreService{
+		&memKVStore{data: make(map[string][]byte)},
+	}, nil)
+	err := kv.SetBatch([]*testDeposit{{Index: 0}})
+	require.ErrorIs(t, err, deposit.ErrBatchNotSupported)
+}
+
+func TestDepositRoot(t *testing.T) {
+	kv := newTestStore()
+
+	// The root of the deposit contract before any deposit has been made.
+	root, err := kv.DepositRoot()
+	require.NoError(t, err)
+	require.Equal(t, common.Root{
+		0xd7, 0x0a, 0x23, 0x47, 0x31, 0x28, 0x5c, 0x68,
+		0x04, 0xc2, 0xa4, 0xf5, 0x67, 0x11, 0xdd, 0xb8,
+		0xc8, 0x2c, 0x99, 0x74, 0x0f, 0x20, 0x78, 0x54,
+		0x89, 0x10, 0x28, 0xaf, 0x34, 0xe2, 0x7e, 0x5e,
+	}, common.Root(root))
+
+	contract := newDepositContract()
+	for index := range uint64(5) {
+		d := &testDeposit{Index: index}
+		require.NoError(t, kv.EnqueueDeposit(d))
+		leaf, leafErr := d.DepositDataRoot()
+		require.NoError(t, leafErr)
+		contract.deposit(leaf)
+
+		root, err = kv.DepositRoot()
+		require.NoError(t, err)
+		require.Equal(t, contract.root(), root, "deposits %d", index+1)
+	}
+
+	// The root cannot be computed once the first deposits are pruned.
+	require.NoError(t, kv.Prune(0, 1))
+	_, err = kv.DepositRoot()
+	require.ErrorIs(t, err, deposit.ErrMissingDeposits)
+}
+
+// =============================== HELPERS ==================================
+
+// depositContract computes the deposit root the way the deposit contract
+// does, with an incremental merkle tree.
+type depositContract struct {
+	branch [deposit.DepositContractDepth][32]byte
+	zeros  [deposit.DepositContractDepth][32]byte
+	count  uint64
+}
+
+func newDepositContract() *depositContract {
+	c := &depositContract{}
+	for i := 1; i < len(c.zeros); i++ {
+		c.zeros[i] = hashPair(c.zeros[i-1], c.zeros[i-1])
+	}
+	return c
+}
+
+func (c *depositContract) deposit(leaf [32]byte) {
+	c.count++
+	size := c.count
+	node := leaf
+	for height := range c.branch {
+		if size&1 == 1 {
+			c.branch[height] = node
+			return
+		}
+		node = hashPair(c.branch[height], node)
+		size /= 2
+	}
+}
+
+func (c *depositContract) root() [32]byte {
+	var node [32]byte
+	size := c.count
+	for height := range c.branch {
+		if size&1 == 1 {
+			node = hashPair(c.branch[height], node)
+		} else {
+			node = hashPair(node, c.zeros[height])
+		}
+		size /= 2
+	}
+	var count [32]byte
+	binary.LittleEndian.PutUint64(count[:], c.count)
+	return hashPair(node, count)
+}
+
+func hashPair(a, b [32]byte) [32]byte {
+	return sha256.Sum256(append(a[:], b[:]...))
+}
+
+// newTestStore returns a new deposit store backed by an in-memory kv store.
+func newTestStore() *deposit.KVStore[*testDeposit] {
+	return newTestStoreWithSink(nil)
+}
+
+// newTestStoreWithSink returns a new deposit store backed by an in-memory kv
+// store that sends its metrics to the given sink.
+func newTestStoreWithSink(
+	sink deposit.TelemetrySink,
+) *deposit.KVStore[*testDeposit] {
+	return deposit.NewStore[*testDeposit](&deposit.KVStoreProvider{
+		KVStoreWithBatch: &memKVStore{data: make(map[string][]byte)},
+	}, sink)
+}
+
+// testSink is a TelemetrySink that records the metrics sent to it.
+type testSink struct {
+	counters map[string]int
+	gauges   map[string]int64
+}
+
+func newTestSink() *testSink {
+	return &testSink{
+		counters: make(map[string]int),
+		gauges:   make(map[string]int64),
+	}
+}
+
+func (s *testSink) IncrementCounter(key string, args ...string) {
+	s.counters[strings.Join(append([]string{key}, args...), ",")]++
+}
+
+func (s *testSink) SetGauge(key string, value int64, _ ...string) {
+	s.gauges[key] = value
+}
+
+// testDeposit is a deposit that only encodes its index.
+type testDeposit struct {
+	Index uint64
+}
+
+func (d *testDeposit) GetIndex() uint64 {
+	return d.Index
+}
+
+func (d *testDeposit) DepositDataRoot() (common.Root, error) {
+	var index [8]byte
+	binary.LittleEndian.PutUint64(index[:], d.Index)
+	return sha256.Sum256(index[:]), nil
+}
+
+func (d *testDeposit) MarshalSSZTo(buf []byte) ([]byte, error) {
+	return binary.LittleEndian.AppendUint64(buf, d.Index), nil
+}
+
+func (d *testDeposit) MarshalSSZ() ([]byte, error) {
+	return d.MarshalSSZTo(nil)
+}
+
+func (d *testDeposit) UnmarshalSSZ(bz []byte) error {
+	if len(bz) != 8 {
+		return errors.New("invalid test deposit size")
+	}
+	d.Index = binary.LittleEndian.Uint64(bz)
+	return nil
+}
+
+func (d *testDeposit) SizeSSZ() int {
+	return 8
+}
+
+func (d *testDeposit) HashTreeRoot() ([32]byte, error) {
+	var root [32]byte
+	binary.LittleEndian.PutUint64(root[:], d.Index)
+	return root, nil
+}
+
+// errBatchSet is returned by the batches of a memKVStore that fail to set.
+var errBatchSet = errors.New("batch set failed")
+
+// compactingKVStore is a memKVStore that counts its manual compactions.
+type compactingKVStore struct {
+	memKVStore
+	compactions int
+}
+
+func (m *compactingKVStore) ForceCompact([]byte, []byte) error {
+	m.compactions++
+	return nil
+}
+
+// memKVStore is a minimal in-memory store.KVStoreWithBatch.
+type memKVStore struct {
+	data map[string][]byte
+	// failSetAt is the call to Set, counted from one, from which on the
+	// batches of the store fail. They never fail if it is zero.
+	failSetAt int
+}
+
+func (m *memKVStore) Get(key []byte) ([]byte, error) {
+	return m.data[string(key)], nil
+}
+
+func (m *memKVStore) Has(key []byte) (bool, error) {
+	_, ok := m.data[string(key)]
+	return ok, nil
+}
+
+func (m *memKVStore) Set(key, value []byte) error {
+	m.data[string(key)] = value
+	return nil
+}
+
+func (m *memKVStore) Delete(key []byte) error {
+	delete(m.data, string(key))
+	return nil
+}
+
+func (m *memKVStore) Iterator(start, end []byte) (store.Iterator, error) {
+	return m.newIterator(start, end, false), nil
+}
+
+func (m *memKVStore) ReverseIterator(
+	start, end []byte,
+) (store.Iterator, error) {
+	return m.newIterator(start, end, true), nil
+}
+
+func (m *memKVStore) NewBatch() store.Batch {
+	return &memBatch{store: m}
+}
+
+func (m *memKVStore) NewBatchWithSize(int) store.Batch {
+	return m.NewBatch()
+}
+
+func (m *memKVStore) Close() error {
+	return nil
+}
+
+func (m *memKVStore) newIterator(start, end []byte, reverse bool) *memIterator {
+	keys := make([]string, 0, len(m.data))
+	for k := range m.data {
+		if (start == nil || bytes.Compare([]byte(k), start) >= 0) &&
+			(end == nil || bytes.Compare([]byte(k), end) < 0) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	if reverse {
+		sort.Sort(sort.Reverse(sort.StringSlice(keys)))
+	}
+	return &memIterator{store: m, keys: keys, start: start, end: end}
+}
+
+// memIterator iterates over a snapshot of the keys of a memKVStore.
+type memIterator struct {
+	store      *memKVStore
+	keys       []string
+	start, end []byte
+}
+
+func (it *memIterator) Domain() ([]byte, []byte) { return it.start, it.end }
+func (it *memIterator) Valid() bool              { return len(it.keys) > 0 }
+func (it *memIterator) Next()                    { it.keys = it.keys[1:] }
+func (it *memIterator) Key() []byte              { return []byte(it.keys[0]) }
+func (it *memIterator) Error() error             { return nil }
+func (it *memIterator) Close() error             { return nil }
+
+func (it *memIterator) Value() []byte {
+	return it.store.data[it.keys[0]]
+}
+
+// memBatch buffers the writes to a memKVStore until it is written.
+type memBatch struct {
+	store *memKVStore
+	sets  int
+	data  map[string][]byte
+}
+
+func (b *memBatch) Set(key, value []byte) error {
+	b.sets++
+	if b.store.failSetAt > 0 && b.sets >= b.store.failSetAt {
+		return errBatchSet
+	}
+	if b.data == nil {
+		b.data = make(map[string][]byte)
+	}
+	b.data[string(key)] = value
+	return nil
+}
+
+func (b *memBatch) Delete(key []byte) error {
+	delete(b.data, string(key))
+	return nil
+}
+
+func (b *memBatch) Write() error {
+	for k, v := range b.data {
+		b.store.data[k] = v
+	}
+	return nil
+}
+
+func (b *memBatch) WriteSync() error          { return b.Write() }
+func (b *memBatch) Close() error              { return nil }
+func (b *memBatch) GetByteSize() (int, error) { return 0, nil }
+
+// plainKVStoreService is a store service that does not support batches.
+type plainKVStoreService struct {
+	store.KVStore
+}
+
+func (s plainKVStoreService) OpenKVStore(context.Context) store.KVStore {
+	return s.KVStore
+}
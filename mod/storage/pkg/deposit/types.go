@@ -21,6 +21,9 @@
 package deposit
 
 import (
+	"context"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/ssz"
 )
 
@@ -28,6 +31,42 @@ import (
 type Deposit interface {
 	ssz.Marshallable
 	GetIndex() uint64
+	// DepositDataRoot returns the leaf of the deposit in the deposit tree of
+	// the deposit contract.
+	DepositDataRoot() (common.Root, error)
+}
+
+// Store is a store of deposits keyed by their index. KVStore is the default
+// implementation, other implementations allow the deposits of the node to be
+// kept in a different database.
+type Store[DepositT Deposit] interface {
+	// GetDepositsByIndex returns up to numView deposits starting from the
+	// given index, stopping at the first missing index.
+	GetDepositsByIndex(startIndex uint64, numView uint64) ([]DepositT, error)
+	// EnqueueDeposits stores the given deposits.
+	EnqueueDeposits(deposits []DepositT) error
+	// Has returns whether a deposit with the given index is stored.
+	Has(index uint64) (bool, error)
+	// Count returns the number of stored deposits.
+	Count() (uint64, error)
+	// LatestIndex returns the highest stored deposit index, and false if no
+	// deposit is stored.
+	LatestIndex() (uint64, bool, error)
+	// IterateDeposits calls fn for every deposit in the [start, end) index
+	// range in ascending index order, stopping early at the first error
+	// returned by fn.
+	IterateDeposits(
+		ctx context.Context,
+		start, end uint64,
+		fn func(index uint64, deposit DepositT) error,
+	) error
+	// Prune removes the deposits in the [start, end) index range.
+	Prune(start, end uint64) error
+	// PruneBelow removes the deposits with an index strictly below the given
+	// one and returns the number of deposits removed.
+	PruneBelow(index uint64) (uint64, error)
+	// Close releases the resources held by the store.
+	Close() error
 }
 
 // RawBatch represents a group of writes. They may or may not be written
@@ -66,3 +105,13 @@ type RawBatch interface {
 	// on top of the expected key and value total byte count.
 	GetByteSize() (int, error)
 }
+
+// TelemetrySink is an interface for sending metrics to a telemetry backend.
+type TelemetrySink interface {
+	// IncrementCounter increments a counter metric identified by the provided
+	// keys.
+	IncrementCounter(key string, args ...string)
+	// SetGauge sets a gauge metric to the specified value, identified by the
+	// provided keys.
+	SetGauge(key string, value int64, args ...string)
+}
@@ -53,6 +53,15 @@ func NewDB(opts ...Option) *DB {
 	return db
 }
 
+// NewInMemoryDB creates a new instance of the DB backed by an in-memory
+// filesystem, such that nothing is persisted to disk.
+// NOTE: Should only be used for testing.
+func NewInMemoryDB(opts ...Option) *DB {
+	db := NewDB(opts...)
+	db.fs = afero.NewMemMapFs()
+	return db
+}
+
 // Get retrieves the value for a key.
 func (db *DB) Get(key []byte) ([]byte, error) {
 	return afero.ReadFile(db.fs, db.pathForKey(key))
@@ -21,6 +21,8 @@
 package filedb_test
 
 import (
+	"io/fs"
+	"os"
 	"testing"
 
 	"cosmossdk.io/log"
@@ -158,6 +160,25 @@ func TestDB(t *testing.T) {
 	})
 }
 
+func TestInMemoryDB(t *testing.T) {
+	rootDir := t.TempDir() + "/testdb"
+	db := file.NewInMemoryDB(
+		file.WithRootDirectory(rootDir),
+		file.WithFileExtension("txt"),
+		file.WithDirectoryPermissions(0700),
+		file.WithLogger(log.NewNopLogger()),
+	)
+
+	require.NoError(t, db.Set([]byte("key"), []byte("value")))
+	value, err := db.Get([]byte("key"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), value)
+
+	// Nothing is written to disk.
+	_, err = os.Stat(rootDir)
+	require.ErrorIs(t, err, fs.ErrNotExist)
+}
+
 // Test with `etc` as root directory to cause creation failure
 // due to permission denied.
 func TestDB_SetExistingKey_CreateError(t *testing.T) {
@@ -23,12 +23,16 @@ package filedb
 import (
 	"bytes"
 	"fmt"
+	"io/fs"
+	"slices"
 	"strconv"
+	"sync"
 
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/hex"
 	db "github.com/berachain/beacon-kit/mod/storage/pkg/interfaces"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
+	"github.com/spf13/afero"
 )
 
 // two is a constant for the number 2.
@@ -43,6 +47,10 @@ var _ pruner.Prunable = (*RangeDB)(nil)
 type RangeDB struct {
 	db.DB
 	firstNonNilIndex uint64
+	// mu is held for reading while values are written or deleted, and for
+	// writing while the db is pruned or compacted, or firstNonNilIndex is
+	// lowered.
+	mu sync.RWMutex
 }
 
 // NewRangeDB creates a new RangeDB.
@@ -71,10 +79,18 @@ func (db *RangeDB) Has(index uint64, key []byte) (bool, error) {
 // It prefixes the key with the index and a slash before storing it in the
 // underlying database.
 func (db *RangeDB) Set(index uint64, key []byte, value []byte) error {
-	// enforce invariant
-	if index < db.firstNonNilIndex {
-		db.firstNonNilIndex = index
+	db.mu.RLock()
+	if index >= db.firstNonNilIndex {
+		defer db.mu.RUnlock()
+		return db.DB.Set(db.prefix(index, key), value)
 	}
+	db.mu.RUnlock()
+
+	// enforce invariant, under the write lock as values may be set
+	// concurrently.
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.firstNonNilIndex = min(db.firstNonNilIndex, index)
 	return db.DB.Set(db.prefix(index, key), value)
 }
 
@@ -82,6 +98,8 @@ func (db *RangeDB) Set(index uint64, key []byte, value []byte) error {
 // database. It prefixes the key with the index and a slash before deleting it
 // from the underlying database.
 func (db *RangeDB) Delete(index uint64, key []byte) error {
+	db.mu.RLock()
+	defer db.mu.RUnlock()
 	return db.DB.Delete(db.prefix(index, key))
 }
 
@@ -101,8 +119,57 @@ func (db *RangeDB) DeleteRange(from, to uint64) error {
 	return nil
 }
 
+// CountRange returns the number of values stored across all indexes in the
+// given range [from, to).
+func (db *RangeDB) CountRange(from, to uint64) (int, error) {
+	f, ok := db.DB.(*DB)
+	if !ok {
+		return 0, errors.New("rangedb: count range not supported for this db")
+	}
+	var count int
+	for from = max(from, db.firstNonNilIndex); from < to; from++ {
+		entries, err := afero.ReadDir(f.fs, fmt.Sprintf("%d/", from))
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		} else if err != nil {
+			return 0, err
+		}
+		count += len(entries)
+	}
+	return count, nil
+}
+
+// GetRange retrieves all the values stored across the indexes in the given
+// range [from, to), keyed by index. Indexes without any values are skipped.
+func (db *RangeDB) GetRange(from, to uint64) (map[uint64][][]byte, error) {
+	f, ok := db.DB.(*DB)
+	if !ok {
+		return nil, errors.New("rangedb: get range not supported for this db")
+	}
+	values := make(map[uint64][][]byte)
+	for from = max(from, db.firstNonNilIndex); from < to; from++ {
+		dir := fmt.Sprintf("%d/", from)
+		entries, err := afero.ReadDir(f.fs, dir)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			bz, readErr := afero.ReadFile(f.fs, dir+entry.Name())
+			if readErr != nil {
+				return nil, readErr
+			}
+			values[from] = append(values[from], bz)
+		}
+	}
+	return values, nil
+}
+
 // Prune removes all values in the given range [start, end) from the db.
 func (db *RangeDB) Prune(start, end uint64) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
 	start = max(start, db.firstNonNilIndex)
 	if err := db.DeleteRange(start, end); err != nil {
 		// Resets last pruned index in case Delete somehow populates indices on
@@ -116,6 +183,78 @@ func (db *RangeDB) Prune(start, end uint64) error {
 	return nil
 }
 
+// Compact removes the directories of the indexes that no longer hold any
+// values, such as those whose values were all deleted. Compaction is skipped
+// while values are written or deleted, or the db is pruned, as it is meant to
+// be retried periodically.
+func (db *RangeDB) Compact() error {
+	f, ok := db.DB.(*DB)
+	if !ok {
+		return errors.New("rangedb: compact not supported for this db")
+	}
+	if !db.mu.TryLock() {
+		return nil
+	}
+	defer db.mu.Unlock()
+
+	dirs, err := afero.ReadDir(f.fs, "/")
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for _, dir := range dirs {
+		if _, parseErr := strconv.ParseUint(
+			dir.Name(), 10, 64,
+		); parseErr != nil || !dir.IsDir() {
+			continue
+		}
+		empty, emptyErr := afero.IsEmpty(f.fs, dir.Name())
+		if emptyErr != nil {
+			return emptyErr
+		} else if !empty {
+			continue
+		}
+		if err = f.fs.Remove(dir.Name()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Indexes returns the indexes values are stored for, in ascending order.
+func (db *RangeDB) Indexes() ([]uint64, error) {
+	f, ok := db.DB.(*DB)
+	if !ok {
+		return nil, errors.New("rangedb: indexes not supported for this db")
+	}
+	db.mu.RLock()
+	defer db.mu.RUnlock()
+
+	dirs, err := afero.ReadDir(f.fs, "/")
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	indexes := make([]uint64, 0, len(dirs))
+	for _, dir := range dirs {
+		index, parseErr := strconv.ParseUint(dir.Name(), 10, 64)
+		if parseErr != nil || !dir.IsDir() {
+			continue
+		}
+		empty, emptyErr := afero.IsEmpty(f.fs, dir.Name())
+		if emptyErr != nil {
+			return nil, emptyErr
+		} else if empty {
+			continue
+		}
+		indexes = append(indexes, index)
+	}
+	slices.Sort(indexes)
+	return indexes, nil
+}
+
 // prefix prefixes the given key with the index and a slash.
 func (db *RangeDB) prefix(index uint64, key []byte) []byte {
 	return []byte(fmt.Sprintf("%d/%s", index, hex.FromBytes(key).Unwrap()))
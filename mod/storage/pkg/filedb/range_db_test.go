@@ -21,7 +21,9 @@
 package filedb_test
 
 import (
+	"path/filepath"
 	"reflect"
+	"sync"
 	"testing"
 
 	"cosmossdk.io/log"
@@ -277,6 +279,95 @@ func TestRangeDB_Prune(t *testing.T) {
 	}
 }
 
+func TestRangeDB_CountRange(t *testing.T) {
+	rdb := file.NewRangeDB(newTestFDB("/tmp/testdb-4"))
+	require.NoError(t, populateTestDB(rdb, 1, 10))
+	require.NoError(t, rdb.Set(3, []byte("key2"), []byte("value")))
+
+	count, err := rdb.CountRange(0, 5)
+	require.NoError(t, err)
+	require.Equal(t, 5, count)
+
+	require.NoError(t, rdb.Prune(0, 5))
+	count, err = rdb.CountRange(0, 5)
+	require.NoError(t, err)
+	require.Zero(t, count)
+
+	count, err = rdb.CountRange(5, 20)
+	require.NoError(t, err)
+	require.Equal(t, 6, count)
+}
+
+func TestRangeDB_GetRange(t *testing.T) {
+	rdb := file.NewRangeDB(newTestFDB("/tmp/testdb-5"))
+	require.NoError(t, rdb.Prune(0, 20))
+	require.NoError(t, populateTestDB(rdb, 2, 3))
+	require.NoError(t, populateTestDB(rdb, 6, 6))
+	require.NoError(t, rdb.Set(3, []byte("key2"), []byte("value2")))
+
+	values, err := rdb.GetRange(0, 6)
+	require.NoError(t, err)
+	require.Len(t, values, 2)
+	require.Equal(t, [][]byte{[]byte("value")}, values[2])
+	require.ElementsMatch(t,
+		[][]byte{[]byte("value"), []byte("value2")}, values[3])
+
+	values, err = rdb.GetRange(4, 6)
+	require.NoError(t, err)
+	require.Empty(t, values)
+}
+
+func TestRangeDB_Indexes(t *testing.T) {
+	rdb := file.NewRangeDB(file.NewDB(
+		file.WithRootDirectory(t.TempDir()),
+		file.WithFileExtension("txt"),
+		file.WithDirectoryPermissions(0700),
+		file.WithLogger(log.NewNopLogger()),
+	))
+
+	indexes, err := rdb.Indexes()
+	require.NoError(t, err)
+	require.Empty(t, indexes)
+
+	require.NoError(t, populateTestDB(rdb, 2, 3))
+	require.NoError(t, populateTestDB(rdb, 10, 10))
+	require.NoError(t, rdb.Delete(3, []byte("key")))
+
+	// Indexes without any values are skipped, and the indexes are sorted by
+	// value rather than by name.
+	indexes, err = rdb.Indexes()
+	require.NoError(t, err)
+	require.Equal(t, []uint64{2, 10}, indexes)
+}
+
+// =========================== COMPACTION ==================================
+
+func TestRangeDB_Compact(t *testing.T) {
+	root := t.TempDir()
+	rdb := file.NewRangeDB(file.NewDB(
+		file.WithRootDirectory(root),
+		file.WithFileExtension("txt"),
+		file.WithDirectoryPermissions(0700),
+		file.WithLogger(log.NewNopLogger()),
+	))
+
+	// Compacting an empty db is a no-op.
+	require.NoError(t, rdb.Compact())
+
+	require.NoError(t, populateTestDB(rdb, 1, 3))
+	require.NoError(t, rdb.Delete(2, []byte("key")))
+	require.DirExists(t, filepath.Join(root, "2"))
+	require.NoError(t, rdb.Compact())
+
+	require.NoDirExists(t, filepath.Join(root, "2"))
+	requireExist(t, rdb, 1, 1)
+	requireExist(t, rdb, 3, 3)
+
+	// The index is usable again after its directory is removed.
+	require.NoError(t, rdb.Set(2, []byte("key"), []byte("value")))
+	requireExist(t, rdb, 2, 2)
+}
+
 // =========================== INVARIANTS ================================.
 
 // invariant: all indexes up to the firstNonNilIndex should be nil.
@@ -367,6 +458,37 @@ func TestRangeDB_Invariants(t *testing.T) {
 	}
 }
 
+// invariant: values set concurrently with pruning must not be left below
+// the firstNonNilIndex.
+func TestRangeDB_ConcurrentSetPrune(t *testing.T) {
+	const numIndexes = 64
+	rdb := file.NewRangeDB(newTestFDB("/tmp/testdb-6"))
+	require.NoError(t, populateTestDB(rdb, 0, numIndexes))
+
+	var (
+		wg   sync.WaitGroup
+		errs = make(chan error, 2*numIndexes)
+	)
+	for i := range uint64(numIndexes) {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			errs <- rdb.Set(i, []byte("key"), []byte("value"))
+		}()
+		go func() {
+			defer wg.Done()
+			errs <- rdb.Prune(0, i)
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		require.NoError(t, err)
+	}
+	requireNotExist(t, rdb, 0, lastConsequetiveNilIndex(rdb))
+}
+
 // =============================== HELPERS ==================================
 
 // newTestFDB returns a new file DB instance with an in-memory filesystem.
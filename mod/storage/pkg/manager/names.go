@@ -31,4 +31,7 @@ const (
 	DepositPrunerName = "deposit-store-pruner"
 	// AvailabilityPrunerName is the name of the availability store pruner.
 	AvailabilityPrunerName = "availability-store-pruner"
+	// AvailabilityCompactorName is the name of the availability store
+	// compactor.
+	AvailabilityCompactorName = "availability-store-compactor"
 )